@@ -6,22 +6,60 @@ import (
 	"os/signal"
 	"syscall"
 
+	"awesome-chat/internal/application/attachment/useCases/generatePreviews"
+	"awesome-chat/internal/application/chat/useCases/invalidatePreviews"
+	"awesome-chat/internal/application/draft/useCases/flush"
+	eventInvalidatePreviews "awesome-chat/internal/application/events/useCases/invalidatePreviews"
+	exportRun "awesome-chat/internal/application/export/useCases/run"
+	"awesome-chat/internal/application/linkPreview/useCases/unfurl"
+	"awesome-chat/internal/application/message/useCases/countReceipts"
+	"awesome-chat/internal/application/message/useCases/dispatchScheduled"
+	"awesome-chat/internal/application/message/useCases/notifyMentions"
+	"awesome-chat/internal/application/message/useCases/purgeExpired"
 	"awesome-chat/internal/bootstrap"
 	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/infrastructure/archive"
 	"awesome-chat/internal/infrastructure/config/apps/worker"
+	"awesome-chat/internal/infrastructure/imaging"
 	"awesome-chat/internal/infrastructure/logger"
 	"awesome-chat/internal/infrastructure/messagePipe"
+	"awesome-chat/internal/infrastructure/minio"
+	"awesome-chat/internal/infrastructure/minio/services/bucket"
 	"awesome-chat/internal/infrastructure/postgres"
 	"awesome-chat/internal/infrastructure/postgres/executor"
 	"awesome-chat/internal/infrastructure/postgres/store/message"
 	"awesome-chat/internal/infrastructure/redis"
+	"awesome-chat/internal/infrastructure/redis/pubsub"
+	"awesome-chat/internal/infrastructure/redis/storage"
 	"awesome-chat/internal/infrastructure/redis/stream"
+	"awesome-chat/internal/infrastructure/snowflake"
 	"awesome-chat/internal/presentation/workers"
+	"awesome-chat/internal/presentation/workers/attachment/handlers/previewGenerator"
+	"awesome-chat/internal/presentation/workers/draft/handlers/flusher"
+	"awesome-chat/internal/presentation/workers/export/handlers/exporter"
+	"awesome-chat/internal/presentation/workers/linkPreview/handlers/unfurler"
 	"awesome-chat/internal/presentation/workers/message/handlers/acknowledger"
 	"awesome-chat/internal/presentation/workers/message/handlers/batchSaver"
+	"awesome-chat/internal/presentation/workers/message/handlers/mentionNotifier"
+	"awesome-chat/internal/presentation/workers/message/handlers/receiptCounter"
+	"awesome-chat/internal/presentation/workers/message/handlers/scheduler"
 	"awesome-chat/internal/presentation/workers/message/handlers/streamSubscriber"
+	"awesome-chat/internal/presentation/workers/message/handlers/sweeper"
+	"awesome-chat/internal/presentation/workers/redis/handlers/event"
 
+	minioURL "awesome-chat/internal/infrastructure/minio/services/url"
+	attachmentStorage "awesome-chat/internal/infrastructure/minio/storage/attachment"
+	exportStorage "awesome-chat/internal/infrastructure/minio/storage/export"
+	voiceStorage "awesome-chat/internal/infrastructure/minio/storage/voice"
+	attachmentStore "awesome-chat/internal/infrastructure/postgres/store/attachment"
+	chatStore "awesome-chat/internal/infrastructure/postgres/store/chat"
+	draftStore "awesome-chat/internal/infrastructure/postgres/store/draft"
+	exportStore "awesome-chat/internal/infrastructure/postgres/store/export"
+	linkPreviewStore "awesome-chat/internal/infrastructure/postgres/store/linkPreview"
+	draftCache "awesome-chat/internal/infrastructure/redis/draft"
+	previewCache "awesome-chat/internal/infrastructure/redis/preview"
 	streamNames "awesome-chat/internal/infrastructure/redis/stream/names"
+	unfurlService "awesome-chat/internal/infrastructure/unfurl"
 	redisLib "github.com/redis/go-redis/v9"
 )
 
@@ -37,9 +75,16 @@ func main() {
 	txManager := executor.NewTransactionManager(pool)
 
 	redisConn := redis.NewConnection(&cfg.StreamSubscriber)
+	idNodeLease := snowflake.MustLeaseNode(ctx, redisConn, cfg.IDs)
 
 	messageSaveFromStreamStore := message.NewSaveFromStreamStore(txManager)
 
+	chatInvalidatePreviewsUC := invalidatePreviews.NewChatInvalidatePreviewsUseCase(
+		log,
+		chatStore.NewPreviewAudienceStore(txManager),
+		previewCache.NewInvalidator(redisConn),
+	)
+
 	messageAckPipeTx := messagePipe.NewAckPipeTx()
 	messageAckPipe := messagePipe.NewMessagePipe[string]()
 	messageSaverPipe := messagePipe.NewMessagePipe[vo.StreamMessage]()
@@ -66,6 +111,7 @@ func main() {
 		messageSaverPipe,
 		messageSaveFromStreamStore,
 		messageAckPipeTx,
+		chatInvalidatePreviewsUC,
 	)
 	messageReaderHandler := streamSubscriber.NewHandler(
 		log,
@@ -73,21 +119,154 @@ func main() {
 		messageSaverPipe,
 	)
 
+	minioConn := minio.NewConnection(cfg.S3)
+	minioBucketSvc := bucket.NewService(log, minioConn)
+	attachmentObjStorage := attachmentStorage.NewStorage(
+		minioConn,
+		bucket.Attachments.String(),
+		minioBucketSvc,
+	)
+
+	chatEventPub := pubsub.NewPublisher(&cfg.EventPublisher)
+	chatEventPublisher := pubsub.NewChatEventPublisher(chatEventPub)
+
+	attachmentGeneratePreviewsUC := generatePreviews.NewAttachmentGeneratePreviewsUseCase(
+		log,
+		txManager,
+		attachmentStore.NewPreviewStore(txManager),
+		imaging.NewPreviewGenerator(),
+		attachmentObjStorage,
+		attachmentObjStorage,
+		chatEventPublisher,
+	)
+	attachmentPreviewHandler := previewGenerator.NewHandler(
+		log,
+		attachmentGeneratePreviewsUC,
+	)
+
+	linkPreviewUnfurlUC := unfurl.NewLinkPreviewUnfurlUseCase(
+		log,
+		linkPreviewStore.NewClaimStore(txManager),
+		linkPreviewStore.NewSaveStore(txManager),
+		unfurlService.NewUnfurler(&cfg.Unfurl),
+		storage.NewStorage(redisConn, storage.LinkPreview),
+		chatEventPublisher,
+	)
+	linkPreviewUnfurlHandler := unfurler.NewHandler(
+		log,
+		linkPreviewUnfurlUC,
+	)
+
+	messageDispatchScheduledUC := dispatchScheduled.NewMessageDispatchScheduledUseCase(
+		log,
+		txManager,
+		message.NewScheduledDispatchStore(txManager),
+		stream.NewPublisherImpl(redisConn, streamNames.SentMessage.String()),
+		snowflake.MustNewGenerator(idNodeLease.Config()),
+		chatEventPublisher,
+	)
+	messageSchedulerHandler := scheduler.NewHandler(
+		log,
+		messageDispatchScheduledUC,
+	)
+	schedulerWorker := workers.NewWorker(log, messageSchedulerHandler)
+
+	messagePurgeExpiredUC := purgeExpired.NewMessagePurgeExpiredUseCase(
+		log,
+		txManager,
+		message.NewExpiryStore(txManager),
+		attachmentObjStorage,
+		voiceStorage.NewStorage(minioConn, bucket.Voices.String(), minioBucketSvc),
+		chatEventPublisher,
+	)
+	messageSweeperHandler := sweeper.NewHandler(
+		log,
+		messagePurgeExpiredUC,
+	)
+
+	messageNotifyMentionsUC := notifyMentions.NewMessageNotifyMentionsUseCase(
+		log,
+		txManager,
+		message.NewMentionNotifyStore(txManager),
+		chatEventPublisher,
+	)
+	messageMentionNotifierHandler := mentionNotifier.NewHandler(
+		log,
+		messageNotifyMentionsUC,
+	)
+
+	messageCountReceiptsUC := countReceipts.NewMessageCountReceiptsUseCase(
+		log,
+		txManager,
+		message.NewReceiptCountStore(txManager),
+		chatEventPublisher,
+	)
+	messageReceiptCounterHandler := receiptCounter.NewHandler(
+		log,
+		messageCountReceiptsUC,
+	)
+
+	draftFlushUC := flush.NewDraftFlushUseCase(
+		log,
+		draftCache.NewCache(redisConn),
+		draftStore.NewStore(txManager),
+	)
+	draftFlusherHandler := flusher.NewHandler(
+		log,
+		draftFlushUC,
+	)
+
+	exportRunUC := exportRun.NewExportRunUseCase(
+		log,
+		exportStore.NewJobStore(txManager),
+		exportStore.NewSourceStore(txManager),
+		archive.NewArchiver(),
+		exportStorage.NewStorage(minioConn, bucket.Exports.String(), minioBucketSvc),
+		minioURL.NewUrlService(
+			minioConn.Client,
+			bucket.Exports.String(),
+			storage.NewStorage(redisConn, storage.Export),
+		),
+		chatEventPublisher,
+	)
+	exportRunHandler := exporter.NewHandler(
+		log,
+		exportRunUC,
+	)
+
+	previewInvalidateHandler := event.NewPreviewInvalidateHandler(
+		log,
+		pubsub.NewSubscriber(&cfg.EventSubscriber),
+		eventInvalidatePreviews.NewChatEventInvalidatePreviewsUseCase(log, chatInvalidatePreviewsUC),
+	)
+
 	mainWorker := workers.NewWorker(
 		log,
 		messageAckHandler,
 		messageSaverHandler,
 		messageReaderHandler,
+		attachmentPreviewHandler,
+		linkPreviewUnfurlHandler,
+		messageSweeperHandler,
+		messageMentionNotifierHandler,
+		messageReceiptCounterHandler,
+		draftFlusherHandler,
+		previewInvalidateHandler,
+		exportRunHandler,
 	)
 
 	app := bootstrap.NewApp(
 		log,
 		pool,
 		redisConn,
+		idNodeLease,
+		minioConn,
+		chatEventPub,
 		messageAckPipeTx,
 		//pipeCloser,
 		messageStreamSubscriber,
 		mainWorker,
+		schedulerWorker,
 	)
 
 	app.Run(ctx)
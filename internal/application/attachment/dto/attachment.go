@@ -0,0 +1,60 @@
+package dto
+
+type (
+	RequestUploadRequest struct {
+		UserID   string `json:"user_id"`
+		ChatID   string `json:"chat_id"`
+		FileName string `json:"file_name"`
+		MimeType string `json:"mime_type"`
+		Size     int64  `json:"size"`
+		Checksum string `json:"checksum"` // sha256 hex
+	}
+	RequestUploadResponse struct {
+		AttachmentID string `json:"attachment_id"`
+		Kind         string `json:"kind"`
+		UploadURL    string `json:"upload_url"`
+	}
+
+	CompleteUploadRequest struct {
+		UserID       string `json:"user_id"`
+		AttachmentID string `json:"attachment_id"`
+	}
+	CompleteUploadResponse struct {
+		MessageID  int        `json:"message_id"`
+		Attachment Attachment `json:"attachment"`
+	}
+
+	GetDownloadURLRequest struct {
+		UserID       string `json:"user_id"`
+		AttachmentID string `json:"attachment_id"`
+		Size         string `json:"size,omitempty"` // preview size, original when empty
+	}
+	GetDownloadURLResponse struct {
+		URL string `json:"url"`
+	}
+
+	Attachment struct {
+		ID        string `json:"id"`
+		MessageID int    `json:"message_id,omitempty"`
+		Kind      string `json:"kind"`
+		FileName  string `json:"file_name"`
+		MimeType  string `json:"mime_type"`
+		Size      int64  `json:"size"`
+		Checksum  string `json:"checksum"`
+	}
+
+	Preview struct {
+		Size     string `json:"size"`
+		MimeType string `json:"mime_type"`
+		Width    int    `json:"width"`
+		Height   int    `json:"height"`
+	}
+	AttachmentReadyEvent struct {
+		AttachmentID string    `json:"attachment_id"`
+		MessageID    int       `json:"message_id"`
+		Width        int       `json:"width"`
+		Height       int       `json:"height"`
+		Blurhash     string    `json:"blurhash"`
+		Previews     []Preview `json:"previews"`
+	}
+)
@@ -0,0 +1,163 @@
+package completeUpload
+
+import (
+	"awesome-chat/internal/application/attachment/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/attachment/entity"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"awesome-chat/internal/domain/core/attachment/ports"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentCompleteUploadUseCase struct {
+	log           appPorts.Logger
+	txManager     sharedPorts.TransactionManager
+	getStore      ports.GetStore
+	completeStore ports.CompleteStore
+	objects       s3.ObjectReader
+	sendGuard     chatPorts.SendGuard
+}
+
+func NewAttachmentCompleteUploadUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	getStore ports.GetStore,
+	completeStore ports.CompleteStore,
+	objects s3.ObjectReader,
+	sendGuard chatPorts.SendGuard,
+) *AttachmentCompleteUploadUseCase {
+	return &AttachmentCompleteUploadUseCase{
+		log:           log,
+		txManager:     txManager,
+		getStore:      getStore,
+		completeStore: completeStore,
+		objects:       objects,
+		sendGuard:     sendGuard,
+	}
+}
+
+func (uc *AttachmentCompleteUploadUseCase) Execute(
+	ctx context.Context,
+	req dto.CompleteUploadRequest,
+) (
+	resp dto.CompleteUploadResponse,
+	err error,
+) {
+	const op = "AttachmentCompleteUploadUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "attachment_id", req.AttachmentID}, args...)
+	}
+
+	uc.log.Info("Attempting to complete attachment upload", withFields()...)
+	defer func() {
+		if err != nil {
+			uc.log.Error("Failed to complete attachment upload", withFields("error", err.Error())...)
+		}
+	}()
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return resp, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	attachmentID, err := uuid.Parse(req.AttachmentID)
+	if err != nil {
+		return resp, fmt.Errorf("%s: invalid attachment id: %w", op, err)
+	}
+
+	attachment, err := uc.getStore.Get(ctx, attachmentID)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+	// only the uploader knows the attachment is there, hide it from everybody else
+	if attachment.UploaderID != userID {
+		return resp, fmt.Errorf("%s: %w", op, attachmentErrors.ErrAttachmentNotFound)
+	}
+	if attachment.Status != vo.StatusPending {
+		return resp, fmt.Errorf("%s: %w", op, attachmentErrors.ErrAlreadyCompleted)
+	}
+
+	// the role may have changed since the upload was requested, completing it posts the message
+	if err = uc.sendGuard.RequireSend(ctx, attachment.ChatID, userID); err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.verifyObject(ctx, attachment); err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	messageID, err := uc.completeStore.Complete(ctx, attachment)
+	if err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return resp, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully completed attachment upload", withFields("message_id", messageID)...)
+
+	return dto.CompleteUploadResponse{
+		MessageID: messageID,
+		Attachment: dto.Attachment{
+			ID:        attachment.ID.String(),
+			MessageID: messageID,
+			Kind:      attachment.Kind.String(),
+			FileName:  attachment.FileName,
+			MimeType:  attachment.MimeType,
+			Size:      attachment.Size,
+			Checksum:  attachment.Checksum.String(),
+		},
+	}, nil
+}
+
+// verifyObject checks that the uploaded object matches what was declared on upload request.
+func (uc *AttachmentCompleteUploadUseCase) verifyObject(ctx context.Context, a entity.Attachment) error {
+	info, err := uc.objects.Stat(ctx, a.ObjectKey)
+	if err != nil {
+		return fmt.Errorf("%w: %w", attachmentErrors.ErrAttachmentNotUploaded, err)
+	}
+	if info.Size != a.Size {
+		return fmt.Errorf("%w: expected %d, got %d", attachmentErrors.ErrSizeMismatch, a.Size, info.Size)
+	}
+	if vo.NormalizeMimeType(info.ContentType) != a.MimeType {
+		return fmt.Errorf("%w: expected %s, got %s",
+			attachmentErrors.ErrContentTypeMismatch, a.MimeType, info.ContentType,
+		)
+	}
+
+	obj, err := uc.objects.Open(ctx, a.ObjectKey)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = obj.Close() }()
+
+	hash := sha256.New()
+	if _, err = io.Copy(hash, obj); err != nil {
+		return fmt.Errorf("failed to read object: %w", err)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != a.Checksum.String() {
+		return attachmentErrors.ErrChecksumMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package generatePreviews
+
+import (
+	"awesome-chat/internal/application/attachment/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/attachment/entity"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"awesome-chat/internal/domain/core/attachment/ports"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	"context"
+	"errors"
+	"fmt"
+)
+
+const maxAttempts = 3
+
+type AttachmentGeneratePreviewsUseCase struct {
+	log       appPorts.Logger
+	txManager sharedPorts.TransactionManager
+	store     ports.PreviewStore
+	generator ports.PreviewGenerator
+	reader    s3.ObjectReader
+	writer    s3.ObjectWriter
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewAttachmentGeneratePreviewsUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	store ports.PreviewStore,
+	generator ports.PreviewGenerator,
+	reader s3.ObjectReader,
+	writer s3.ObjectWriter,
+	publisher sharedPorts.ChatEventPublisher,
+) *AttachmentGeneratePreviewsUseCase {
+	return &AttachmentGeneratePreviewsUseCase{
+		log:       log,
+		txManager: txManager,
+		store:     store,
+		generator: generator,
+		reader:    reader,
+		writer:    writer,
+		publisher: publisher,
+	}
+}
+
+// Execute generates previews for one pending image. It reports false when there was nothing to do.
+// A failed image is retried after a growing delay until maxAttempts, broken or unsupported images are given up at once.
+func (uc *AttachmentGeneratePreviewsUseCase) Execute(ctx context.Context) (bool, error) {
+	const op = "AttachmentGeneratePreviewsUseCase.Execute"
+
+	attachment, err := uc.store.ClaimPending(ctx)
+	if errors.Is(err, attachmentErrors.ErrNoPendingPreviews) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "attachment_id", attachment.ID}, args...)
+	}
+	uc.log.Info("Attempting to generate attachment previews", withFields()...)
+
+	if genErr := uc.generate(ctx, &attachment); genErr != nil {
+		uc.log.Error("Failed to generate attachment previews", withFields("error", genErr.Error())...)
+
+		attempts := maxAttempts
+		if errors.Is(genErr, attachmentErrors.ErrUnsupportedImage) ||
+			errors.Is(genErr, attachmentErrors.ErrImageTooLarge) {
+			attempts = 1
+		}
+		if err = uc.store.MarkFailed(ctx, attachment.ID, attempts); err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+		return true, nil
+	}
+
+	if err = uc.save(ctx, attachment); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// previews are saved at this point, a lost event only means clients see them on the next fetch
+	if pubErr := uc.publishReady(ctx, attachment); pubErr != nil {
+		uc.log.Error("Failed to publish attachment_ready event", withFields("error", pubErr.Error())...)
+	}
+
+	uc.log.Info("Successfully generated attachment previews", withFields("previews", len(attachment.Previews))...)
+
+	return true, nil
+}
+
+func (uc *AttachmentGeneratePreviewsUseCase) save(ctx context.Context, a entity.Attachment) error {
+	ctx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = uc.txManager.RollbackTx(ctx) }()
+
+	if err = uc.store.SavePreviews(ctx, a); err != nil {
+		return err
+	}
+
+	return uc.txManager.CommitTx(ctx)
+}
+
+func (uc *AttachmentGeneratePreviewsUseCase) generate(ctx context.Context, a *entity.Attachment) error {
+	obj, err := uc.reader.Open(ctx, a.ObjectKey)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = obj.Close() }()
+
+	generated, err := uc.generator.Generate(obj, vo.PreviewSizes...)
+	if err != nil {
+		return err
+	}
+
+	previews := make([]vo.Preview, 0, len(generated.Thumbnails))
+	for _, thumb := range generated.Thumbnails {
+		key := entity.PreviewObjectKeyFor(a.ObjectKey, thumb.Size, thumb.Ext)
+		if err = uc.writer.Put(ctx, key, thumb.Data, thumb.MimeType); err != nil {
+			return err
+		}
+		previews = append(previews, vo.Preview{
+			Size:      thumb.Size,
+			ObjectKey: key,
+			MimeType:  thumb.MimeType,
+			Width:     thumb.Width,
+			Height:    thumb.Height,
+		})
+	}
+
+	a.Width = generated.Width
+	a.Height = generated.Height
+	a.Blurhash = generated.Blurhash
+	a.Previews = previews
+	a.PreviewStatus = vo.PreviewStatusReady
+
+	return nil
+}
+
+func (uc *AttachmentGeneratePreviewsUseCase) publishReady(ctx context.Context, a entity.Attachment) error {
+	payload := dto.AttachmentReadyEvent{
+		AttachmentID: a.ID.String(),
+		MessageID:    a.MessageID,
+		Width:        a.Width,
+		Height:       a.Height,
+		Blurhash:     a.Blurhash,
+		Previews:     make([]dto.Preview, 0, len(a.Previews)),
+	}
+	for _, p := range a.Previews {
+		payload.Previews = append(payload.Previews, dto.Preview{
+			Size:     p.Size.String(),
+			MimeType: p.MimeType,
+			Width:    p.Width,
+			Height:   p.Height,
+		})
+	}
+
+	event, err := eventEntity.NewChatEvent(eventVo.AttachmentReady, a.ChatID, payload)
+	if err != nil {
+		return err
+	}
+
+	return uc.publisher.PublishChatEvent(ctx, event)
+}
@@ -0,0 +1,105 @@
+package getDownloadURL
+
+import (
+	"awesome-chat/internal/application/attachment/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/attachment/entity"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"awesome-chat/internal/domain/core/attachment/ports"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentGetDownloadURLUseCase struct {
+	log           appPorts.Logger
+	store         ports.GetStore
+	chatValidator chatPorts.ValidateStore
+	urlSvc        s3.URLService
+}
+
+func NewAttachmentGetDownloadURLUseCase(
+	log appPorts.Logger,
+	store ports.GetStore,
+	chatValidator chatPorts.ValidateStore,
+	urlSvc s3.URLService,
+) *AttachmentGetDownloadURLUseCase {
+	return &AttachmentGetDownloadURLUseCase{
+		log:           log,
+		store:         store,
+		chatValidator: chatValidator,
+		urlSvc:        urlSvc,
+	}
+}
+
+func (uc *AttachmentGetDownloadURLUseCase) Execute(
+	ctx context.Context,
+	req dto.GetDownloadURLRequest,
+) (
+	dto.GetDownloadURLResponse,
+	error,
+) {
+	const op = "AttachmentGetDownloadURLUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "attachment_id", req.AttachmentID, "user_id", req.UserID}, args...)
+	}
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	attachmentID, err := uuid.Parse(req.AttachmentID)
+	if err != nil {
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: invalid attachment id: %w", op, err)
+	}
+
+	attachment, err := uc.store.Get(ctx, attachmentID)
+	if err != nil {
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if attachment.Status != vo.StatusUploaded {
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: %w", op, attachmentErrors.ErrAttachmentNotUploaded)
+	}
+
+	isMember, err := uc.chatValidator.IsMember(ctx, attachment.ChatID, userID)
+	if err != nil {
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	objectKey, err := previewObjectKey(attachment, req.Size)
+	if err != nil {
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	downloadURL, err := uc.urlSvc.GenerateURL(ctx, objectKey)
+	if err != nil {
+		uc.log.Error("Failed to generate download url", withFields("error", err.Error())...)
+		return dto.GetDownloadURLResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.GetDownloadURLResponse{URL: downloadURL}, nil
+}
+
+// previewObjectKey picks the object to download, the original unless a preview size is asked for.
+func previewObjectKey(a entity.Attachment, size string) (string, error) {
+	if size == "" {
+		return a.ObjectKey, nil
+	}
+	if !vo.PreviewSize(size).IsValid() {
+		return "", attachmentErrors.ErrInvalidPreviewSize
+	}
+	for _, p := range a.Previews {
+		if p.Size == vo.PreviewSize(size) {
+			return p.ObjectKey, nil
+		}
+	}
+	return "", attachmentErrors.ErrPreviewNotFound
+}
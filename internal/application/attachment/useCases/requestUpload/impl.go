@@ -0,0 +1,138 @@
+package requestUpload
+
+import (
+	"awesome-chat/internal/application/attachment/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/attachment/entity"
+	"awesome-chat/internal/domain/core/attachment/ports"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const maxFileNameLen = 255
+
+type AttachmentRequestUploadUseCase struct {
+	log       appPorts.Logger
+	store     ports.CreateStore
+	sendGuard chatPorts.SendGuard
+	bucket    s3.BucketEnsurer
+	urlSvc    s3.UploadURLService
+}
+
+func NewAttachmentRequestUploadUseCase(
+	log appPorts.Logger,
+	store ports.CreateStore,
+	sendGuard chatPorts.SendGuard,
+	bucket s3.BucketEnsurer,
+	urlSvc s3.UploadURLService,
+) *AttachmentRequestUploadUseCase {
+	return &AttachmentRequestUploadUseCase{
+		log:       log,
+		store:     store,
+		sendGuard: sendGuard,
+		bucket:    bucket,
+		urlSvc:    urlSvc,
+	}
+}
+
+func (uc *AttachmentRequestUploadUseCase) Execute(
+	ctx context.Context,
+	req dto.RequestUploadRequest,
+) (
+	dto.RequestUploadResponse,
+	error,
+) {
+	const op = "AttachmentRequestUploadUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{
+			"op", op,
+			"user_id", req.UserID,
+			"chat_id", req.ChatID,
+			"mime_type", req.MimeType,
+			"size", req.Size,
+		}, args...)
+	}
+
+	uc.log.Info("Attempting to request attachment upload", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	kind, err := vo.Classify(req.MimeType, req.Size)
+	if err != nil {
+		uc.log.Warn("Attachment rejected", withFields("error", err.Error())...)
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	checksum, err := vo.NewChecksum(req.Checksum)
+	if err != nil {
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// members who cannot send would never get to attach the file, they get no url to fill the bucket with
+	if err := uc.sendGuard.RequireSend(ctx, chatID, userID); err != nil {
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attachmentID := uuid.New()
+	attachment := entity.Attachment{
+		ID:         attachmentID,
+		UploaderID: userID,
+		ChatID:     chatID,
+		Kind:       kind,
+		ObjectKey:  entity.ObjectKeyFor(chatID, attachmentID),
+		FileName:   sanitizeFileName(req.FileName, attachmentID),
+		MimeType:   vo.NormalizeMimeType(req.MimeType),
+		Size:       req.Size,
+		Checksum:   checksum,
+		Status:     vo.StatusPending,
+	}
+
+	if err = uc.bucket.EnsureBucket(ctx); err != nil {
+		uc.log.Error("Failed to ensure bucket", withFields("error", err.Error())...)
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uploadURL, err := uc.urlSvc.GenerateUploadURL(ctx, attachment.ObjectKey)
+	if err != nil {
+		uc.log.Error("Failed to generate upload url", withFields("error", err.Error())...)
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.store.Create(ctx, attachment); err != nil {
+		uc.log.Error("Failed to save attachment", withFields("error", err.Error())...)
+		return dto.RequestUploadResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully requested attachment upload",
+		withFields("attachment_id", attachmentID.String())...)
+
+	return dto.RequestUploadResponse{
+		AttachmentID: attachmentID.String(),
+		Kind:         kind.String(),
+		UploadURL:    uploadURL,
+	}, nil
+}
+
+func sanitizeFileName(name string, fallback uuid.UUID) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, "\\", "/")))
+	if name == "" || name == "." || name == "/" {
+		return fallback.String()
+	}
+	if runes := []rune(name); len(runes) > maxFileNameLen {
+		name = string(runes[:maxFileNameLen])
+	}
+	return name
+}
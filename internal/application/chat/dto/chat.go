@@ -1,17 +1,49 @@
 package dto
 
 type CreateChatRequest struct {
-	Name      string   `json:"name"`
+	Name string `json:"name"`
+	// CreatorID owns the chat, it is required.
+	CreatorID string   `json:"creator_id"`
 	MemberIDs []string `json:"member_ids"`
+	// Type is "group" when empty or "channel", where the other members join as read-only subscribers.
+	Type string `json:"type,omitempty"`
 }
 
 type ChatResponse struct {
 	ID        string `json:"id"`
 	Name      string `json:"name,omitempty"`
+	Type      string `json:"type,omitempty"`
 	CreatedAt string `json:"created_at,omitempty"`
 }
 
 type AddUserRequest struct {
-	ChatID string `json:"chat_id" validate:"required"`
-	UserID string `json:"user_id" validate:"required"`
+	ChatID    string `json:"chat_id" validate:"required"`
+	UserID    string `json:"user_id" validate:"required"`
+	InviterID string `json:"inviter_id" validate:"required"`
+}
+
+type SetMemberRoleRequest struct {
+	ActorID string `json:"actor_id"`
+	ChatID  string `json:"chat_id"`
+	UserID  string `json:"user_id"`
+	Role    string `json:"role"` // "admin", "member" or "read_only"
+}
+
+// MemberRoleEvent is broadcast to the chat when a member gets a new role.
+type MemberRoleEvent struct {
+	ActorID string `json:"actor_id"`
+	UserID  string `json:"user_id"`
+	Role    string `json:"role"`
+}
+
+type OpenDirectRequest struct {
+	UserID string `json:"user_id"`
+	PeerID string `json:"peer_id"`
+}
+
+type DirectChatResponse struct {
+	ID     string `json:"id"`
+	PeerID string `json:"peer_id"`
+	// Created is false when the pair already had a chat.
+	Created bool `json:"created"`
 }
@@ -1,6 +0,0 @@
-package dto
-
-type (
-	ChatID      string
-	AllMessages []Message
-)
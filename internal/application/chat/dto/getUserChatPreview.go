@@ -1,18 +1,48 @@
 package dto
 
 type (
-	UserID string
-
+	GetUserChatPreviewRequest struct {
+		UserID          string `json:"user_id"`
+		IncludeArchived bool   `json:"include_archived"`
+		Limit           int    `json:"limit,omitempty"`
+		Cursor          string `json:"cursor,omitempty"`
+	}
 	GetUserChatPreviewResponse struct {
 		ChatPreviews []ChatPreview `json:"chat_previews"`
+		// Total counts the chats of all pages.
+		Total      int    `json:"total"`
+		NextCursor string `json:"next_cursor,omitempty"`
+	}
+	// InvalidatePreviewsRequest names the chats whose members' lists are dropped, UserIDs are dropped as well.
+	InvalidatePreviewsRequest struct {
+		ChatIDs []string `json:"chat_ids,omitempty"`
+		UserIDs []string `json:"user_ids,omitempty"`
 	}
 	ChatPreview struct {
-		ChatID       string        `json:"chat_id"`
-		Name         string        `json:"name"`
-		LastMessage  Message       `json:"last_message,omitempty"`
-		UnreadCount  int           `json:"unread_count"`
-		AvatarURL    string        `json:"avatar_url,omitempty"`
-		Participants []Participant `json:"participants,omitempty"`
+		ChatID         string  `json:"chat_id"`
+		Type           string  `json:"type"`
+		Name           string  `json:"name"`
+		Description    string  `json:"description,omitempty"`
+		LastMessage    Message `json:"last_message,omitempty"`
+		UnreadCount    int     `json:"unread_count"`
+		UnreadMentions int     `json:"unread_mentions"`
+		AvatarURL      string  `json:"avatar_url,omitempty"`
+		AvatarThumbURL string  `json:"avatar_thumb_url,omitempty"`
+		// Participants lists a few members besides the user for an avatar stack, none for channels.
+		Participants     []Participant `json:"participants,omitempty"`
+		ParticipantCount int           `json:"participant_count"`
+		PinnedMessageIDs []int         `json:"pinned_message_ids"`
+		TTLSeconds       int           `json:"ttl_seconds,omitempty"`
+		Draft            *Draft        `json:"draft,omitempty"`
+		Role             string        `json:"role"`
+		Preferences      Preferences   `json:"preferences"`
+		// Peer is the other user of a direct chat, whose username also stands in for the name.
+		Peer *Participant `json:"peer,omitempty"`
+	}
+	// Draft is shown instead of the last message ("Draft: ...") while the user has unsent text.
+	Draft struct {
+		Content   string `json:"content"`
+		UpdatedAt string `json:"updated_at"`
 	}
 	Message struct {
 		UserID    string `json:"user_id"`
@@ -24,5 +54,6 @@ type (
 		Username  string `json:"username"`
 		AvatarURL string `json:"avatar_url,omitempty"`
 		IsOnline  bool   `json:"is_online,omitempty"`
+		Role      string `json:"role"`
 	}
 )
@@ -0,0 +1,105 @@
+package dto
+
+import (
+	"time"
+
+	"awesome-chat/internal/domain/core/chat/entity"
+)
+
+type (
+	CreateInviteRequest struct {
+		ActorID          string `json:"actor_id"`
+		ChatID           string `json:"chat_id"`
+		TTLSeconds       int64  `json:"ttl_seconds"`
+		MaxUses          int    `json:"max_uses"`
+		RequiresApproval bool   `json:"requires_approval"`
+	}
+	ListInvitesRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id"`
+	}
+	RevokeInviteRequest struct {
+		ActorID  string `json:"actor_id"`
+		ChatID   string `json:"chat_id"`
+		InviteID string `json:"invite_id"`
+	}
+	Invite struct {
+		ID               string `json:"id"`
+		ChatID           string `json:"chat_id"`
+		Token            string `json:"token"`
+		CreatedBy        string `json:"created_by"`
+		ExpiresAt        string `json:"expires_at,omitempty"`
+		MaxUses          int    `json:"max_uses"`
+		Uses             int    `json:"uses"`
+		RequiresApproval bool   `json:"requires_approval"`
+		CreatedAt        string `json:"created_at"`
+	}
+
+	JoinByInviteRequest struct {
+		UserID string `json:"user_id"`
+		Token  string `json:"token"`
+	}
+	// JoinResponse tells whether the user is in the chat or waits for an admin.
+	JoinResponse struct {
+		ChatID string `json:"chat_id"`
+		Status string `json:"status"`
+	}
+
+	ListJoinRequestsRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id"`
+	}
+	JoinRequest struct {
+		UserID    string `json:"user_id"`
+		Username  string `json:"username"`
+		InviteID  string `json:"invite_id,omitempty"`
+		CreatedAt string `json:"created_at"`
+	}
+	DecideJoinRequestRequest struct {
+		ActorID string `json:"actor_id"`
+		ChatID  string `json:"chat_id"`
+		UserID  string `json:"user_id"`
+		Approve bool   `json:"approve"`
+	}
+
+	// JoinRequestEvent is the payload of join_requested and join_request_decided, the actor is empty while pending.
+	JoinRequestEvent struct {
+		ActorID string `json:"actor_id,omitempty"`
+		UserID  string `json:"user_id"`
+		Status  string `json:"status"`
+	}
+)
+
+const (
+	JoinStatusJoined  = "joined"
+	JoinStatusPending = "pending"
+)
+
+func NewInvite(i entity.Invite) Invite {
+	invite := Invite{
+		ID:               i.ID.String(),
+		ChatID:           i.ChatID.String(),
+		Token:            i.Token,
+		CreatedBy:        i.CreatedBy.String(),
+		MaxUses:          i.MaxUses,
+		Uses:             i.Uses,
+		RequiresApproval: i.RequiresApproval,
+		CreatedAt:        i.CreatedAt.Format(time.RFC3339),
+	}
+	if i.ExpiresAt != nil {
+		invite.ExpiresAt = i.ExpiresAt.Format(time.RFC3339)
+	}
+	return invite
+}
+
+func NewJoinRequest(r entity.JoinRequest) JoinRequest {
+	request := JoinRequest{
+		UserID:    r.UserID.String(),
+		Username:  r.Username,
+		CreatedAt: r.CreatedAt.Format(time.RFC3339),
+	}
+	if r.InviteID != nil {
+		request.InviteID = r.InviteID.String()
+	}
+	return request
+}
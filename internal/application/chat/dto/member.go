@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"time"
+
+	"awesome-chat/internal/domain/core/chat/entity"
+	"awesome-chat/internal/domain/core/chat/vo"
+)
+
+type (
+	LeaveChatRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id"`
+	}
+	RemoveMemberRequest struct {
+		ActorID string `json:"actor_id"`
+		ChatID  string `json:"chat_id"`
+		UserID  string `json:"user_id"`
+	}
+
+	// MemberEvent is the payload of member_joined and member_removed, the actor is the user themselves when they left or joined by link.
+	MemberEvent struct {
+		ActorID string `json:"actor_id"`
+		UserID  string `json:"user_id"`
+	}
+	// SystemMessage is sent like a regular message, clients render it from System and fall back to Content.
+	SystemMessage struct {
+		ID        int              `json:"id"`
+		Type      string           `json:"type"`
+		UserID    string           `json:"user_id"`
+		ChatID    string           `json:"chat_id"`
+		Content   string           `json:"content"`
+		System    vo.SystemPayload `json:"system"`
+		Timestamp string           `json:"timestamp"`
+	}
+)
+
+func NewSystemMessage(m entity.SystemMessage) SystemMessage {
+	return SystemMessage{
+		ID:        m.ID,
+		Type:      entity.SystemMessageType,
+		UserID:    m.Payload.ActorID.String(),
+		ChatID:    m.ChatID.String(),
+		Content:   m.Content,
+		System:    m.Payload,
+		Timestamp: m.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,9 @@
+package dto
+
+type (
+	SetMentionPolicyRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id"`
+		Policy string `json:"policy"` // "ignore" or "reject"
+	}
+)
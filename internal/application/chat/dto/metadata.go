@@ -0,0 +1,35 @@
+package dto
+
+import "io"
+
+type (
+	UpdateChatRequest struct {
+		ActorID     string  `json:"actor_id"`
+		ChatID      string  `json:"chat_id"`
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+	}
+	SetChatAvatarRequest struct {
+		ActorID string    `json:"actor_id"`
+		ChatID  string    `json:"chat_id"`
+		Image   io.Reader `json:"-"`
+	}
+	RemoveChatAvatarRequest struct {
+		ActorID string `json:"actor_id"`
+		ChatID  string `json:"chat_id"`
+	}
+
+	// ChatMetadata carries pre-signed avatar urls, they expire like attachment urls and are fetched again with the previews.
+	ChatMetadata struct {
+		ChatID         string `json:"chat_id"`
+		Name           string `json:"name"`
+		Description    string `json:"description"`
+		AvatarURL      string `json:"avatar_url,omitempty"`
+		AvatarThumbURL string `json:"avatar_thumb_url,omitempty"`
+		UpdatedAt      string `json:"updated_at"`
+	}
+	ChatUpdatedEvent struct {
+		ActorID string       `json:"actor_id"`
+		Chat    ChatMetadata `json:"chat"`
+	}
+)
@@ -0,0 +1,29 @@
+package dto
+
+type (
+	PinMessageRequest struct {
+		UserID    string `json:"user_id"`
+		ChatID    string `json:"chat_id"`
+		MessageID int    `json:"message_id"`
+	}
+	GetPinsRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id"`
+	}
+	GetPinsResponse struct {
+		Pins  []Pin `json:"pins"`
+		Count int   `json:"count"`
+	}
+	Pin struct {
+		MessageID int     `json:"message_id"`
+		Message   Message `json:"message"`
+		PinnedBy  string  `json:"pinned_by"`
+		PinnedAt  string  `json:"pinned_at"`
+	}
+
+	// PinEvent is broadcast to the chat on pin and unpin.
+	PinEvent struct {
+		MessageID int    `json:"message_id"`
+		UserID    string `json:"user_id"`
+	}
+)
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"awesome-chat/internal/domain/core/chat/entity"
+)
+
+type (
+	// SetPreferencesRequest leaves out fields which stay as they are, muted_until takes
+	// an RFC3339 time, "forever" or "" to unmute and sort_order 0 clears the manual order.
+	SetPreferencesRequest struct {
+		UserID     string  `json:"user_id"`
+		ChatID     string  `json:"chat_id"`
+		MutedUntil *string `json:"muted_until"`
+		Archived   *bool   `json:"archived"`
+		Pinned     *bool   `json:"pinned"`
+		SortOrder  *int    `json:"sort_order"`
+	}
+	Preferences struct {
+		Muted      bool   `json:"muted"`
+		MutedUntil string `json:"muted_until,omitempty"`
+		Archived   bool   `json:"archived"`
+		Pinned     bool   `json:"pinned"`
+		SortOrder  int    `json:"sort_order,omitempty"`
+	}
+	// PreferencesEvent goes to the other devices of the member only.
+	PreferencesEvent struct {
+		ChatID      string      `json:"chat_id"`
+		Preferences Preferences `json:"preferences"`
+	}
+)
+
+func NewPreferences(p entity.ChatPreferences, now time.Time) Preferences {
+	prefs := Preferences{
+		Muted:     p.IsMuted(now),
+		Archived:  p.Archived,
+		Pinned:    p.Pinned,
+		SortOrder: p.SortOrder,
+	}
+	if prefs.Muted {
+		prefs.MutedUntil = p.MutedUntil.Format(time.RFC3339)
+	}
+	return prefs
+}
@@ -0,0 +1,15 @@
+package dto
+
+type (
+	SetMessageTTLRequest struct {
+		UserID     string `json:"user_id"`
+		ChatID     string `json:"chat_id"`
+		TTLSeconds int    `json:"ttl_seconds"` // 0 turns expiry off
+	}
+
+	// MessageTTLEvent is broadcast to the chat when its ttl changes.
+	MessageTTLEvent struct {
+		UserID     string `json:"user_id"`
+		TTLSeconds int    `json:"ttl_seconds"`
+	}
+)
@@ -2,7 +2,14 @@ package addMember
 
 import (
 	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
 	"awesome-chat/internal/domain/core/chat/ports"
+	chatVO "awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
 	userPorts "awesome-chat/internal/domain/core/user/ports"
 	"awesome-chat/internal/domain/core/user/vo"
 	"context"
@@ -13,24 +20,45 @@ import (
 )
 
 type ChatAddMemberUseCase struct {
+	log           appPorts.Logger
+	txManager     sharedPorts.TransactionManager
 	chatStore     ports.AddMemberStore
 	chatValidator ports.ValidateStore
 	userValidator userPorts.UserValidatorStore
+	permissions   ports.PermissionChecker
+	chatTypes     ports.ChatTypeStore
+	system        ports.SystemMessageStore
+	users         userPorts.UserGetStore
+	publisher     sharedPorts.ChatEventPublisher
 }
 
 func NewChatAddMemberUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
 	chatStore ports.AddMemberStore,
 	chatValidator ports.ValidateStore,
 	userValidator userPorts.UserValidatorStore,
+	permissions ports.PermissionChecker,
+	chatTypes ports.ChatTypeStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	publisher sharedPorts.ChatEventPublisher,
 ) *ChatAddMemberUseCase {
 	return &ChatAddMemberUseCase{
+		log:           log,
+		txManager:     txManager,
 		chatStore:     chatStore,
 		chatValidator: chatValidator,
 		userValidator: userValidator,
+		permissions:   permissions,
+		chatTypes:     chatTypes,
+		system:        system,
+		users:         users,
+		publisher:     publisher,
 	}
 }
 
-func (uc *ChatAddMemberUseCase) Execute(ctx context.Context, req dto.AddUserRequest) error {
+func (uc *ChatAddMemberUseCase) Execute(ctx context.Context, req dto.AddUserRequest) (err error) {
 	chatID, err := uuid.Parse(req.ChatID)
 	if err != nil {
 		return fmt.Errorf("invalid chat ID: %w", err)
@@ -41,11 +69,28 @@ func (uc *ChatAddMemberUseCase) Execute(ctx context.Context, req dto.AddUserRequ
 		return fmt.Errorf("invalid user ID: %w", err)
 	}
 
+	inviterID, err := uuid.Parse(req.InviterID)
+	if err != nil {
+		return fmt.Errorf("invalid inviter ID: %w", err)
+	}
+
+	var chatType chatVO.ChatType
 	g, groupCtx := errgroup.WithContext(ctx)
 
 	g.Go(func() error {
-		if err = uc.chatValidator.ValidateExists(groupCtx, chatID); err != nil {
-			return fmt.Errorf("chat validation failed: %w", err)
+		if _, checkErr := uc.permissions.Require(groupCtx, chatID, inviterID, chatVO.PermissionAddMembers); checkErr != nil {
+			return fmt.Errorf("inviter permission: %w", checkErr)
+		}
+		return nil
+	})
+
+	g.Go(func() error {
+		var typeErr error
+		if chatType, typeErr = uc.chatTypes.ChatType(groupCtx, chatID); typeErr != nil {
+			return fmt.Errorf("chat validation failed: %w", typeErr)
+		}
+		if chatType == chatVO.ChatTypeDirect {
+			return fmt.Errorf("chat validation failed: %w", chatErrors.ErrDirectChatMembers) // 409
 		}
 		return nil
 	})
@@ -54,14 +99,14 @@ func (uc *ChatAddMemberUseCase) Execute(ctx context.Context, req dto.AddUserRequ
 		if isMember, validateErr := uc.chatValidator.IsMember(groupCtx, chatID, userID); validateErr != nil {
 			return fmt.Errorf("chat is member: %w", validateErr)
 		} else if isMember {
-			return fmt.Errorf("user already in chat") // 409
+			return fmt.Errorf("user already in chat: %w", chatErrors.ErrAlreadyMember) // 409
 		}
 		return nil
 	})
 
 	g.Go(func() error {
-		if err = uc.userValidator.ValidateByID(groupCtx, vo.UserID(userID)); err != nil {
-			return fmt.Errorf("user validation failed: %w", err)
+		if validateErr := uc.userValidator.ValidateByID(groupCtx, vo.UserID(userID)); validateErr != nil {
+			return fmt.Errorf("user validation failed: %w", validateErr)
 		}
 		return nil
 	})
@@ -70,9 +115,55 @@ func (uc *ChatAddMemberUseCase) Execute(ctx context.Context, req dto.AddUserRequ
 		return err
 	}
 
-	if err = uc.chatStore.AddMember(ctx, chatID, userID); err != nil {
+	inviter, err := uc.users.Execute(ctx, inviterID)
+	if err != nil {
+		return fmt.Errorf("inviter: %w", err)
+	}
+	user, err := uc.users.Execute(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user: %w", err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	if err = uc.chatStore.AddMember(txCtx, chatID, userID, chatType.JoinRole()); err != nil {
 		return fmt.Errorf("failed to add member: %w", err)
 	}
 
+	joined, err := eventEntity.NewGrantingChatEvent(eventVo.MemberJoined, chatID, userID, dto.MemberEvent{
+		ActorID: req.InviterID,
+		UserID:  req.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	sent, err := announce.Message(txCtx, uc.system, chatID,
+		chatVO.NewSystemPayload(chatVO.SystemMemberAdded, inviterID).WithTarget(userID),
+		fmt.Sprintf("%s added %s to the chat", inviter.Username, user.Username),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return fmt.Errorf("commit failed: %w", err)
+	}
+
+	for _, event := range []eventEntity.ChatEvent{joined, sent} {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			// the member is stored, clients catch up on the next preview load
+			uc.log.Error("Failed to publish chat event",
+				"op", "ChatAddMemberUseCase.Execute", "chat_id", req.ChatID, "event_type", event.Type, "error", pubErr.Error())
+		}
+	}
+
 	return nil
 }
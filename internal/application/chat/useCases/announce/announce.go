@@ -0,0 +1,62 @@
+package announce
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Message saves the system message in the transaction of ctx and builds the event delivering it to the chat,
+// the event is published by the caller once the change is committed.
+func Message(
+	ctx context.Context,
+	system ports.SystemMessageStore,
+	chatID uuid.UUID,
+	payload vo.SystemPayload,
+	content string,
+) (eventEntity.ChatEvent, error) {
+	message, err := system.SaveSystem(ctx, chatID, payload, content)
+	if err != nil {
+		return eventEntity.ChatEvent{}, fmt.Errorf("failed to save system message: %w", err)
+	}
+
+	return eventEntity.NewChatEvent(eventVo.MessageSent, chatID, dto.NewSystemMessage(message))
+}
+
+// Joined builds the events of a user who joined the chat on their own or was let in. The live connections of the user
+// are subscribed to the chat by the event, a channel only tells the user since subscribers are not told about each other.
+func Joined(
+	ctx context.Context,
+	system ports.SystemMessageStore,
+	chatType vo.ChatType,
+	chatID, actorID, userID uuid.UUID,
+	username string,
+) ([]eventEntity.ChatEvent, error) {
+	joined, err := eventEntity.NewGrantingChatEvent(eventVo.MemberJoined, chatID, userID, dto.MemberEvent{
+		ActorID: actorID.String(),
+		UserID:  userID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !chatType.AnnouncesMembers() {
+		joined.RecipientID = &userID
+		return []eventEntity.ChatEvent{joined}, nil
+	}
+
+	sent, err := Message(ctx, system, chatID,
+		vo.NewSystemPayload(vo.SystemMemberJoined, userID),
+		fmt.Sprintf("%s joined the chat", username),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []eventEntity.ChatEvent{joined, sent}, nil
+}
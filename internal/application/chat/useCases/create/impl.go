@@ -20,20 +20,26 @@ type ChatCreateUseCase struct {
 	log             appPorts.Logger
 	txManager       sharedPorts.TransactionManager
 	chatCreateStore ports.CreateWithMembersStore
+	system          ports.SystemMessageStore
 	userValidator   userPorts.UserValidatorStore
+	previews        ports.PreviewCache
 }
 
 func NewChatCreateUseCase(
 	log appPorts.Logger,
 	txManager sharedPorts.TransactionManager,
 	store ports.CreateWithMembersStore,
+	system ports.SystemMessageStore,
 	userValidator userPorts.UserValidatorStore,
+	previews ports.PreviewCache,
 ) *ChatCreateUseCase {
 	return &ChatCreateUseCase{
 		log:             log,
 		txManager:       txManager,
 		chatCreateStore: store,
+		system:          system,
 		userValidator:   userValidator,
+		previews:        previews,
 	}
 }
 
@@ -51,6 +57,7 @@ func (uc *ChatCreateUseCase) Execute(
 			"op", op,
 			"chat_id", chatID.String(),
 			"chat_name", req.Name,
+			"chat_type", req.Type,
 			"member_count", len(req.MemberIDs),
 		}, args...)
 	}
@@ -58,6 +65,7 @@ func (uc *ChatCreateUseCase) Execute(
 
 	if err := uc.basicValidation(
 		req.Name,
+		req.CreatorID,
 		req.MemberIDs,
 		op,
 		withFields,
@@ -65,7 +73,14 @@ func (uc *ChatCreateUseCase) Execute(
 		return nil, err
 	}
 
-	members, err := uc.prepareMembers(req.MemberIDs, op, withFields)
+	chatType, err := vo.ParseChatType(req.Type)
+	if err != nil {
+		uc.log.Error("Failed to create new chat",
+			withFields("error", err.Error())...)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	members, err := uc.prepareMembers(req.CreatorID, req.MemberIDs, op, withFields)
 	if err != nil {
 		return nil, err
 	}
@@ -92,34 +107,57 @@ func (uc *ChatCreateUseCase) Execute(
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if txErr = uc.chatCreateStore.CreateChat(ctxWithTx, vo.ChatID(chatID), req.Name); txErr != nil {
+	if txErr = uc.chatCreateStore.CreateChat(ctxWithTx, vo.ChatID(chatID), req.Name, chatType); txErr != nil {
 		uc.log.Error("Failed to create new chat",
 			withFields("error", txErr.Error())...)
 		return nil, fmt.Errorf("%s: %w", op, txErr)
 	}
 
-	if txErr = uc.chatCreateStore.AddMembers(ctxWithTx, vo.ChatID(chatID), members); txErr != nil {
+	if txErr = uc.chatCreateStore.AddMembers(ctxWithTx, vo.ChatID(chatID), members[:1], vo.RoleOwner); txErr != nil {
+		uc.log.Error("Failed to add owner to chat",
+			withFields("error", txErr.Error())...)
+		return nil, fmt.Errorf("%s: %w", op, txErr)
+	}
+
+	if txErr = uc.chatCreateStore.AddMembers(ctxWithTx, vo.ChatID(chatID), members[1:], chatType.JoinRole()); txErr != nil {
 		uc.log.Error("Failed to add members to chat",
 			withFields("error", txErr.Error())...)
 		return nil, fmt.Errorf("%s: %w", op, txErr)
 	}
 
+	// nobody reads the chat before commit, the message opens the timeline without an event
+	if _, txErr = uc.system.SaveSystem(ctxWithTx, chatID,
+		vo.NewSystemPayload(vo.SystemChatCreated, members[0]).WithName(req.Name),
+		fmt.Sprintf("Chat %q created", req.Name),
+	); txErr != nil {
+		uc.log.Error("Failed to save system message",
+			withFields("error", txErr.Error())...)
+		return nil, fmt.Errorf("%s: %w", op, txErr)
+	}
+
 	if txErr = uc.txManager.CommitTx(ctxWithTx); txErr != nil {
 		uc.log.Error("Failed to commit transaction",
 			withFields("error", txErr.Error())...)
 		return nil, fmt.Errorf("commit failed: %w", txErr)
 	}
 
+	// no event announces a new chat, the cached lists of the members would not show it until they expire
+	if err = uc.previews.Invalidate(ctx, members); err != nil {
+		uc.log.Warn("Failed to invalidate previews", withFields("error", err.Error())...)
+	}
+
 	uc.log.Info("Successfully created new chat", withFields()...)
 
 	return &dto.ChatResponse{
 		ID:   chatID.String(),
 		Name: req.Name,
+		Type: chatType.String(),
 	}, nil
 }
 
 func (uc *ChatCreateUseCase) basicValidation(
 	chatName string,
+	creatorID string,
 	memberIDs []string,
 	op string,
 	logFields func(...any) []any,
@@ -129,6 +167,12 @@ func (uc *ChatCreateUseCase) basicValidation(
 			logFields("error", chatErrors.ErrChatShortName.Error())...)
 		return fmt.Errorf("%s: %w", op, chatErrors.ErrChatShortName)
 	}
+	// the owner is never guessed from the members, an invitee would end up owning the chat
+	if creatorID == "" {
+		uc.log.Error("Failed to create new chat",
+			logFields("error", chatErrors.ErrChatCreatorRequired.Error())...)
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrChatCreatorRequired)
+	}
 	if len(memberIDs) < 1 {
 		uc.log.Error("Failed to create new chat",
 			logFields("error", chatErrors.ErrChatInvalidMembersLen.Error())...)
@@ -138,12 +182,17 @@ func (uc *ChatCreateUseCase) basicValidation(
 	return nil
 }
 
+// prepareMembers keeps the request order and puts the owner first.
 func (uc *ChatCreateUseCase) prepareMembers(
+	creatorID string,
 	memberIDs []string,
 	op string,
 	logFields func(...any) []any,
 ) (userVO.UserIDs, error) {
-	unique := make(map[uuid.UUID]struct{})
+	memberIDs = append([]string{creatorID}, memberIDs...)
+
+	unique := make(map[uuid.UUID]struct{}, len(memberIDs))
+	result := make(userVO.UserIDs, 0, len(memberIDs))
 	for _, idStr := range memberIDs {
 		id, err := uuid.Parse(idStr)
 		if err != nil {
@@ -151,15 +200,14 @@ func (uc *ChatCreateUseCase) prepareMembers(
 			return nil, fmt.Errorf("%s: invalid member ID format: %w", op, err)
 		}
 		if _, exists := unique[id]; exists {
-			uc.log.Warn("Duplicate member ID", logFields("member_id", idStr)...)
-			continue // or return nil, errors.New("duplicate member ID")
+			if id.String() != creatorID {
+				uc.log.Warn("Duplicate member ID", logFields("member_id", idStr)...)
+			}
+			continue
 		}
 		unique[id] = struct{}{}
-	}
-
-	result := make(userVO.UserIDs, 0, len(unique))
-	for id := range unique {
 		result = append(result, id)
 	}
+
 	return result, nil
 }
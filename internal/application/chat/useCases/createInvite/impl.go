@@ -0,0 +1,98 @@
+package createInvite
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatCreateInviteUseCase struct {
+	log         appPorts.Logger
+	permissions ports.PermissionChecker
+	chatTypes   ports.ChatTypeStore
+	invites     ports.InviteStore
+}
+
+func NewChatCreateInviteUseCase(
+	log appPorts.Logger,
+	permissions ports.PermissionChecker,
+	chatTypes ports.ChatTypeStore,
+	invites ports.InviteStore,
+) *ChatCreateInviteUseCase {
+	return &ChatCreateInviteUseCase{
+		log:         log,
+		permissions: permissions,
+		chatTypes:   chatTypes,
+		invites:     invites,
+	}
+}
+
+// Execute creates an invite link, whoever may add members may hand out links.
+func (uc *ChatCreateInviteUseCase) Execute(ctx context.Context, req dto.CreateInviteRequest) (dto.Invite, error) {
+	const op = "ChatCreateInviteUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "actor_id", req.ActorID}, args...)
+	}
+
+	uc.log.Info("Attempting to create chat invite", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.Invite{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return dto.Invite{}, fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+	settings, err := vo.NewInviteSettings(time.Duration(req.TTLSeconds)*time.Second, req.MaxUses, req.RequiresApproval, now)
+	if err != nil {
+		return dto.Invite{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, actorID, vo.PermissionAddMembers); err != nil {
+		uc.log.Warn("Invite rejected", withFields("error", err.Error())...)
+		return dto.Invite{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatType, err := uc.chatTypes.ChatType(ctx, chatID)
+	if err != nil {
+		return dto.Invite{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if chatType == vo.ChatTypeDirect {
+		return dto.Invite{}, fmt.Errorf("%s: %w", op, chatErrors.ErrDirectChatMembers)
+	}
+
+	token, err := vo.NewInviteToken()
+	if err != nil {
+		return dto.Invite{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	invite := entity.Invite{
+		ID:               uuid.New(),
+		ChatID:           chatID,
+		Token:            token,
+		CreatedBy:        actorID,
+		ExpiresAt:        settings.ExpiresAt,
+		MaxUses:          settings.MaxUses,
+		RequiresApproval: settings.RequiresApproval,
+		CreatedAt:        now,
+	}
+	if err = uc.invites.CreateInvite(ctx, invite); err != nil {
+		uc.log.Error("Failed to create chat invite", withFields("error", err.Error())...)
+		return dto.Invite{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully created chat invite", withFields("invite_id", invite.ID)...)
+
+	return dto.NewInvite(invite), nil
+}
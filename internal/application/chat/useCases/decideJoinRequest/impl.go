@@ -0,0 +1,151 @@
+package decideJoinRequest
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	userVO "awesome-chat/internal/domain/core/user/vo"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatDecideJoinRequestUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions ports.PermissionChecker
+	requests    ports.JoinRequestStore
+	members     ports.CreateWithMembersStore
+	chatTypes   ports.ChatTypeStore
+	system      ports.SystemMessageStore
+	users       userPorts.UserGetStore
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewChatDecideJoinRequestUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions ports.PermissionChecker,
+	requests ports.JoinRequestStore,
+	members ports.CreateWithMembersStore,
+	chatTypes ports.ChatTypeStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatDecideJoinRequestUseCase {
+	return &ChatDecideJoinRequestUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		requests:    requests,
+		members:     members,
+		chatTypes:   chatTypes,
+		system:      system,
+		users:       users,
+		publisher:   publisher,
+	}
+}
+
+// Execute approves or rejects a pending request, an approved user joins as a member, channels take subscribers.
+func (uc *ChatDecideJoinRequestUseCase) Execute(ctx context.Context, req dto.DecideJoinRequestRequest) (err error) {
+	const op = "ChatDecideJoinRequestUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "actor_id", req.ActorID, "user_id", req.UserID, "approve", req.Approve}, args...)
+	}
+
+	uc.log.Info("Attempting to decide join request", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, actorID, vo.PermissionAddMembers); err != nil {
+		uc.log.Warn("Decision rejected", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	status := vo.JoinRequestRejected
+	if req.Approve {
+		status = vo.JoinRequestApproved
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	if err = uc.requests.DecideJoinRequest(txCtx, chatID, userID, actorID, status); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	decided, err := eventEntity.NewChatEvent(eventVo.JoinRequestDecided, chatID, dto.JoinRequestEvent{
+		ActorID: req.ActorID,
+		UserID:  req.UserID,
+		Status:  status.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	events := []eventEntity.ChatEvent{decided}
+
+	if req.Approve {
+		var joinEvents []eventEntity.ChatEvent
+		if joinEvents, err = uc.join(txCtx, chatID, actorID, userID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, joinEvents...)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, event := range events {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully decided join request", withFields("status", status)...)
+
+	return nil
+}
+
+func (uc *ChatDecideJoinRequestUseCase) join(ctx context.Context, chatID, actorID, userID uuid.UUID) ([]eventEntity.ChatEvent, error) {
+	user, err := uc.users.Execute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	chatType, err := uc.chatTypes.ChatType(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.members.AddMembers(ctx, vo.ChatID(chatID), userVO.UserIDs{userID}, chatType.JoinRole()); err != nil {
+		return nil, err
+	}
+
+	return announce.Joined(ctx, uc.system, chatType, chatID, actorID, userID, user.Username)
+}
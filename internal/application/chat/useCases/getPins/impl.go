@@ -0,0 +1,84 @@
+package getPins
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatGetPinsUseCase struct {
+	log       appPorts.Logger
+	validator ports.ValidateStore
+	store     ports.PinStore
+}
+
+func NewChatGetPinsUseCase(
+	log appPorts.Logger,
+	validator ports.ValidateStore,
+	store ports.PinStore,
+) *ChatGetPinsUseCase {
+	return &ChatGetPinsUseCase{
+		log:       log,
+		validator: validator,
+		store:     store,
+	}
+}
+
+func (uc *ChatGetPinsUseCase) Execute(ctx context.Context, req dto.GetPinsRequest) (dto.GetPinsResponse, error) {
+	const op = "ChatGetPinsUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to get pinned messages", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.GetPinsResponse{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.GetPinsResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	isMember, err := uc.validator.IsMember(ctx, chatID, userID)
+	if err != nil {
+		return dto.GetPinsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		return dto.GetPinsResponse{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	pins, err := uc.store.List(ctx, chatID)
+	if err != nil {
+		uc.log.Error("Failed to get pinned messages", withFields("error", err.Error())...)
+		return dto.GetPinsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp := dto.GetPinsResponse{
+		Pins:  make([]dto.Pin, 0, len(pins)),
+		Count: len(pins),
+	}
+	for _, pin := range pins {
+		resp.Pins = append(resp.Pins, dto.Pin{
+			MessageID: pin.Message.ID,
+			Message: dto.Message{
+				UserID:    pin.Message.SenderID.String(),
+				Content:   pin.Message.Text,
+				Timestamp: pin.Message.Timestamp.Format(time.RFC3339),
+			},
+			PinnedBy: pin.PinnedBy.String(),
+			PinnedAt: pin.PinnedAt.Format(time.RFC3339),
+		})
+	}
+
+	uc.log.Info("Successfully got pinned messages", withFields("count", resp.Count)...)
+
+	return resp, nil
+}
@@ -3,62 +3,97 @@ package getUserChatPreview
 import (
 	"awesome-chat/internal/application/chat/dto"
 	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/entity"
 	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	draftEntity "awesome-chat/internal/domain/core/draft/entity"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"time"
 )
 
+// draftCache is ahead of postgres while a draft waits to be flushed.
+type draftCache interface {
+	GetMany(ctx context.Context, userID uuid.UUID, chatIDs []uuid.UUID) (map[uuid.UUID]draftEntity.Draft, error)
+}
+
 type ChatGetUserChatPreviewUseCase struct {
-	log   appPorts.Logger
-	store ports.GetUserChatPreviewStore
+	log     appPorts.Logger
+	store   ports.GetUserChatPreviewStore
+	avatars s3.URLService
+	drafts  draftCache
 }
 
 func NewChatGetUserChatPreviewUseCase(
 	log appPorts.Logger,
 	store ports.GetUserChatPreviewStore,
+	avatars s3.URLService,
+	drafts draftCache,
 ) *ChatGetUserChatPreviewUseCase {
 	return &ChatGetUserChatPreviewUseCase{
-		log:   log,
-		store: store,
+		log:     log,
+		store:   store,
+		avatars: avatars,
+		drafts:  drafts,
 	}
 }
 
 func (uc *ChatGetUserChatPreviewUseCase) Execute(
 	ctx context.Context,
-	userID dto.UserID,
+	req dto.GetUserChatPreviewRequest,
 ) (
 	dto.GetUserChatPreviewResponse,
 	error,
 ) {
 	const op = "ChatGetUserChatPreviewUseCase.SetupChatPreviews"
 	withFields := func(args ...any) []any {
-		return append([]any{"op", op, "user_id", string(userID)}, args...)
+		return append([]any{"op", op, "user_id", req.UserID, "include_archived", req.IncludeArchived, "cursor", req.Cursor}, args...)
 	}
 
 	uc.log.Info("Attempting to get user chats", withFields()...)
 
-	id, err := uuid.Parse(string(userID))
+	id, err := uuid.Parse(req.UserID)
 	if err != nil {
 		uc.log.Error("Failed to parse user id", withFields("error", err.Error())...)
 		return emptyWithErr(fmt.Errorf("%s: %w", op, err))
 	}
 
-	previews, err := uc.store.SetupChatPreviews(ctx, id)
+	page, err := vo.NewPreviewPage(req.Cursor, req.Limit)
+	if err != nil {
+		uc.log.Warn("Invalid preview page", withFields("error", err.Error())...)
+		return emptyWithErr(fmt.Errorf("%s: %w", op, err))
+	}
+
+	result, err := uc.store.SetupChatPreviews(ctx, id, req.IncludeArchived, page)
 	if err != nil {
 		uc.log.Error("Failed to get user chats", withFields("error", err.Error())...)
 		return emptyWithErr(fmt.Errorf("%s: %w", op, err))
 	}
+	previews := result.Previews
 
+	// a channel may have tens of thousands of subscribers, its preview only carries their count
+	pageIDs := make([]uuid.UUID, 0, len(previews))
 	chatIDs := make([]uuid.UUID, 0, len(previews))
 	for _, p := range previews {
-		chatIDs = append(chatIDs, p.ChatID)
+		pageIDs = append(pageIDs, p.ChatID)
+		if p.Type != vo.ChatTypeChannel {
+			chatIDs = append(chatIDs, p.ChatID)
+		}
+	}
+
+	// the stored drafts stay shown when the cache can not be read
+	if drafts, draftErr := uc.drafts.GetMany(ctx, id, pageIDs); draftErr != nil {
+		uc.log.Warn("Failed to read cached drafts", withFields("error", draftErr.Error())...)
+	} else {
+		overlayDrafts(previews, drafts)
 	}
 
-	participantsByChat, err := uc.store.BatchParticipantsForChats(ctx, chatIDs)
+	participantsByChat, err := uc.store.PreviewParticipants(ctx, id, chatIDs, vo.PreviewParticipants)
 	if err != nil {
-		uc.log.Error("Failed to batch load participants",
+		uc.log.Error("Failed to load preview participants",
 			withFields("error", err.Error())...)
 		return emptyWithErr(fmt.Errorf("%s: %w", op, err))
 	}
@@ -67,13 +102,32 @@ func (uc *ChatGetUserChatPreviewUseCase) Execute(
 		previews[i].Participants = participantsByChat[previews[i].ChatID]
 	}
 
+	now := time.Now()
 	previewsResp := make([]dto.ChatPreview, 0, len(previews))
 	for _, preview := range previews {
 		chatPreviewResp := dto.ChatPreview{
-			ChatID:      preview.ChatID.String(),
-			Name:        preview.Name,
-			UnreadCount: preview.UnreadCount,
-			AvatarURL:   preview.AvatarURL,
+			ChatID:           preview.ChatID.String(),
+			Type:             preview.Type.String(),
+			Name:             preview.Name,
+			Description:      preview.Description,
+			UnreadCount:      preview.UnreadCount,
+			UnreadMentions:   preview.UnreadMentions,
+			PinnedMessageIDs: preview.PinnedMessageIDs,
+			TTLSeconds:       int(preview.MessageTTL / time.Second),
+			ParticipantCount: preview.MemberCount,
+			Role:             preview.Role.String(),
+			Preferences:      dto.NewPreferences(preview.Preferences, now),
+		}
+
+		if !preview.Avatar.IsZero() {
+			// signing is local, a failure leaves the chat without a picture rather than failing the list
+			avatarURL, urlErr := uc.avatars.GenerateURL(ctx, preview.Avatar.Key)
+			thumbURL, thumbErr := uc.avatars.GenerateURL(ctx, preview.Avatar.ThumbKey)
+			if urlErr == nil && thumbErr == nil {
+				chatPreviewResp.AvatarURL, chatPreviewResp.AvatarThumbURL = avatarURL, thumbURL
+			} else {
+				uc.log.Warn("Failed to sign chat avatar", withFields("chat_id", preview.ChatID, "error", errors.Join(urlErr, thumbErr).Error())...)
+			}
 		}
 
 		participantsResp := make([]dto.Participant, 0, len(preview.Participants))
@@ -83,7 +137,14 @@ func (uc *ChatGetUserChatPreviewUseCase) Execute(
 				Username:  participant.Username,
 				AvatarURL: participant.AvatarURL,
 				IsOnline:  participant.IsOnline,
+				Role:      participant.Role.String(),
 			})
+			// the user is left out of the participants, in a direct chat the one listed is the peer
+			if preview.Type == vo.ChatTypeDirect {
+				peer := participantsResp[len(participantsResp)-1]
+				chatPreviewResp.Peer = &peer
+				chatPreviewResp.Name = peer.Username
+			}
 		}
 		chatPreviewResp.Participants = participantsResp
 
@@ -95,12 +156,38 @@ func (uc *ChatGetUserChatPreviewUseCase) Execute(
 			}
 		}
 
+		if preview.Draft != nil {
+			chatPreviewResp.Draft = &dto.Draft{
+				Content:   preview.Draft.Text,
+				UpdatedAt: preview.Draft.UpdatedAt.Format(time.RFC3339),
+			}
+		}
+
 		previewsResp = append(previewsResp, chatPreviewResp)
 	}
 
-	uc.log.Info("Successfully got user chats", withFields("count", len(previewsResp))...)
-	return dto.GetUserChatPreviewResponse{ChatPreviews: previewsResp}, nil
+	uc.log.Info("Successfully got user chats", withFields("count", len(previewsResp), "total", result.Total)...)
+	return dto.GetUserChatPreviewResponse{
+		ChatPreviews: previewsResp,
+		Total:        result.Total,
+		NextCursor:   result.Next.Encode(),
+	}, nil
 }
+
+// overlayDrafts puts the cached drafts over the stored ones, a draft cleared in the cache hides the stored one.
+func overlayDrafts(previews []entity.ChatPreview, drafts map[uuid.UUID]draftEntity.Draft) {
+	for i := range previews {
+		draft, ok := drafts[previews[i].ChatID]
+		switch {
+		case !ok:
+		case draft.IsEmpty():
+			previews[i].Draft = nil
+		default:
+			previews[i].Draft = &entity.DraftPreview{Text: draft.Content, UpdatedAt: draft.UpdatedAt}
+		}
+	}
+}
+
 func emptyWithErr(err error) (dto.GetUserChatPreviewResponse, error) {
 	return dto.GetUserChatPreviewResponse{}, err
 }
@@ -0,0 +1,66 @@
+package invalidatePreviews
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatInvalidatePreviewsUseCase struct {
+	log      appPorts.Logger
+	audience ports.PreviewAudienceStore
+	cache    ports.PreviewCache
+}
+
+func NewChatInvalidatePreviewsUseCase(
+	log appPorts.Logger,
+	audience ports.PreviewAudienceStore,
+	cache ports.PreviewCache,
+) *ChatInvalidatePreviewsUseCase {
+	return &ChatInvalidatePreviewsUseCase{
+		log:      log,
+		audience: audience,
+		cache:    cache,
+	}
+}
+
+// Execute drops the preview lists showing any of the chats and the ones of the listed users,
+// who may no longer be members, e.g. after leaving.
+func (uc *ChatInvalidatePreviewsUseCase) Execute(ctx context.Context, req dto.InvalidatePreviewsRequest) error {
+	const op = "ChatInvalidatePreviewsUseCase.Execute"
+
+	chatIDs := make([]uuid.UUID, 0, len(req.ChatIDs))
+	for _, raw := range req.ChatIDs {
+		chatID, err := uuid.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("%s: invalid chat id: %w", op, err)
+		}
+		chatIDs = append(chatIDs, chatID)
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(req.UserIDs))
+	for _, raw := range req.UserIDs {
+		userID, err := uuid.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("%s: invalid user id: %w", op, err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	audience, err := uc.audience.PreviewAudience(ctx, chatIDs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.cache.Invalidate(ctx, append(userIDs, audience...)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Debug("Chat previews invalidated", "op", op, "chats", len(chatIDs), "users", len(userIDs)+len(audience))
+
+	return nil
+}
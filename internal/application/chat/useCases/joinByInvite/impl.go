@@ -0,0 +1,178 @@
+package joinByInvite
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	userVO "awesome-chat/internal/domain/core/user/vo"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatJoinByInviteUseCase struct {
+	log       appPorts.Logger
+	txManager sharedPorts.TransactionManager
+	invites   ports.InviteStore
+	requests  ports.JoinRequestStore
+	members   ports.CreateWithMembersStore
+	validator ports.ValidateStore
+	chatTypes ports.ChatTypeStore
+	system    ports.SystemMessageStore
+	users     userPorts.UserGetStore
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewChatJoinByInviteUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	invites ports.InviteStore,
+	requests ports.JoinRequestStore,
+	members ports.CreateWithMembersStore,
+	validator ports.ValidateStore,
+	chatTypes ports.ChatTypeStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatJoinByInviteUseCase {
+	return &ChatJoinByInviteUseCase{
+		log:       log,
+		txManager: txManager,
+		invites:   invites,
+		requests:  requests,
+		members:   members,
+		validator: validator,
+		chatTypes: chatTypes,
+		system:    system,
+		users:     users,
+		publisher: publisher,
+	}
+}
+
+// Execute redeems the invite, the user joins (as a read-only subscriber of a channel) or files a join request when the link asks for approval.
+// Both count as a use of the link.
+func (uc *ChatJoinByInviteUseCase) Execute(ctx context.Context, req dto.JoinByInviteRequest) (resp dto.JoinResponse, err error) {
+	const op = "ChatJoinByInviteUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to join chat by invite", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	token := strings.TrimSpace(req.Token)
+	if token == "" {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, chatErrors.ErrInviteNotFound)
+	}
+
+	user, err := uc.users.Execute(ctx, userID)
+	if err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	invite, err := uc.invites.InviteForUpdate(txCtx, token)
+	if err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = invite.Check(time.Now()); err != nil {
+		uc.log.Warn("Invite not usable", withFields("invite_id", invite.ID, "error", err.Error())...)
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	isMember, err := uc.validator.IsMember(txCtx, invite.ChatID, userID)
+	if err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if isMember {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, chatErrors.ErrAlreadyMember)
+	}
+
+	if err = uc.invites.UseInvite(txCtx, invite.ID); err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var events []eventEntity.ChatEvent
+	if invite.RequiresApproval {
+		resp.Status = dto.JoinStatusPending
+		events, err = uc.request(txCtx, invite, userID)
+	} else {
+		resp.Status = dto.JoinStatusJoined
+		events, err = uc.join(txCtx, invite.ChatID, userID, user.Username)
+	}
+	if err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return dto.JoinResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, event := range events {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	resp.ChatID = invite.ChatID.String()
+
+	uc.log.Info("Successfully joined chat by invite", withFields("chat_id", resp.ChatID, "status", resp.Status)...)
+
+	return resp, nil
+}
+
+func (uc *ChatJoinByInviteUseCase) request(ctx context.Context, invite entity.Invite, userID uuid.UUID) ([]eventEntity.ChatEvent, error) {
+	if err := uc.requests.CreateJoinRequest(ctx, entity.JoinRequest{
+		ChatID:   invite.ChatID,
+		UserID:   userID,
+		InviteID: &invite.ID,
+	}); err != nil {
+		return nil, err
+	}
+
+	requested, err := eventEntity.NewChatEvent(eventVo.JoinRequested, invite.ChatID, dto.JoinRequestEvent{
+		UserID: userID.String(),
+		Status: vo.JoinRequestPending.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []eventEntity.ChatEvent{requested}, nil
+}
+
+func (uc *ChatJoinByInviteUseCase) join(ctx context.Context, chatID, userID uuid.UUID, username string) ([]eventEntity.ChatEvent, error) {
+	chatType, err := uc.chatTypes.ChatType(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = uc.members.AddMembers(ctx, vo.ChatID(chatID), userVO.UserIDs{userID}, chatType.JoinRole()); err != nil {
+		return nil, err
+	}
+
+	return announce.Joined(ctx, uc.system, chatType, chatID, userID, userID, username)
+}
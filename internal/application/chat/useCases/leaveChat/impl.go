@@ -0,0 +1,165 @@
+package leaveChat
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatLeaveUseCase struct {
+	log        appPorts.Logger
+	txManager  sharedPorts.TransactionManager
+	membership ports.MembershipStore
+	chatTypes  ports.ChatTypeStore
+	system     ports.SystemMessageStore
+	users      userPorts.UserGetStore
+	publisher  sharedPorts.ChatEventPublisher
+}
+
+func NewChatLeaveUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	membership ports.MembershipStore,
+	chatTypes ports.ChatTypeStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatLeaveUseCase {
+	return &ChatLeaveUseCase{
+		log:        log,
+		txManager:  txManager,
+		membership: membership,
+		chatTypes:  chatTypes,
+		system:     system,
+		users:      users,
+		publisher:  publisher,
+	}
+}
+
+// Execute takes the user out of the chat. An owner hands the chat to the next admin, or member,
+// who joined first, and the chat is deleted with its last member.
+func (uc *ChatLeaveUseCase) Execute(ctx context.Context, req dto.LeaveChatRequest) (err error) {
+	const op = "ChatLeaveUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to leave chat", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	user, err := uc.users.Execute(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	chatType, err := uc.chatTypes.ChatType(ctx, chatID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	role, err := uc.membership.RemoveMember(txCtx, chatID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var successor uuid.UUID
+	if role == vo.RoleOwner {
+		if successor, err = uc.membership.PromoteSuccessor(txCtx, chatID); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	deleted, err := uc.membership.DeleteIfEmpty(txCtx, chatID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	events, err := uc.events(chatID, userID, successor, chatType)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !deleted && chatType.AnnouncesMembers() {
+		sent, err := announce.Message(txCtx, uc.system, chatID,
+			vo.NewSystemPayload(vo.SystemMemberLeft, userID),
+			fmt.Sprintf("%s left the chat", user.Username),
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, sent)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, event := range events {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			// membership is committed, clients catch up on the next preview load
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully left chat", withFields("successor_id", successor, "chat_deleted", deleted)...)
+
+	return nil
+}
+
+func (uc *ChatLeaveUseCase) events(
+	chatID, userID, successor uuid.UUID,
+	chatType vo.ChatType,
+) ([]eventEntity.ChatEvent, error) {
+	removed, err := eventEntity.NewRevokingChatEvent(eventVo.MemberRemoved, chatID, userID, dto.MemberEvent{
+		ActorID: userID.String(),
+		UserID:  userID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !chatType.AnnouncesMembers() {
+		// subscribers are not told about each other, only the leaving user's devices drop the chat
+		removed.RecipientID = &userID
+	}
+	events := []eventEntity.ChatEvent{removed}
+
+	if successor != uuid.Nil {
+		promoted, err := eventEntity.NewChatEvent(eventVo.MemberRoleChanged, chatID, dto.MemberRoleEvent{
+			ActorID: userID.String(),
+			UserID:  successor.String(),
+			Role:    vo.RoleOwner.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, promoted)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,68 @@
+package listInvites
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatListInvitesUseCase struct {
+	log         appPorts.Logger
+	permissions ports.PermissionChecker
+	invites     ports.InviteStore
+}
+
+func NewChatListInvitesUseCase(
+	log appPorts.Logger,
+	permissions ports.PermissionChecker,
+	invites ports.InviteStore,
+) *ChatListInvitesUseCase {
+	return &ChatListInvitesUseCase{
+		log:         log,
+		permissions: permissions,
+		invites:     invites,
+	}
+}
+
+// Execute lists the links which are not revoked, tokens are only shown to members who may add members.
+func (uc *ChatListInvitesUseCase) Execute(ctx context.Context, req dto.ListInvitesRequest) ([]dto.Invite, error) {
+	const op = "ChatListInvitesUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to list chat invites", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, userID, vo.PermissionAddMembers); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	invites, err := uc.invites.ListInvites(ctx, chatID)
+	if err != nil {
+		uc.log.Error("Failed to list chat invites", withFields("error", err.Error())...)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp := make([]dto.Invite, 0, len(invites))
+	for _, invite := range invites {
+		resp = append(resp, dto.NewInvite(invite))
+	}
+
+	uc.log.Info("Successfully listed chat invites", withFields("count", len(resp))...)
+
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package listJoinRequests
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatListJoinRequestsUseCase struct {
+	log         appPorts.Logger
+	permissions ports.PermissionChecker
+	requests    ports.JoinRequestStore
+}
+
+func NewChatListJoinRequestsUseCase(
+	log appPorts.Logger,
+	permissions ports.PermissionChecker,
+	requests ports.JoinRequestStore,
+) *ChatListJoinRequestsUseCase {
+	return &ChatListJoinRequestsUseCase{
+		log:         log,
+		permissions: permissions,
+		requests:    requests,
+	}
+}
+
+// Execute returns the pending requests oldest first.
+func (uc *ChatListJoinRequestsUseCase) Execute(ctx context.Context, req dto.ListJoinRequestsRequest) ([]dto.JoinRequest, error) {
+	const op = "ChatListJoinRequestsUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to list join requests", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, userID, vo.PermissionAddMembers); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	requests, err := uc.requests.ListJoinRequests(ctx, chatID)
+	if err != nil {
+		uc.log.Error("Failed to list join requests", withFields("error", err.Error())...)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp := make([]dto.JoinRequest, 0, len(requests))
+	for _, request := range requests {
+		resp = append(resp, dto.NewJoinRequest(request))
+	}
+
+	uc.log.Info("Successfully listed join requests", withFields("count", len(resp))...)
+
+	return resp, nil
+}
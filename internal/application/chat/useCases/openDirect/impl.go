@@ -0,0 +1,164 @@
+package openDirect
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	userVO "awesome-chat/internal/domain/core/user/vo"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatOpenDirectUseCase struct {
+	log           appPorts.Logger
+	txManager     sharedPorts.TransactionManager
+	store         ports.DirectStore
+	members       ports.CreateWithMembersStore
+	userValidator userPorts.UserValidatorStore
+	previews      ports.PreviewCache
+}
+
+func NewChatOpenDirectUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	store ports.DirectStore,
+	members ports.CreateWithMembersStore,
+	userValidator userPorts.UserValidatorStore,
+	previews ports.PreviewCache,
+) *ChatOpenDirectUseCase {
+	return &ChatOpenDirectUseCase{
+		log:           log,
+		txManager:     txManager,
+		store:         store,
+		members:       members,
+		userValidator: userValidator,
+		previews:      previews,
+	}
+}
+
+// Execute returns the direct chat of the two users, creating it on first use.
+// Both users are admins, so either may pin or set a ttl and neither outranks the other.
+func (uc *ChatOpenDirectUseCase) Execute(ctx context.Context, req dto.OpenDirectRequest) (dto.DirectChatResponse, error) {
+	const op = "ChatOpenDirectUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "peer_id", req.PeerID}, args...)
+	}
+
+	uc.log.Info("Attempting to open direct chat", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.DirectChatResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	peerID, err := uuid.Parse(req.PeerID)
+	if err != nil {
+		return dto.DirectChatResponse{}, fmt.Errorf("%s: invalid peer id: %w", op, err)
+	}
+
+	pair, err := vo.NewDirectPair(userID, peerID)
+	if err != nil {
+		return dto.DirectChatResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatID, err := uc.store.FindDirect(ctx, pair)
+	switch {
+	case err == nil:
+		// a user who left the chat gets back into it with the history
+		if err = uc.rejoin(ctx, chatID, pair); err != nil {
+			return dto.DirectChatResponse{}, fmt.Errorf("%s: %w", op, err)
+		}
+		uc.invalidatePreviews(ctx, pair, withFields)
+		return uc.response(chatID, peerID, false), nil
+	case !errors.Is(err, chatErrors.ErrChatNotFound):
+		return dto.DirectChatResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.userValidator.ValidateByID(ctx, userVO.UserID(peerID)); err != nil {
+		return dto.DirectChatResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatID = uuid.New()
+	created, err := uc.create(ctx, chatID, pair)
+	if err != nil {
+		uc.log.Error("Failed to create direct chat", withFields("error", err.Error())...)
+		return dto.DirectChatResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !created {
+		// the other user opened the chat at the same time, theirs is committed by now
+		if chatID, err = uc.store.FindDirect(ctx, pair); err != nil {
+			return dto.DirectChatResponse{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	uc.invalidatePreviews(ctx, pair, withFields)
+
+	uc.log.Info("Successfully opened direct chat", withFields("chat_id", chatID, "created", created)...)
+
+	return uc.response(chatID, peerID, created), nil
+}
+
+func (uc *ChatOpenDirectUseCase) create(ctx context.Context, chatID uuid.UUID, pair vo.DirectPair) (created bool, err error) {
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil || !created {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	if created, err = uc.store.CreateDirect(ctx, chatID, pair); err != nil || !created {
+		return false, err
+	}
+
+	if err = uc.members.AddMembers(ctx, vo.ChatID(chatID), userVO.UserIDs{pair.Low, pair.High}, vo.RoleAdmin); err != nil {
+		return false, err
+	}
+
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (uc *ChatOpenDirectUseCase) rejoin(ctx context.Context, chatID uuid.UUID, pair vo.DirectPair) (err error) {
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	if err = uc.members.AddMembers(ctx, vo.ChatID(chatID), userVO.UserIDs{pair.Low, pair.High}, vo.RoleAdmin); err != nil {
+		return err
+	}
+
+	return uc.txManager.CommitTx(ctx)
+}
+
+// invalidatePreviews drops the cached lists of both users, which predate a chat created or rejoined.
+func (uc *ChatOpenDirectUseCase) invalidatePreviews(ctx context.Context, pair vo.DirectPair, withFields func(args ...any) []any) {
+	if err := uc.previews.Invalidate(ctx, []uuid.UUID{pair.Low, pair.High}); err != nil {
+		uc.log.Warn("Failed to invalidate previews", withFields("error", err.Error())...)
+	}
+}
+
+func (uc *ChatOpenDirectUseCase) response(chatID, peerID uuid.UUID, created bool) dto.DirectChatResponse {
+	return dto.DirectChatResponse{
+		ID:      chatID.String(),
+		PeerID:  peerID.String(),
+		Created: created,
+	}
+}
@@ -0,0 +1,141 @@
+package pinMessage
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatPinMessageUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions ports.PermissionChecker
+	store       ports.PinStore
+	system      ports.SystemMessageStore
+	users       userPorts.UserGetStore
+	publisher   sharedPorts.ChatEventPublisher
+	pins        pinInvalidator
+}
+
+// pinInvalidator drops the cached pins of the chat.
+type pinInvalidator interface {
+	Invalidate(ctx context.Context, chatID uuid.UUID) error
+}
+
+func NewChatPinMessageUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions ports.PermissionChecker,
+	store ports.PinStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	publisher sharedPorts.ChatEventPublisher,
+	pins pinInvalidator,
+) *ChatPinMessageUseCase {
+	return &ChatPinMessageUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		store:       store,
+		system:      system,
+		users:       users,
+		publisher:   publisher,
+		pins:        pins,
+	}
+}
+
+func (uc *ChatPinMessageUseCase) Execute(ctx context.Context, req dto.PinMessageRequest) (_ dto.Pin, err error) {
+	const op = "ChatPinMessageUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "message_id", req.MessageID}, args...)
+	}
+
+	uc.log.Info("Attempting to pin message", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, userID, vo.PermissionPinMessages); err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := uc.users.Execute(ctx, userID)
+	if err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	pin, err := uc.store.Pin(txCtx, chatID, req.MessageID, userID)
+	if err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sent, err := announce.Message(txCtx, uc.system, chatID,
+		vo.NewSystemPayload(vo.SystemMessagePinned, userID).WithMessage(req.MessageID),
+		fmt.Sprintf("%s pinned a message", user.Username),
+	)
+	if err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pinned, err := eventEntity.NewChatEvent(eventVo.MessagePinned, chatID, dto.PinEvent{
+		MessageID: req.MessageID,
+		UserID:    req.UserID,
+	})
+	if err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return dto.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if cacheErr := uc.pins.Invalidate(ctx, chatID); cacheErr != nil {
+		uc.log.Error("Failed to invalidate cached pins", withFields("error", cacheErr.Error())...)
+	}
+
+	for _, event := range []eventEntity.ChatEvent{pinned, sent} {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			// the pin is stored, clients catch up on the next preview load
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully pinned message", withFields()...)
+
+	return dto.Pin{
+		MessageID: pin.Message.ID,
+		Message: dto.Message{
+			UserID:    pin.Message.SenderID.String(),
+			Content:   pin.Message.Text,
+			Timestamp: pin.Message.Timestamp.Format(time.RFC3339),
+		},
+		PinnedBy: pin.PinnedBy.String(),
+		PinnedAt: pin.PinnedAt.Format(time.RFC3339),
+	}, nil
+}
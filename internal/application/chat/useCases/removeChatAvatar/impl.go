@@ -0,0 +1,160 @@
+package removeChatAvatar
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatRemoveAvatarUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions ports.PermissionChecker
+	metadata    ports.MetadataStore
+	system      ports.SystemMessageStore
+	users       userPorts.UserGetStore
+	storage     s3.Storage
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewChatRemoveAvatarUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions ports.PermissionChecker,
+	metadata ports.MetadataStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	storage s3.Storage,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatRemoveAvatarUseCase {
+	return &ChatRemoveAvatarUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		metadata:    metadata,
+		system:      system,
+		users:       users,
+		storage:     storage,
+		publisher:   publisher,
+	}
+}
+
+// Execute drops the avatar, removing one from a chat without it changes nothing.
+func (uc *ChatRemoveAvatarUseCase) Execute(ctx context.Context, req dto.RemoveChatAvatarRequest) (resp dto.ChatMetadata, err error) {
+	const op = "ChatRemoveAvatarUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "actor_id", req.ActorID}, args...)
+	}
+
+	uc.log.Info("Attempting to remove chat avatar", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, actorID, vo.PermissionRenameChat); err != nil {
+		uc.log.Warn("Avatar removal rejected", withFields("error", err.Error())...)
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	current, err := uc.metadata.Metadata(ctx, chatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if current.Type == vo.ChatTypeDirect {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, chatErrors.ErrDirectChatSettings)
+	}
+	if current.Avatar.IsZero() {
+		return response(current), nil
+	}
+
+	actor, err := uc.users.Execute(ctx, actorID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	previous, err := uc.metadata.SetAvatar(txCtx, chatID, vo.Avatar{})
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sent, err := announce.Message(txCtx, uc.system, chatID,
+		vo.NewSystemPayload(vo.SystemAvatarRemoved, actorID),
+		fmt.Sprintf("%s removed the chat avatar", actor.Username),
+	)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updated, err := uc.metadata.Metadata(txCtx, chatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	resp = response(updated)
+
+	chatUpdated, err := eventEntity.NewChatEvent(eventVo.ChatUpdated, chatID, dto.ChatUpdatedEvent{
+		ActorID: req.ActorID,
+		Chat:    resp,
+	})
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, key := range []string{previous.Key, previous.ThumbKey} {
+		if delErr := uc.storage.Delete(ctx, key); delErr != nil {
+			uc.log.Warn("Failed to delete avatar object", withFields("object_key", key, "error", delErr.Error())...)
+		}
+	}
+
+	for _, event := range []eventEntity.ChatEvent{sent, chatUpdated} {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully removed chat avatar", withFields()...)
+
+	return resp, nil
+}
+
+func response(m entity.ChatMetadata) dto.ChatMetadata {
+	return dto.ChatMetadata{
+		ChatID:      m.ChatID.String(),
+		Name:        m.Name,
+		Description: m.Description,
+		UpdatedAt:   m.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,132 @@
+package removeMember
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatRemoveMemberUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions ports.PermissionChecker
+	membership  ports.MembershipStore
+	system      ports.SystemMessageStore
+	users       userPorts.UserGetStore
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewChatRemoveMemberUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions ports.PermissionChecker,
+	membership ports.MembershipStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatRemoveMemberUseCase {
+	return &ChatRemoveMemberUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		membership:  membership,
+		system:      system,
+		users:       users,
+		publisher:   publisher,
+	}
+}
+
+// Execute takes another member out of the chat, the actor has to outrank them.
+func (uc *ChatRemoveMemberUseCase) Execute(ctx context.Context, req dto.RemoveMemberRequest) (err error) {
+	const op = "ChatRemoveMemberUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "actor_id", req.ActorID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to remove chat member", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	if actorID == userID {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrRemoveSelf)
+	}
+
+	if _, err = uc.permissions.RequireOver(ctx, chatID, actorID, userID, vo.PermissionRemoveMembers); err != nil {
+		uc.log.Warn("Remove rejected", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	actor, err := uc.users.Execute(ctx, actorID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	user, err := uc.users.Execute(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	if _, err = uc.membership.RemoveMember(txCtx, chatID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	sent, err := announce.Message(txCtx, uc.system, chatID,
+		vo.NewSystemPayload(vo.SystemMemberRemoved, actorID).WithTarget(userID),
+		fmt.Sprintf("%s removed %s from the chat", actor.Username, user.Username),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	removed, err := eventEntity.NewRevokingChatEvent(eventVo.MemberRemoved, chatID, userID, dto.MemberEvent{
+		ActorID: req.ActorID,
+		UserID:  req.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, event := range []eventEntity.ChatEvent{removed, sent} {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully removed chat member", withFields()...)
+
+	return nil
+}
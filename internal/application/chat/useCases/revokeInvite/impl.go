@@ -0,0 +1,66 @@
+package revokeInvite
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatRevokeInviteUseCase struct {
+	log         appPorts.Logger
+	permissions ports.PermissionChecker
+	invites     ports.InviteStore
+}
+
+func NewChatRevokeInviteUseCase(
+	log appPorts.Logger,
+	permissions ports.PermissionChecker,
+	invites ports.InviteStore,
+) *ChatRevokeInviteUseCase {
+	return &ChatRevokeInviteUseCase{
+		log:         log,
+		permissions: permissions,
+		invites:     invites,
+	}
+}
+
+// Execute stops the link from working, requests already filed through it stay in the queue.
+func (uc *ChatRevokeInviteUseCase) Execute(ctx context.Context, req dto.RevokeInviteRequest) error {
+	const op = "ChatRevokeInviteUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "actor_id", req.ActorID, "invite_id", req.InviteID}, args...)
+	}
+
+	uc.log.Info("Attempting to revoke chat invite", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+	inviteID, err := uuid.Parse(req.InviteID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid invite id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, actorID, vo.PermissionAddMembers); err != nil {
+		uc.log.Warn("Revoke rejected", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.invites.RevokeInvite(ctx, chatID, inviteID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully revoked chat invite", withFields()...)
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package setChatAvatar
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	attachmentPorts "awesome-chat/internal/domain/core/attachment/ports"
+	attachmentVo "awesome-chat/internal/domain/core/attachment/vo"
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatSetAvatarUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions ports.PermissionChecker
+	metadata    ports.MetadataStore
+	system      ports.SystemMessageStore
+	users       userPorts.UserGetStore
+	generator   attachmentPorts.PreviewGenerator
+	writer      s3.ObjectWriter
+	storage     s3.Storage
+	urls        s3.URLService
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewChatSetAvatarUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions ports.PermissionChecker,
+	metadata ports.MetadataStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	generator attachmentPorts.PreviewGenerator,
+	writer s3.ObjectWriter,
+	storage s3.Storage,
+	urls s3.URLService,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatSetAvatarUseCase {
+	return &ChatSetAvatarUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		metadata:    metadata,
+		system:      system,
+		users:       users,
+		generator:   generator,
+		writer:      writer,
+		storage:     storage,
+		urls:        urls,
+		publisher:   publisher,
+	}
+}
+
+// Execute stores the medium and small renditions of the image as avatar and thumbnail.
+// The upload itself is not kept, re-encoding drops metadata such as the EXIF location.
+func (uc *ChatSetAvatarUseCase) Execute(ctx context.Context, req dto.SetChatAvatarRequest) (resp dto.ChatMetadata, err error) {
+	const op = "ChatSetAvatarUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "actor_id", req.ActorID}, args...)
+	}
+
+	uc.log.Info("Attempting to set chat avatar", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, actorID, vo.PermissionRenameChat); err != nil {
+		uc.log.Warn("Avatar rejected", withFields("error", err.Error())...)
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	current, err := uc.metadata.Metadata(ctx, chatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if current.Type == vo.ChatTypeDirect {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, chatErrors.ErrDirectChatSettings)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(req.Image, vo.MaxAvatarBytes+1))
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(data) > vo.MaxAvatarBytes {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, chatErrors.ErrAvatarTooLarge)
+	}
+
+	previews, err := uc.generator.Generate(bytes.NewReader(data), attachmentVo.PreviewSizeMedium, attachmentVo.PreviewSizeSmall)
+	if err != nil {
+		uc.log.Warn("Failed to render avatar", withFields("error", err.Error())...)
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	image, thumb := previews.Thumbnails[0], previews.Thumbnails[1]
+
+	// a fresh key per upload, cached urls of the old avatar never show the new picture
+	base := fmt.Sprintf("chats/%s/%s", chatID, uuid.New())
+	avatar := vo.Avatar{Key: base + image.Ext, ThumbKey: base + "_thumb" + thumb.Ext}
+
+	if err = uc.writer.Put(ctx, avatar.Key, image.Data, image.MimeType); err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			uc.removeObjects(context.WithoutCancel(ctx), avatar, withFields)
+		}
+	}()
+	if err = uc.writer.Put(ctx, avatar.ThumbKey, thumb.Data, thumb.MimeType); err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	actor, err := uc.users.Execute(ctx, actorID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	previous, err := uc.metadata.SetAvatar(txCtx, chatID, avatar)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	sent, err := announce.Message(txCtx, uc.system, chatID,
+		vo.NewSystemPayload(vo.SystemAvatarChanged, actorID),
+		fmt.Sprintf("%s changed the chat avatar", actor.Username),
+	)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	updated, err := uc.metadata.Metadata(txCtx, chatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if resp, err = uc.response(ctx, updated); err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatUpdated, err := eventEntity.NewChatEvent(eventVo.ChatUpdated, chatID, dto.ChatUpdatedEvent{
+		ActorID: req.ActorID,
+		Chat:    resp,
+	})
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !previous.IsZero() {
+		uc.removeObjects(ctx, previous, withFields)
+	}
+
+	for _, event := range []eventEntity.ChatEvent{sent, chatUpdated} {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully set chat avatar", withFields("avatar_key", avatar.Key)...)
+
+	return resp, nil
+}
+
+// removeObjects is best effort, a leftover object is only wasted space.
+func (uc *ChatSetAvatarUseCase) removeObjects(ctx context.Context, avatar vo.Avatar, withFields func(args ...any) []any) {
+	for _, key := range []string{avatar.Key, avatar.ThumbKey} {
+		if err := uc.storage.Delete(ctx, key); err != nil {
+			uc.log.Warn("Failed to delete avatar object", withFields("object_key", key, "error", err.Error())...)
+		}
+	}
+}
+
+func (uc *ChatSetAvatarUseCase) response(ctx context.Context, m entity.ChatMetadata) (dto.ChatMetadata, error) {
+	resp := dto.ChatMetadata{
+		ChatID:      m.ChatID.String(),
+		Name:        m.Name,
+		Description: m.Description,
+		UpdatedAt:   m.UpdatedAt.Format(time.RFC3339),
+	}
+
+	var err error
+	if resp.AvatarURL, err = uc.urls.GenerateURL(ctx, m.Avatar.Key); err != nil {
+		return dto.ChatMetadata{}, err
+	}
+	if resp.AvatarThumbURL, err = uc.urls.GenerateURL(ctx, m.Avatar.ThumbKey); err != nil {
+		return dto.ChatMetadata{}, err
+	}
+
+	return resp, nil
+}
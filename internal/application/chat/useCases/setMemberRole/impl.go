@@ -0,0 +1,108 @@
+package setMemberRole
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatSetMemberRoleUseCase struct {
+	log         appPorts.Logger
+	permissions ports.PermissionChecker
+	store       ports.RoleStore
+	chatTypes   ports.ChatTypeStore
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewChatSetMemberRoleUseCase(
+	log appPorts.Logger,
+	permissions ports.PermissionChecker,
+	store ports.RoleStore,
+	chatTypes ports.ChatTypeStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatSetMemberRoleUseCase {
+	return &ChatSetMemberRoleUseCase{
+		log:         log,
+		permissions: permissions,
+		store:       store,
+		chatTypes:   chatTypes,
+		publisher:   publisher,
+	}
+}
+
+// Execute promotes or demotes a member, turning a member read-only takes away posting.
+// Channel subscribers stay read-only unless they are made admins.
+func (uc *ChatSetMemberRoleUseCase) Execute(ctx context.Context, req dto.SetMemberRoleRequest) error {
+	const op = "ChatSetMemberRoleUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{
+			"op", op,
+			"chat_id", req.ChatID,
+			"actor_id", req.ActorID,
+			"user_id", req.UserID,
+			"role", req.Role,
+		}, args...)
+	}
+
+	uc.log.Info("Attempting to set member role", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	role, err := vo.ParseRole(req.Role)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatType, err := uc.chatTypes.ChatType(ctx, chatID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !chatType.Allows(role) {
+		return fmt.Errorf("%s: %s has no %s role: %w", op, chatType, role, chatErrors.ErrRoleNotAssignable)
+	}
+
+	if err = uc.permissions.RequireAssign(ctx, chatID, actorID, userID, role); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.store.SetRole(ctx, chatID, userID, role); err != nil {
+		uc.log.Error("Failed to set member role", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	event, err := eventEntity.NewChatEvent(eventVo.MemberRoleChanged, chatID, dto.MemberRoleEvent{
+		ActorID: req.ActorID,
+		UserID:  req.UserID,
+		Role:    role.String(),
+	})
+	if err == nil {
+		err = uc.publisher.PublishChatEvent(ctx, event)
+	}
+	if err != nil {
+		uc.log.Error("Failed to publish member_role_changed event", withFields("error", err.Error())...)
+	}
+
+	uc.log.Info("Successfully set member role", withFields()...)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package setMentionPolicy
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatSetMentionPolicyUseCase struct {
+	log         appPorts.Logger
+	permissions ports.PermissionChecker
+	store       ports.MentionStore
+}
+
+func NewChatSetMentionPolicyUseCase(
+	log appPorts.Logger,
+	permissions ports.PermissionChecker,
+	store ports.MentionStore,
+) *ChatSetMentionPolicyUseCase {
+	return &ChatSetMentionPolicyUseCase{
+		log:         log,
+		permissions: permissions,
+		store:       store,
+	}
+}
+
+func (uc *ChatSetMentionPolicyUseCase) Execute(ctx context.Context, req dto.SetMentionPolicyRequest) error {
+	const op = "ChatSetMentionPolicyUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "policy", req.Policy}, args...)
+	}
+
+	uc.log.Info("Attempting to set mention policy", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	policy, err := vo.ParseMentionPolicy(req.Policy)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, userID, vo.PermissionSetMentionPolicy); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.store.SetMentionPolicy(ctx, chatID, policy); err != nil {
+		uc.log.Error("Failed to set mention policy", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully set mention policy", withFields()...)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package setMessageTTL
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	msgVo "awesome-chat/internal/domain/core/message/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatSetMessageTTLUseCase struct {
+	log         appPorts.Logger
+	permissions ports.PermissionChecker
+	store       ports.TTLStore
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewChatSetMessageTTLUseCase(
+	log appPorts.Logger,
+	permissions ports.PermissionChecker,
+	store ports.TTLStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatSetMessageTTLUseCase {
+	return &ChatSetMessageTTLUseCase{
+		log:         log,
+		permissions: permissions,
+		store:       store,
+		publisher:   publisher,
+	}
+}
+
+// Execute changes the ttl for messages sent from now on, messages already in the chat keep theirs.
+func (uc *ChatSetMessageTTLUseCase) Execute(ctx context.Context, req dto.SetMessageTTLRequest) error {
+	const op = "ChatSetMessageTTLUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "ttl_seconds", req.TTLSeconds}, args...)
+	}
+
+	uc.log.Info("Attempting to set message ttl", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	ttl, err := msgVo.ParseTTL(req.TTLSeconds)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, userID, vo.PermissionSetMessageTTL); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.store.SetMessageTTL(ctx, chatID, ttl); err != nil {
+		uc.log.Error("Failed to set message ttl", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	event, err := eventEntity.NewChatEvent(eventVo.MessageTTLChanged, chatID, dto.MessageTTLEvent{
+		UserID:     req.UserID,
+		TTLSeconds: req.TTLSeconds,
+	})
+	if err == nil {
+		err = uc.publisher.PublishChatEvent(ctx, event)
+	}
+	if err != nil {
+		uc.log.Error("Failed to publish message_ttl_changed event", withFields("error", err.Error())...)
+	}
+
+	uc.log.Info("Successfully set message ttl", withFields()...)
+
+	return nil
+}
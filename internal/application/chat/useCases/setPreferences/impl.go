@@ -0,0 +1,98 @@
+package setPreferences
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatSetPreferencesUseCase struct {
+	log       appPorts.Logger
+	store     ports.PreferencesStore
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewChatSetPreferencesUseCase(
+	log appPorts.Logger,
+	store ports.PreferencesStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatSetPreferencesUseCase {
+	return &ChatSetPreferencesUseCase{
+		log:       log,
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// Execute changes how the chat behaves for the member, every member may change their own settings.
+func (uc *ChatSetPreferencesUseCase) Execute(ctx context.Context, req dto.SetPreferencesRequest) (dto.Preferences, error) {
+	const op = "ChatSetPreferencesUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to set chat preferences", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.Preferences{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Preferences{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	now := time.Now()
+	update := vo.PreferencesUpdate{
+		Archived:  req.Archived,
+		Pinned:    req.Pinned,
+		SortOrder: req.SortOrder,
+	}
+	if req.MutedUntil != nil {
+		mutedUntil, parseErr := vo.ParseMutedUntil(*req.MutedUntil, now)
+		if parseErr != nil {
+			return dto.Preferences{}, fmt.Errorf("%s: %w", op, parseErr)
+		}
+		update.MutedUntil = &mutedUntil
+	}
+	if req.SortOrder != nil {
+		if err = vo.ValidateSortOrder(*req.SortOrder); err != nil {
+			return dto.Preferences{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if update.IsEmpty() {
+		return dto.Preferences{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNothingToUpdate)
+	}
+
+	prefs, err := uc.store.UpdatePreferences(ctx, chatID, userID, update)
+	if err != nil {
+		uc.log.Warn("Failed to set chat preferences", withFields("error", err.Error())...)
+		return dto.Preferences{}, fmt.Errorf("%s: %w", op, err)
+	}
+	resp := dto.NewPreferences(prefs, now)
+
+	event, err := eventEntity.NewUserChatEvent(eventVo.ChatPreferencesUpdated, chatID, userID, dto.PreferencesEvent{
+		ChatID:      req.ChatID,
+		Preferences: resp,
+	})
+	if err == nil {
+		err = uc.publisher.PublishChatEvent(ctx, event)
+	}
+	if err != nil {
+		uc.log.Error("Failed to publish chat event", withFields("event_type", eventVo.ChatPreferencesUpdated, "error", err.Error())...)
+	}
+
+	uc.log.Info("Successfully set chat preferences", withFields()...)
+
+	return resp, nil
+}
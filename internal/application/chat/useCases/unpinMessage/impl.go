@@ -0,0 +1,129 @@
+package unpinMessage
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ChatUnpinMessageUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions ports.PermissionChecker
+	store       ports.PinStore
+	system      ports.SystemMessageStore
+	users       userPorts.UserGetStore
+	publisher   sharedPorts.ChatEventPublisher
+	pins        pinInvalidator
+}
+
+// pinInvalidator drops the cached pins of the chat.
+type pinInvalidator interface {
+	Invalidate(ctx context.Context, chatID uuid.UUID) error
+}
+
+func NewChatUnpinMessageUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions ports.PermissionChecker,
+	store ports.PinStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	publisher sharedPorts.ChatEventPublisher,
+	pins pinInvalidator,
+) *ChatUnpinMessageUseCase {
+	return &ChatUnpinMessageUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		store:       store,
+		system:      system,
+		users:       users,
+		publisher:   publisher,
+		pins:        pins,
+	}
+}
+
+func (uc *ChatUnpinMessageUseCase) Execute(ctx context.Context, req dto.PinMessageRequest) (err error) {
+	const op = "ChatUnpinMessageUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "message_id", req.MessageID}, args...)
+	}
+
+	uc.log.Info("Attempting to unpin message", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, userID, vo.PermissionPinMessages); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	user, err := uc.users.Execute(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	if err = uc.store.Unpin(txCtx, chatID, req.MessageID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	sent, err := announce.Message(txCtx, uc.system, chatID,
+		vo.NewSystemPayload(vo.SystemMessageUnpinned, userID).WithMessage(req.MessageID),
+		fmt.Sprintf("%s unpinned a message", user.Username),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	unpinned, err := eventEntity.NewChatEvent(eventVo.MessageUnpinned, chatID, dto.PinEvent{
+		MessageID: req.MessageID,
+		UserID:    req.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if cacheErr := uc.pins.Invalidate(ctx, chatID); cacheErr != nil {
+		uc.log.Error("Failed to invalidate cached pins", withFields("error", cacheErr.Error())...)
+	}
+
+	for _, event := range []eventEntity.ChatEvent{unpinned, sent} {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully unpinned message", withFields()...)
+
+	return nil
+}
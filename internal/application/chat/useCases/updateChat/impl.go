@@ -0,0 +1,195 @@
+package updateChat
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/chat/useCases/announce"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	userPorts "awesome-chat/internal/domain/core/user/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChatUpdateUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions ports.PermissionChecker
+	metadata    ports.MetadataStore
+	system      ports.SystemMessageStore
+	users       userPorts.UserGetStore
+	urls        s3.URLService
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewChatUpdateUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions ports.PermissionChecker,
+	metadata ports.MetadataStore,
+	system ports.SystemMessageStore,
+	users userPorts.UserGetStore,
+	urls s3.URLService,
+	publisher sharedPorts.ChatEventPublisher,
+) *ChatUpdateUseCase {
+	return &ChatUpdateUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		metadata:    metadata,
+		system:      system,
+		users:       users,
+		urls:        urls,
+		publisher:   publisher,
+	}
+}
+
+// Execute renames the chat and/or changes its description, every change leaves a system message.
+// Fields equal to the current ones are skipped, an update changing nothing returns the chat as it is.
+func (uc *ChatUpdateUseCase) Execute(ctx context.Context, req dto.UpdateChatRequest) (resp dto.ChatMetadata, err error) {
+	const op = "ChatUpdateUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID, "actor_id", req.ActorID}, args...)
+	}
+
+	uc.log.Info("Attempting to update chat", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	actorID, err := uuid.Parse(req.ActorID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: invalid actor id: %w", op, err)
+	}
+
+	update, err := vo.NewChatInfoUpdate(req.Name, req.Description)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = uc.permissions.Require(ctx, chatID, actorID, vo.PermissionRenameChat); err != nil {
+		uc.log.Warn("Update rejected", withFields("error", err.Error())...)
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	current, err := uc.metadata.Metadata(ctx, chatID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if current.Type == vo.ChatTypeDirect {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, chatErrors.ErrDirectChatSettings)
+	}
+
+	if update.Name != nil && *update.Name == current.Name {
+		update.Name = nil
+	}
+	if update.Description != nil && *update.Description == current.Description {
+		update.Description = nil
+	}
+	if update.IsEmpty() {
+		uc.log.Info("Chat already up to date", withFields()...)
+		return uc.response(ctx, current)
+	}
+
+	actor, err := uc.users.Execute(ctx, actorID)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	updated, err := uc.metadata.UpdateInfo(txCtx, chatID, update)
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var notes []entity.SystemMessage
+	if update.Name != nil {
+		notes = append(notes, entity.SystemMessage{
+			Payload: vo.NewSystemPayload(vo.SystemChatRenamed, actorID).WithName(updated.Name),
+			Content: fmt.Sprintf("%s renamed the chat to %q", actor.Username, updated.Name),
+		})
+	}
+	if update.Description != nil {
+		notes = append(notes, entity.SystemMessage{
+			Payload: vo.NewSystemPayload(vo.SystemDescriptionChanged, actorID),
+			Content: fmt.Sprintf("%s changed the chat description", actor.Username),
+		})
+	}
+
+	events := make([]eventEntity.ChatEvent, 0, len(notes)+1)
+	for _, note := range notes {
+		var sent eventEntity.ChatEvent
+		if sent, err = announce.Message(txCtx, uc.system, chatID, note.Payload, note.Content); err != nil {
+			return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+		}
+		events = append(events, sent)
+	}
+
+	if resp, err = uc.response(ctx, updated); err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatUpdated, err := eventEntity.NewChatEvent(eventVo.ChatUpdated, chatID, dto.ChatUpdatedEvent{
+		ActorID: req.ActorID,
+		Chat:    resp,
+	})
+	if err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	events = append(events, chatUpdated)
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return dto.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, event := range events {
+		if pubErr := uc.publisher.PublishChatEvent(ctx, event); pubErr != nil {
+			uc.log.Error("Failed to publish chat event", withFields("event_type", event.Type, "error", pubErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully updated chat", withFields()...)
+
+	return resp, nil
+}
+
+func (uc *ChatUpdateUseCase) response(ctx context.Context, m entity.ChatMetadata) (dto.ChatMetadata, error) {
+	resp := dto.ChatMetadata{
+		ChatID:      m.ChatID.String(),
+		Name:        m.Name,
+		Description: m.Description,
+		UpdatedAt:   m.UpdatedAt.Format(time.RFC3339),
+	}
+	if m.Avatar.IsZero() {
+		return resp, nil
+	}
+
+	var err error
+	if resp.AvatarURL, err = uc.urls.GenerateURL(ctx, m.Avatar.Key); err != nil {
+		return dto.ChatMetadata{}, err
+	}
+	if resp.AvatarThumbURL, err = uc.urls.GenerateURL(ctx, m.Avatar.ThumbKey); err != nil {
+		return dto.ChatMetadata{}, err
+	}
+
+	return resp, nil
+}
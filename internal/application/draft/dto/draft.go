@@ -0,0 +1,20 @@
+package dto
+
+type (
+	SaveDraftRequest struct {
+		UserID  string `json:"user_id"`
+		ChatID  string `json:"chat_id"`
+		Content string `json:"content"`
+	}
+	GetDraftRequest struct {
+		UserID string
+		ChatID string
+	}
+	// Draft is also the payload of the draft_updated event, devices drop events older than the draft they show.
+	Draft struct {
+		ChatID    string `json:"chat_id"`
+		Content   string `json:"content"`
+		Version   int64  `json:"version"`
+		UpdatedAt string `json:"updated_at"`
+	}
+)
@@ -0,0 +1,105 @@
+package clear
+
+import (
+	"awesome-chat/internal/application/draft/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/draft/entity"
+	draftErrors "awesome-chat/internal/domain/core/draft/errors"
+	"awesome-chat/internal/domain/core/draft/ports"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DraftClearUseCase struct {
+	log       appPorts.Logger
+	cache     ports.Cache
+	store     ports.Store
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewDraftClearUseCase(
+	log appPorts.Logger,
+	cache ports.Cache,
+	store ports.Store,
+	publisher sharedPorts.ChatEventPublisher,
+) *DraftClearUseCase {
+	return &DraftClearUseCase{
+		log:       log,
+		cache:     cache,
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// Execute clears the draft of the sender once the message is out.
+// A draft typed on another device after sentAt is newer than the message and survives.
+// Most senders have no cached draft, only then the store is asked for one left from earlier days.
+func (uc *DraftClearUseCase) Execute(ctx context.Context, userID, chatID string, sentAt time.Time) error {
+	const op = "DraftClearUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", userID, "chat_id", chatID}, args...)
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	cid, err := uuid.Parse(chatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	draft, cleared, err := uc.cache.Clear(ctx, uid, cid, sentAt)
+	if errors.Is(err, draftErrors.ErrDraftNotFound) {
+		draft, cleared, err = uc.clearStored(ctx, uid, cid, sentAt)
+	}
+	if err != nil {
+		uc.log.Error("Failed to clear draft", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !cleared {
+		return nil
+	}
+
+	event, err := eventEntity.NewUserChatEvent(eventVo.DraftUpdated, cid, uid, dto.Draft{
+		ChatID:    cid.String(),
+		Version:   draft.Version,
+		UpdatedAt: draft.UpdatedAt.Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = uc.publisher.PublishChatEvent(ctx, event); err != nil {
+		uc.log.Warn("Failed to publish draft event", withFields("error", err.Error())...)
+	}
+
+	uc.log.Debug("Successfully cleared draft", withFields("version", draft.Version)...)
+	return nil
+}
+
+func (uc *DraftClearUseCase) clearStored(
+	ctx context.Context,
+	userID, chatID uuid.UUID,
+	sentAt time.Time,
+) (entity.Draft, bool, error) {
+	version, cleared, err := uc.store.ClearBefore(ctx, userID, chatID, sentAt)
+	if err != nil || !cleared {
+		return entity.Draft{}, false, err
+	}
+
+	// same rule as the cache, the version keeps growing past the stored one
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	return entity.Draft{
+		UserID:    userID,
+		ChatID:    chatID,
+		Version:   max(version+1, now.UnixMilli()),
+		UpdatedAt: now,
+	}, true, nil
+}
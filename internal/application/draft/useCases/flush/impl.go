@@ -0,0 +1,59 @@
+package flush
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/draft/ports"
+	"awesome-chat/internal/domain/core/draft/vo"
+	"context"
+	"fmt"
+	"time"
+)
+
+const batchSize = 200
+
+type DraftFlushUseCase struct {
+	log   appPorts.Logger
+	queue ports.FlushQueue
+	store ports.Store
+}
+
+func NewDraftFlushUseCase(
+	log appPorts.Logger,
+	queue ports.FlushQueue,
+	store ports.Store,
+) *DraftFlushUseCase {
+	return &DraftFlushUseCase{
+		log:   log,
+		queue: queue,
+		store: store,
+	}
+}
+
+// Execute persists one batch of drafts which have been dirty for at least vo.FlushDelay.
+// The store keeps the higher version, so flushing the same draft twice is harmless.
+func (uc *DraftFlushUseCase) Execute(ctx context.Context) (int, error) {
+	const op = "DraftFlushUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op}, args...)
+	}
+
+	drafts, err := uc.queue.Dirty(ctx, time.Now().Add(-vo.FlushDelay), batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(drafts) == 0 {
+		return 0, nil
+	}
+
+	if err = uc.store.Upsert(ctx, drafts); err != nil {
+		uc.log.Error("Failed to persist drafts", withFields("error", err.Error())...)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.queue.Ack(ctx, drafts); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Debug("Successfully flushed drafts", withFields("count", len(drafts))...)
+	return len(drafts), nil
+}
@@ -0,0 +1,87 @@
+package get
+
+import (
+	"awesome-chat/internal/application/draft/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	draftErrors "awesome-chat/internal/domain/core/draft/errors"
+	"awesome-chat/internal/domain/core/draft/ports"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DraftGetUseCase struct {
+	log       appPorts.Logger
+	validator chatPorts.ValidateStore
+	cache     ports.Cache
+	store     ports.Store
+}
+
+func NewDraftGetUseCase(
+	log appPorts.Logger,
+	validator chatPorts.ValidateStore,
+	cache ports.Cache,
+	store ports.Store,
+) *DraftGetUseCase {
+	return &DraftGetUseCase{
+		log:       log,
+		validator: validator,
+		cache:     cache,
+		store:     store,
+	}
+}
+
+// Execute reads the cache first, it is ahead of postgres while the draft waits to be flushed.
+func (uc *DraftGetUseCase) Execute(ctx context.Context, req dto.GetDraftRequest) (dto.Draft, error) {
+	const op = "DraftGetUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID}, args...)
+	}
+
+	uc.log.Info("Attempting to get draft", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Draft{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.Draft{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	isMember, err := uc.validator.IsMember(ctx, chatID, userID)
+	if err != nil {
+		uc.log.Error("Failed to check membership", withFields("error", err.Error())...)
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	draft, err := uc.cache.Get(ctx, userID, chatID)
+	if err != nil {
+		if !errors.Is(err, draftErrors.ErrDraftNotFound) {
+			uc.log.Warn("Failed to read cached draft, falling back to store", withFields("error", err.Error())...)
+		}
+		if draft, err = uc.store.Get(ctx, userID, chatID); err != nil {
+			return dto.Draft{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if draft.IsEmpty() {
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, draftErrors.ErrDraftNotFound)
+	}
+
+	uc.log.Info("Successfully got draft", withFields("version", draft.Version)...)
+	return dto.Draft{
+		ChatID:    draft.ChatID.String(),
+		Content:   draft.Content,
+		Version:   draft.Version,
+		UpdatedAt: draft.UpdatedAt.Format(time.RFC3339Nano),
+	}, nil
+}
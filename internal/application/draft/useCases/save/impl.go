@@ -0,0 +1,103 @@
+package save
+
+import (
+	"awesome-chat/internal/application/draft/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/draft/entity"
+	"awesome-chat/internal/domain/core/draft/ports"
+	"awesome-chat/internal/domain/core/draft/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DraftSaveUseCase struct {
+	log       appPorts.Logger
+	validator chatPorts.ValidateStore
+	cache     ports.Cache
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewDraftSaveUseCase(
+	log appPorts.Logger,
+	validator chatPorts.ValidateStore,
+	cache ports.Cache,
+	publisher sharedPorts.ChatEventPublisher,
+) *DraftSaveUseCase {
+	return &DraftSaveUseCase{
+		log:       log,
+		validator: validator,
+		cache:     cache,
+		publisher: publisher,
+	}
+}
+
+// Execute writes the draft to the cache only, the flusher persists it once the user pauses typing.
+// The saved draft is sent to the other devices of the user, the one with the highest version wins.
+func (uc *DraftSaveUseCase) Execute(ctx context.Context, req dto.SaveDraftRequest) (dto.Draft, error) {
+	const op = "DraftSaveUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID}, args...)
+	}
+
+	uc.log.Debug("Attempting to save draft", withFields("content_length", len(req.Content))...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Draft{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.Draft{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	content, err := vo.NormalizeContent(req.Content)
+	if err != nil {
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	isMember, err := uc.validator.IsMember(ctx, chatID, userID)
+	if err != nil {
+		uc.log.Error("Failed to check membership", withFields("error", err.Error())...)
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	draft, err := uc.cache.Save(ctx, userID, chatID, content)
+	if err != nil {
+		uc.log.Error("Failed to save draft", withFields("error", err.Error())...)
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp := toDTO(draft)
+
+	event, err := eventEntity.NewUserChatEvent(eventVo.DraftUpdated, chatID, userID, resp)
+	if err != nil {
+		return dto.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = uc.publisher.PublishChatEvent(ctx, event); err != nil {
+		// other devices catch up on the next save or from the REST endpoint
+		uc.log.Warn("Failed to publish draft event", withFields("error", err.Error())...)
+	}
+
+	uc.log.Debug("Successfully saved draft", withFields("version", draft.Version)...)
+	return resp, nil
+}
+
+func toDTO(d entity.Draft) dto.Draft {
+	return dto.Draft{
+		ChatID:    d.ChatID.String(),
+		Content:   d.Content,
+		Version:   d.Version,
+		UpdatedAt: d.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
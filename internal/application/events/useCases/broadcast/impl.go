@@ -0,0 +1,60 @@
+package broadcast
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/shared/events/entity"
+	"awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/ws"
+	"awesome-chat/internal/infrastructure/ws/chathub"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type ChatEventBroadcastUseCase struct {
+	log   appPorts.Logger
+	br    sharedPorts.ChatEventBroadcaster
+	msgBr ws.MessageBroadcaster
+}
+
+func NewChatEventBroadcastUseCase(
+	log appPorts.Logger,
+	br sharedPorts.ChatEventBroadcaster,
+	msgBr ws.MessageBroadcaster,
+) *ChatEventBroadcastUseCase {
+	return &ChatEventBroadcastUseCase{
+		log:   log,
+		br:    br,
+		msgBr: msgBr,
+	}
+}
+
+func (uc *ChatEventBroadcastUseCase) Execute(ctx context.Context, payload []byte) error {
+	const op = "ChatEventBroadcastUseCase.Execute"
+
+	var event entity.ChatEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("%s: failed to decode chat event: %w", op, err)
+	}
+
+	// messages sent outside of ws reach clients as a regular broadcast frame
+	if event.Type == vo.MessageSent {
+		var message chathub.Message
+		if err := json.Unmarshal(event.Payload, &message); err != nil {
+			return fmt.Errorf("%s: failed to decode message: %w", op, err)
+		}
+		if err := uc.msgBr.Broadcast(ctx, message); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		return nil
+	}
+
+	if err := uc.br.BroadcastChatEvent(ctx, event); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Debug("Chat event broadcast", "op", op, "chat_id", event.ChatID, "event_type", event.Type)
+
+	return nil
+}
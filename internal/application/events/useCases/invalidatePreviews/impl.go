@@ -0,0 +1,80 @@
+package invalidatePreviews
+
+import (
+	chatDto "awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/shared/events/entity"
+	"awesome-chat/internal/domain/core/shared/events/vo"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// previewEvents change what a preview list shows or how it is ordered, the rest is left to the clients.
+var previewEvents = map[vo.Type]struct{}{
+	vo.MessageSent:            {},
+	vo.PollCreated:            {},
+	vo.MessagesExpired:        {},
+	vo.MessageDeleted:         {},
+	vo.MessagePinned:          {},
+	vo.MessageUnpinned:        {},
+	vo.MessageTTLChanged:      {},
+	vo.MemberJoined:           {},
+	vo.MemberRemoved:          {},
+	vo.MemberRoleChanged:      {},
+	vo.ChatUpdated:            {},
+	vo.ChatPreferencesUpdated: {},
+	vo.DraftUpdated:           {},
+	vo.Mentioned:              {},
+}
+
+type chatInvalidatePreviewsUseCase interface {
+	Execute(ctx context.Context, req chatDto.InvalidatePreviewsRequest) error
+}
+
+type ChatEventInvalidatePreviewsUseCase struct {
+	log      appPorts.Logger
+	previews chatInvalidatePreviewsUseCase
+}
+
+func NewChatEventInvalidatePreviewsUseCase(
+	log appPorts.Logger,
+	previews chatInvalidatePreviewsUseCase,
+) *ChatEventInvalidatePreviewsUseCase {
+	return &ChatEventInvalidatePreviewsUseCase{
+		log:      log,
+		previews: previews,
+	}
+}
+
+// Execute drops the preview lists a chat event makes stale. An event for one member only drops
+// that member's lists, a revoked member is dropped as well since the chat no longer lists them.
+func (uc *ChatEventInvalidatePreviewsUseCase) Execute(ctx context.Context, payload []byte) error {
+	const op = "ChatEventInvalidatePreviewsUseCase.Execute"
+
+	var event entity.ChatEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return fmt.Errorf("%s: failed to decode chat event: %w", op, err)
+	}
+	if _, ok := previewEvents[event.Type]; !ok {
+		return nil
+	}
+
+	var req chatDto.InvalidatePreviewsRequest
+	if event.RecipientID != nil {
+		req.UserIDs = append(req.UserIDs, event.RecipientID.String())
+	} else {
+		req.ChatIDs = append(req.ChatIDs, event.ChatID.String())
+	}
+	if event.RevokedID != nil {
+		req.UserIDs = append(req.UserIDs, event.RevokedID.String())
+	}
+
+	if err := uc.previews.Execute(ctx, req); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Debug("Chat previews invalidated by event", "op", op, "chat_id", event.ChatID, "event_type", event.Type)
+
+	return nil
+}
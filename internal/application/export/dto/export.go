@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"awesome-chat/internal/domain/core/export/entity"
+	"time"
+)
+
+type (
+	RequestExportRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id"`
+	}
+
+	GetExportRequest struct {
+		UserID   string `json:"user_id"`
+		ExportID string `json:"export_id"`
+	}
+
+	// Export is also the payload of the export_finished event.
+	Export struct {
+		ID           string     `json:"id"`
+		ChatID       string     `json:"chat_id"`
+		Status       string     `json:"status"`
+		RequestedAt  time.Time  `json:"requested_at"`
+		FinishedAt   *time.Time `json:"finished_at,omitempty"`
+		MessageCount int        `json:"message_count,omitempty"`
+		SizeBytes    int64      `json:"size_bytes,omitempty"`
+		// DownloadURL is presigned and short lived, fetching the export again signs a fresh one.
+		DownloadURL string `json:"download_url,omitempty"`
+		Error       string `json:"error,omitempty"`
+	}
+)
+
+func NewExport(e entity.Export, downloadURL string) Export {
+	return Export{
+		ID:           e.ID.String(),
+		ChatID:       e.ChatID.String(),
+		Status:       e.Status.String(),
+		RequestedAt:  e.RequestedAt,
+		FinishedAt:   e.FinishedAt,
+		MessageCount: e.MessageCount,
+		SizeBytes:    e.SizeBytes,
+		DownloadURL:  downloadURL,
+		Error:        e.Error,
+	}
+}
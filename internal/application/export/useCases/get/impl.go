@@ -0,0 +1,84 @@
+package get
+
+import (
+	"awesome-chat/internal/application/export/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	exportErrors "awesome-chat/internal/domain/core/export/errors"
+	"awesome-chat/internal/domain/core/export/ports"
+	"awesome-chat/internal/domain/core/export/vo"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ExportGetUseCase struct {
+	log           appPorts.Logger
+	store         ports.Store
+	chatValidator chatPorts.ValidateStore
+	urlSvc        s3.URLService
+}
+
+func NewExportGetUseCase(
+	log appPorts.Logger,
+	store ports.Store,
+	chatValidator chatPorts.ValidateStore,
+	urlSvc s3.URLService,
+) *ExportGetUseCase {
+	return &ExportGetUseCase{
+		log:           log,
+		store:         store,
+		chatValidator: chatValidator,
+		urlSvc:        urlSvc,
+	}
+}
+
+// Execute returns the state of an export of the requester, a ready one comes with a fresh download url.
+func (uc *ExportGetUseCase) Execute(ctx context.Context, req dto.GetExportRequest) (dto.Export, error) {
+	const op = "ExportGetUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "export_id", req.ExportID}, args...)
+	}
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Export{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	exportID, err := uuid.Parse(req.ExportID)
+	if err != nil {
+		return dto.Export{}, fmt.Errorf("%s: invalid export id: %w", op, err)
+	}
+
+	export, err := uc.store.Get(ctx, exportID)
+	if err != nil {
+		return dto.Export{}, fmt.Errorf("%s: %w", op, err)
+	}
+	// exports of others are not disclosed
+	if export.UserID != userID {
+		return dto.Export{}, fmt.Errorf("%s: %w", op, exportErrors.ErrExportNotFound)
+	}
+
+	// a member who left loses the history with the chat
+	isMember, err := uc.chatValidator.IsMember(ctx, export.ChatID, userID)
+	if err != nil {
+		return dto.Export{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		return dto.Export{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	if export.Status != vo.StatusReady {
+		return dto.NewExport(export, ""), nil
+	}
+
+	downloadURL, err := uc.urlSvc.GenerateURL(ctx, export.ObjectKey)
+	if err != nil {
+		uc.log.Error("Failed to generate export download url", withFields("error", err.Error())...)
+		return dto.Export{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.NewExport(export, downloadURL), nil
+}
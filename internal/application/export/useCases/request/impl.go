@@ -0,0 +1,78 @@
+package request
+
+import (
+	"awesome-chat/internal/application/export/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/export/entity"
+	"awesome-chat/internal/domain/core/export/ports"
+	"awesome-chat/internal/domain/core/export/vo"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ExportRequestUseCase struct {
+	log           appPorts.Logger
+	store         ports.Store
+	chatValidator chatPorts.ValidateStore
+}
+
+func NewExportRequestUseCase(
+	log appPorts.Logger,
+	store ports.Store,
+	chatValidator chatPorts.ValidateStore,
+) *ExportRequestUseCase {
+	return &ExportRequestUseCase{
+		log:           log,
+		store:         store,
+		chatValidator: chatValidator,
+	}
+}
+
+// Execute queues an export of the chat, the worker picks it up and notifies the requester when it is done.
+func (uc *ExportRequestUseCase) Execute(ctx context.Context, req dto.RequestExportRequest) (dto.Export, error) {
+	const op = "ExportRequestUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID}, args...)
+	}
+
+	uc.log.Info("Attempting to request chat export", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Export{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.Export{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	isMember, err := uc.chatValidator.IsMember(ctx, chatID, userID)
+	if err != nil {
+		return dto.Export{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		return dto.Export{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	export := entity.Export{
+		ID:          uuid.New(),
+		ChatID:      chatID,
+		UserID:      userID,
+		Status:      vo.StatusPending,
+		RequestedAt: time.Now().UTC(),
+	}
+
+	if err = uc.store.Create(ctx, export); err != nil {
+		uc.log.Error("Failed to queue chat export", withFields("error", err.Error())...)
+		return dto.Export{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully requested chat export", withFields("export_id", export.ID.String())...)
+
+	return dto.NewExport(export, ""), nil
+}
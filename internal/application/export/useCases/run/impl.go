@@ -0,0 +1,193 @@
+package run
+
+import (
+	"awesome-chat/internal/application/export/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/export/entity"
+	exportErrors "awesome-chat/internal/domain/core/export/errors"
+	"awesome-chat/internal/domain/core/export/ports"
+	"awesome-chat/internal/domain/core/export/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	maxAttempts = 3
+	contentType = "application/zip"
+	// bounds the bookkeeping after a run that used up its deadline
+	finishTimeout = 5 * time.Second
+)
+
+var errClaimExpired = errors.New("export did not finish in time")
+
+type ExportRunUseCase struct {
+	log       appPorts.Logger
+	jobs      ports.JobStore
+	source    ports.SourceStore
+	archiver  ports.Archiver
+	writer    s3.StreamWriter
+	urlSvc    s3.URLService
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewExportRunUseCase(
+	log appPorts.Logger,
+	jobs ports.JobStore,
+	source ports.SourceStore,
+	archiver ports.Archiver,
+	writer s3.StreamWriter,
+	urlSvc s3.URLService,
+	publisher sharedPorts.ChatEventPublisher,
+) *ExportRunUseCase {
+	return &ExportRunUseCase{
+		log:       log,
+		jobs:      jobs,
+		source:    source,
+		archiver:  archiver,
+		writer:    writer,
+		urlSvc:    urlSvc,
+		publisher: publisher,
+	}
+}
+
+// Execute runs one queued export. It reports false when there was nothing to do.
+// The archive is streamed from the database into the bucket, a failed export is retried after a growing delay until maxAttempts.
+func (uc *ExportRunUseCase) Execute(ctx context.Context) (bool, error) {
+	const op = "ExportRunUseCase.Execute"
+
+	export, err := uc.jobs.ClaimPending(ctx)
+	if errors.Is(err, exportErrors.ErrNoPendingExports) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	withFields := func(args ...any) []any {
+		return append([]any{
+			"op", op,
+			"export_id", export.ID,
+			"chat_id", export.ChatID,
+			"attempt", export.Attempts,
+		}, args...)
+	}
+	uc.log.Info("Attempting to export chat", withFields()...)
+
+	// the last attempt was claimed by a worker that never came back
+	runErr := errClaimExpired
+	if export.Attempts <= maxAttempts {
+		runErr = uc.run(ctx, &export)
+	}
+
+	if runErr != nil {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			// shutting down, the claim expires and the export runs again
+			return true, fmt.Errorf("%s: %w", op, runErr)
+		}
+		uc.log.Error("Failed to export chat", withFields("error", runErr.Error())...)
+
+		// a run that timed out is still recorded
+		finishCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finishTimeout)
+		defer cancel()
+
+		status, markErr := uc.jobs.MarkFailed(finishCtx, export.ID, maxAttempts, runErr.Error())
+		if markErr != nil {
+			return true, fmt.Errorf("%s: %w", op, markErr)
+		}
+		if status == vo.StatusFailed {
+			export.Status = status
+			export.Error = runErr.Error()
+			uc.publishFinished(finishCtx, export, "", withFields)
+		}
+		return true, nil
+	}
+
+	if err = uc.jobs.MarkReady(ctx, export); err != nil {
+		return true, fmt.Errorf("%s: %w", op, err)
+	}
+	export.Status = vo.StatusReady
+	now := time.Now().UTC()
+	export.FinishedAt = &now
+
+	// the export is saved at this point, without the event the requester still finds it by polling
+	downloadURL, err := uc.urlSvc.GenerateURL(ctx, export.ObjectKey)
+	if err != nil {
+		uc.log.Error("Failed to generate export download url", withFields("error", err.Error())...)
+	}
+	uc.publishFinished(ctx, export, downloadURL, withFields)
+
+	uc.log.Info("Successfully exported chat",
+		withFields("messages", export.MessageCount, "size_bytes", export.SizeBytes)...)
+
+	return true, nil
+}
+
+// run writes the archive into one end of a pipe while the upload reads the other,
+// whichever side fails closes the pipe so the other one stops too.
+func (uc *ExportRunUseCase) run(ctx context.Context, export *entity.Export) error {
+	chat, err := uc.source.Chat(ctx, export.ChatID)
+	if err != nil {
+		return err
+	}
+	chat.ExportedUntil = export.RequestedAt
+
+	src := &source{store: uc.source, chat: chat, until: export.RequestedAt}
+	objectKey := entity.ObjectKeyFor(export.ChatID, export.ID)
+
+	var (
+		messages int
+		size     int64
+	)
+	pr, pw := io.Pipe()
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		n, writeErr := uc.archiver.Write(groupCtx, pw, src)
+		messages = n
+		_ = pw.CloseWithError(writeErr)
+		return writeErr
+	})
+	group.Go(func() error {
+		n, putErr := uc.writer.PutStream(groupCtx, objectKey, pr, contentType)
+		size = n
+		_ = pr.CloseWithError(putErr)
+		return putErr
+	})
+	if err = group.Wait(); err != nil {
+		return err
+	}
+
+	export.ObjectKey = objectKey
+	export.MessageCount = messages
+	export.SizeBytes = size
+
+	return nil
+}
+
+func (uc *ExportRunUseCase) publishFinished(
+	ctx context.Context,
+	export entity.Export,
+	downloadURL string,
+	withFields func(args ...any) []any,
+) {
+	event, err := eventEntity.NewUserChatEvent(
+		eventVo.ExportFinished,
+		export.ChatID,
+		export.UserID,
+		dto.NewExport(export, downloadURL),
+	)
+	if err == nil {
+		err = uc.publisher.PublishChatEvent(ctx, event)
+	}
+	if err != nil {
+		uc.log.Error("Failed to publish export_finished event", withFields("error", err.Error())...)
+	}
+}
@@ -0,0 +1,65 @@
+package run
+
+import (
+	"awesome-chat/internal/domain/core/export/entity"
+	"awesome-chat/internal/domain/core/export/ports"
+	msgVo "awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const pageSize = 500
+
+var _ ports.Source = (*source)(nil)
+
+// source walks a chat page by page, only the current page is held at a time.
+type source struct {
+	store ports.SourceStore
+	chat  entity.Chat
+	until time.Time
+}
+
+func (s *source) Chat() entity.Chat {
+	return s.chat
+}
+
+func (s *source) EachParticipant(ctx context.Context, fn func(entity.Participant) error) error {
+	after := uuid.Nil
+	for {
+		page, err := s.store.ParticipantsAfter(ctx, s.chat.ID, after, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, p := range page {
+			if err = fn(p); err != nil {
+				return err
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+		after = page[len(page)-1].UserID
+	}
+}
+
+func (s *source) EachMessage(ctx context.Context, fn func(entity.Message) error) error {
+	var after msgVo.Cursor
+	for {
+		page, err := s.store.MessagesAfter(ctx, s.chat.ID, s.until, after, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, msg := range page {
+			if err = fn(msg); err != nil {
+				return err
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+		last := page[len(page)-1]
+		after = msgVo.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
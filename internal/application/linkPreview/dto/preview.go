@@ -0,0 +1,12 @@
+package dto
+
+type (
+	LinkPreview struct {
+		MessageID   int    `json:"message_id"`
+		URL         string `json:"url"`
+		Title       string `json:"title,omitempty"`
+		Description string `json:"description,omitempty"`
+		ImageURL    string `json:"image_url,omitempty"`
+		SiteName    string `json:"site_name,omitempty"`
+	}
+)
@@ -0,0 +1,182 @@
+package unfurl
+
+import (
+	"awesome-chat/internal/application/linkPreview/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/linkPreview/entity"
+	"awesome-chat/internal/domain/core/linkPreview/ports"
+	"awesome-chat/internal/domain/core/linkPreview/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/cache"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	batchSize   = 16
+	parallelism = 4
+
+	cacheTTL = 24 * time.Hour
+	// failures are remembered too, so a dead or hostile link is not fetched for every message
+	failureCacheTTL = time.Hour
+)
+
+var errCachedFailed = errors.New("link failed to unfurl recently")
+
+// cachedMetadata is stored as is, nil Metadata marks a failed link.
+type cachedMetadata struct {
+	Metadata *vo.Metadata `json:"metadata,omitempty"`
+}
+
+type LinkPreviewUnfurlUseCase struct {
+	log        appPorts.Logger
+	claimStore ports.ClaimStore
+	saveStore  ports.SaveStore
+	unfurler   ports.Unfurler
+	cache      cache.Storage
+	publisher  sharedPorts.ChatEventPublisher
+}
+
+func NewLinkPreviewUnfurlUseCase(
+	log appPorts.Logger,
+	claimStore ports.ClaimStore,
+	saveStore ports.SaveStore,
+	unfurler ports.Unfurler,
+	cache cache.Storage,
+	publisher sharedPorts.ChatEventPublisher,
+) *LinkPreviewUnfurlUseCase {
+	return &LinkPreviewUnfurlUseCase{
+		log:        log,
+		claimStore: claimStore,
+		saveStore:  saveStore,
+		unfurler:   unfurler,
+		cache:      cache,
+		publisher:  publisher,
+	}
+}
+
+// Execute unfurls a batch of freshly saved messages and reports how many were claimed.
+func (uc *LinkPreviewUnfurlUseCase) Execute(ctx context.Context) (int, error) {
+	const op = "LinkPreviewUnfurlUseCase.Execute"
+
+	jobs, err := uc.claimStore.ClaimPending(ctx, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	uc.log.Info("Attempting to unfurl links", "op", op, "messages", len(jobs))
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(parallelism)
+	for _, job := range jobs {
+		group.Go(func() error {
+			if processErr := uc.process(groupCtx, job); processErr != nil {
+				uc.log.Error("Failed to save link preview",
+					"op", op, "message_id", job.MessageID, "error", processErr.Error(),
+				)
+			}
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	return len(jobs), nil
+}
+
+func (uc *LinkPreviewUnfurlUseCase) process(ctx context.Context, job entity.Job) error {
+	preview := entity.LinkPreview{
+		MessageID: job.MessageID,
+		ChatID:    job.ChatID,
+		Status:    vo.StatusReady,
+	}
+
+	rawURL, ok := vo.ExtractURL(job.Content)
+	if !ok {
+		preview.Status = vo.StatusFailed
+		return uc.saveStore.Save(ctx, preview)
+	}
+
+	meta, err := uc.lookup(ctx, rawURL)
+	if err != nil {
+		uc.log.Debug("Link was not unfurled", "message_id", job.MessageID, "url", rawURL, "error", err.Error())
+		preview.Status = vo.StatusFailed
+		meta = vo.Metadata{URL: rawURL}
+	}
+	preview.Metadata = meta
+
+	if err = uc.saveStore.Save(ctx, preview); err != nil {
+		return err
+	}
+
+	if preview.Status == vo.StatusReady {
+		if err = uc.publishReady(ctx, preview); err != nil {
+			uc.log.Error("Failed to publish link_preview_ready event",
+				"message_id", job.MessageID, "error", err.Error(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// lookup serves a link from cache and unfurls it otherwise.
+func (uc *LinkPreviewUnfurlUseCase) lookup(ctx context.Context, rawURL string) (vo.Metadata, error) {
+	sum := sha256.Sum256([]byte(rawURL))
+	key := hex.EncodeToString(sum[:])
+
+	if raw, err := uc.cache.Read(ctx, key); err == nil {
+		var cached cachedMetadata
+		if err = json.Unmarshal([]byte(raw), &cached); err == nil {
+			if cached.Metadata == nil {
+				return vo.Metadata{}, errCachedFailed
+			}
+			return *cached.Metadata, nil
+		}
+	}
+
+	meta, err := uc.unfurler.Unfurl(ctx, rawURL)
+	if ctx.Err() != nil {
+		// shutting down, the claim expires and the link is tried again
+		return vo.Metadata{}, ctx.Err()
+	}
+
+	cached, ttl := cachedMetadata{Metadata: &meta}, cacheTTL
+	if err != nil {
+		cached, ttl = cachedMetadata{}, failureCacheTTL
+	}
+	if payload, marshalErr := json.Marshal(cached); marshalErr == nil {
+		if setErr := uc.cache.Set(ctx, key, string(payload), ttl); setErr != nil {
+			uc.log.Warn("Failed to cache link preview", "url", rawURL, "error", setErr.Error())
+		}
+	}
+
+	return meta, err
+}
+
+func (uc *LinkPreviewUnfurlUseCase) publishReady(ctx context.Context, p entity.LinkPreview) error {
+	event, err := eventEntity.NewChatEvent(eventVo.LinkPreviewReady, p.ChatID, dto.LinkPreview{
+		MessageID:   p.MessageID,
+		URL:         p.URL,
+		Title:       p.Title,
+		Description: p.Description,
+		ImageURL:    p.ImageURL,
+		SiteName:    p.SiteName,
+	})
+	if err != nil {
+		return err
+	}
+
+	return uc.publisher.PublishChatEvent(ctx, event)
+}
@@ -0,0 +1,14 @@
+package dto
+
+type (
+	DeleteMessageRequest struct {
+		UserID    string `json:"user_id"`
+		ChatID    string `json:"chat_id"`
+		MessageID int    `json:"message_id"`
+	}
+	// MessageDeletedEvent is broadcast to the chat, UserID is the member who deleted the message.
+	MessageDeletedEvent struct {
+		MessageID int    `json:"message_id"`
+		UserID    string `json:"user_id"`
+	}
+)
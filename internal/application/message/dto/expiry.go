@@ -0,0 +1,8 @@
+package dto
+
+type (
+	// MessagesExpiredEvent is broadcast to the chat after its expired messages are purged.
+	MessagesExpiredEvent struct {
+		MessageIDs []int `json:"message_ids"`
+	}
+)
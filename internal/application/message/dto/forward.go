@@ -0,0 +1,20 @@
+package dto
+
+type (
+	ForwardRequest struct {
+		UserID     string   `json:"user_id"`
+		FromChatID string   `json:"from_chat_id"`
+		MessageIDs []int    `json:"message_ids"`
+		ToChatIDs  []string `json:"to_chat_ids"`
+	}
+	ForwardResponse struct {
+		Forwarded int `json:"forwarded"` // messages written across all target chats
+		// MessageIDs are the ids of the copies, target chat by target chat.
+		MessageIDs []int `json:"message_ids"`
+	}
+	ForwardOrigin struct {
+		MessageID int    `json:"message_id,omitempty"`
+		UserID    string `json:"user_id"`
+		ChatID    string `json:"chat_id"`
+	}
+)
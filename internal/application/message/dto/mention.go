@@ -0,0 +1,35 @@
+package dto
+
+type (
+	MentionsFeedRequest struct {
+		UserID     string `json:"user_id"`
+		ChatID     string `json:"chat_id,omitempty"`
+		UnreadOnly bool   `json:"unread_only,omitempty"`
+		Limit      int    `json:"limit,omitempty"`
+		Cursor     string `json:"cursor,omitempty"`
+	}
+	MentionsFeedResponse struct {
+		Mentions   []Mention `json:"mentions"`
+		Count      int       `json:"count"`
+		NextCursor string    `json:"next_cursor,omitempty"`
+	}
+	// Mention is also the payload of the mentioned event.
+	Mention struct {
+		MessageID int    `json:"message_id"`
+		ChatID    string `json:"chat_id"`
+		SenderID  string `json:"sender_id"`
+		Content   string `json:"content"`
+		IsAll     bool   `json:"is_all"`
+		Timestamp string `json:"timestamp"`
+		Read      bool   `json:"read"`
+		// Muted tells clients to show the mention without sound, the chat is muted by the user.
+		Muted bool `json:"muted,omitempty"`
+	}
+	ReadMentionsRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id"`
+	}
+	ReadMentionsResponse struct {
+		Read int `json:"read"`
+	}
+)
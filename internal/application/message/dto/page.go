@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"awesome-chat/internal/domain/core/message/vo"
+)
+
+type (
+	// PageRequest takes at most one of Before, After and AroundID, none reads the newest page.
+	PageRequest struct {
+		ChatID string `json:"chat_id"`
+		// UserID is optional, it fills my_vote of polls.
+		UserID   string `json:"user_id,omitempty"`
+		Before   string `json:"before,omitempty"`
+		After    string `json:"after,omitempty"`
+		AroundID int    `json:"around,omitempty"`
+		Limit    int    `json:"limit,omitempty"`
+	}
+	// PageResponse lists messages newest first, the cursors are set when there is more to read.
+	PageResponse struct {
+		Messages    []PagedMessage `json:"messages"`
+		Count       int            `json:"count"`
+		OlderCursor string         `json:"older_cursor,omitempty"`
+		NewerCursor string         `json:"newer_cursor,omitempty"`
+	}
+	PagedMessage struct {
+		ID            int            `json:"id"`
+		Type          string         `json:"type"`
+		Text          string         `json:"text"`
+		Format        string         `json:"format"`
+		Entities      []vo.Entity    `json:"entities,omitempty"`
+		SenderID      string         `json:"sender_id"`
+		Timestamp     string         `json:"timestamp"`
+		LinkPreview   *LinkPreview   `json:"link_preview,omitempty"`
+		ForwardedFrom *ForwardOrigin `json:"forwarded_from,omitempty"`
+		Poll          *Poll          `json:"poll,omitempty"`
+		// System describes what a system message reports, Text is its plain fallback.
+		System json.RawMessage `json:"system,omitempty"`
+	}
+	LinkPreview struct {
+		URL         string `json:"url"`
+		Title       string `json:"title,omitempty"`
+		Description string `json:"description,omitempty"`
+		ImageURL    string `json:"image_url,omitempty"`
+		SiteName    string `json:"site_name,omitempty"`
+	}
+)
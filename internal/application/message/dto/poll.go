@@ -0,0 +1,78 @@
+package dto
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"time"
+)
+
+type (
+	CreatePollRequest struct {
+		UserID         string   `json:"user_id"`
+		ChatID         string   `json:"chat_id"`
+		Question       string   `json:"question"`
+		Options        []string `json:"options"`
+		MultipleChoice bool     `json:"multiple_choice,omitempty"`
+		Anonymous      bool     `json:"anonymous,omitempty"`
+		ClosesAt       string   `json:"closes_at,omitempty"` // RFC3339
+	}
+	VotePollRequest struct {
+		UserID    string `json:"user_id"`
+		MessageID int    `json:"message_id"`
+		// Choices are option positions, an empty list retracts the vote.
+		Choices []int `json:"choices"`
+	}
+	GetPollRequest struct {
+		UserID    string `json:"user_id"`
+		MessageID int    `json:"message_id"`
+	}
+	// Poll is also the payload of the poll_created and poll_updated events, without my_vote.
+	Poll struct {
+		MessageID      int          `json:"message_id"`
+		ChatID         string       `json:"chat_id"`
+		AuthorID       string       `json:"author_id"`
+		Question       string       `json:"question"`
+		Options        []PollOption `json:"options"`
+		MultipleChoice bool         `json:"multiple_choice"`
+		Anonymous      bool         `json:"anonymous"`
+		ClosesAt       string       `json:"closes_at,omitempty"`
+		Closed         bool         `json:"closed"`
+		TotalVoters    int          `json:"total_voters"`
+		MyVote         []int        `json:"my_vote,omitempty"`
+		Timestamp      string       `json:"timestamp"`
+	}
+	PollOption struct {
+		Position int      `json:"position"`
+		Text     string   `json:"text"`
+		Votes    int      `json:"votes"`
+		Voters   []string `json:"voters,omitempty"`
+	}
+)
+
+// NewPoll maps the poll as seen by its viewer, my_vote is left to the caller.
+func NewPoll(p entity.Poll, now time.Time) Poll {
+	poll := Poll{
+		MessageID:      p.MessageID,
+		ChatID:         p.ChatID.String(),
+		AuthorID:       p.AuthorID.String(),
+		Question:       p.Question,
+		Options:        make([]PollOption, 0, len(p.Options)),
+		MultipleChoice: p.MultipleChoice,
+		Anonymous:      p.Anonymous,
+		Closed:         p.IsClosed(now),
+		TotalVoters:    p.TotalVoters,
+		Timestamp:      p.CreatedAt.Format(time.RFC3339Nano),
+	}
+	if p.ClosesAt != nil {
+		poll.ClosesAt = p.ClosesAt.Format(time.RFC3339)
+	}
+
+	for _, o := range p.Options {
+		option := PollOption{Position: o.Position, Text: o.Text, Votes: o.Votes}
+		for _, voter := range o.Voters {
+			option.Voters = append(option.Voters, voter.String())
+		}
+		poll.Options = append(poll.Options, option)
+	}
+
+	return poll
+}
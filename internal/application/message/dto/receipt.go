@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"awesome-chat/internal/domain/core/message/vo"
+	"time"
+)
+
+type (
+	// AckReceiptsRequest moves the receipt cursors of the user, clients ack delivered_until
+	// with the timestamp of the newest message any device received and read_until as the user reads.
+	AckReceiptsRequest struct {
+		UserID         string `json:"user_id"`
+		ChatID         string `json:"chat_id"`
+		DeliveredUntil string `json:"delivered_until,omitempty"` // RFC3339
+		ReadUntil      string `json:"read_until,omitempty"`      // RFC3339
+	}
+	// ReceiptCursors answers the ack and is the payload of receipt_updated.
+	ReceiptCursors struct {
+		ChatID         string `json:"chat_id"`
+		UserID         string `json:"user_id"`
+		DeliveredUntil string `json:"delivered_until,omitempty"`
+		ReadUntil      string `json:"read_until,omitempty"`
+	}
+	GetReceiptsRequest struct {
+		UserID    string `json:"user_id"`
+		MessageID int    `json:"message_id"`
+	}
+	MessageReceipts struct {
+		MessageID  int    `json:"message_id"`
+		ChatID     string `json:"chat_id"`
+		Timestamp  string `json:"timestamp"`
+		Status     string `json:"status"`
+		Recipients int    `json:"recipients"`
+		Delivered  int    `json:"delivered"`
+		Read       int    `json:"read"`
+		// Members is left out for chats above the receipt threshold.
+		Members []RecipientReceipt `json:"members,omitempty"`
+	}
+	RecipientReceipt struct {
+		UserID string `json:"user_id"`
+		Status string `json:"status"`
+	}
+	// ReceiptCounts is the payload of receipt_counts_updated, it goes to the sender only.
+	ReceiptCounts struct {
+		ChatID     string                `json:"chat_id"`
+		Recipients int                   `json:"recipients"`
+		Messages   []MessageReceiptCount `json:"messages"`
+	}
+	MessageReceiptCount struct {
+		MessageID int    `json:"message_id"`
+		Timestamp string `json:"timestamp"`
+		Status    string `json:"status"`
+		Delivered int    `json:"delivered"`
+		Read      int    `json:"read"`
+	}
+)
+
+func NewReceiptCursors(chatID, userID string, c vo.ReceiptCursors) ReceiptCursors {
+	cursors := ReceiptCursors{ChatID: chatID, UserID: userID}
+	if c.DeliveredUntil != nil {
+		cursors.DeliveredUntil = c.DeliveredUntil.Format(time.RFC3339Nano)
+	}
+	if c.ReadUntil != nil {
+		cursors.ReadUntil = c.ReadUntil.Format(time.RFC3339Nano)
+	}
+	return cursors
+}
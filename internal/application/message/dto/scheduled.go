@@ -0,0 +1,33 @@
+package dto
+
+type (
+	ScheduleRequest struct {
+		UserID  string `json:"user_id"`
+		ChatID  string `json:"chat_id"`
+		Content string `json:"content"`
+		SendAt  string `json:"send_at"` // RFC3339
+	}
+	ListScheduledRequest struct {
+		UserID string `json:"user_id"`
+		ChatID string `json:"chat_id,omitempty"`
+	}
+	EditScheduledRequest struct {
+		ID      string  `json:"id"`
+		UserID  string  `json:"user_id"`
+		Content *string `json:"content,omitempty"`
+		SendAt  *string `json:"send_at,omitempty"` // RFC3339
+	}
+	CancelScheduledRequest struct {
+		ID     string `json:"id"`
+		UserID string `json:"user_id"`
+	}
+	ScheduledMessage struct {
+		ID        string `json:"id"`
+		ChatID    string `json:"chat_id"`
+		Content   string `json:"content"`
+		SendAt    string `json:"send_at"`
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}
+	ScheduledMessages []ScheduledMessage
+)
@@ -0,0 +1,27 @@
+package dto
+
+type (
+	SearchRequest struct {
+		UserID   string `json:"user_id"`
+		Query    string `json:"q"`
+		ChatID   string `json:"chat_id,omitempty"`
+		SenderID string `json:"sender_id,omitempty"`
+		From     string `json:"from,omitempty"` // RFC3339
+		To       string `json:"to,omitempty"`   // RFC3339
+		Limit    int    `json:"limit,omitempty"`
+		Cursor   string `json:"cursor,omitempty"`
+	}
+	SearchResponse struct {
+		Hits       []SearchHit `json:"hits"`
+		Count      int         `json:"count"`
+		NextCursor string      `json:"next_cursor,omitempty"`
+	}
+	SearchHit struct {
+		ID        int    `json:"id"`
+		ChatID    string `json:"chat_id"`
+		SenderID  string `json:"sender_id"`
+		Text      string `json:"text"`
+		Snippet   string `json:"snippet"`
+		Timestamp string `json:"timestamp"`
+	}
+)
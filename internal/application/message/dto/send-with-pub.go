@@ -3,5 +3,14 @@ package dto
 type (
 	BroadcastWithPubRequest struct {
 		Message
+		TTLSeconds int `json:"ttl_seconds,omitempty"`
+	}
+	// SentMessage answers send_message, the id is the one the message is stored with.
+	SentMessage struct {
+		ID        int    `json:"id"`
+		ChatID    string `json:"chat_id"`
+		Status    string `json:"status"`
+		Timestamp string `json:"timestamp"`
+		ExpiresAt string `json:"expires_at,omitempty"`
 	}
 )
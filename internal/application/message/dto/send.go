@@ -1,11 +1,21 @@
 package dto
 
+import "awesome-chat/internal/domain/core/message/vo"
+
 type (
 	Message struct {
+		// ID is set by the server, clients leave it out when sending.
+		ID        int    `json:"id,omitempty"`
 		UserID    string `json:"user_id"`
 		ChatID    string `json:"chat_id"`
 		Content   string `json:"content"`
 		Timestamp string `json:"timestamp,omitempty"`
+		// Format is plain or markdown, markdown content comes back as plain text with Entities.
+		Format   string      `json:"format,omitempty"`
+		Entities []vo.Entity `json:"entities,omitempty"`
+		// set on messages that reach clients through the message_sent event
+		ExpiresAt     string         `json:"expires_at,omitempty"`
+		ForwardedFrom *ForwardOrigin `json:"forwarded_from,omitempty"`
 	}
 	Messages []Message
 )
@@ -20,11 +30,3 @@ type SendSyncRequest struct {
 	ChatID  string `json:"chat_id"`
 	Content string `json:"content"`
 }
-
-type GetRequest struct {
-	ChatID string `json:"chat_id"`
-	Limit  int    `json:"limit,omitempty"`  // 100
-	Offset int    `json:"offset,omitempty"` // pagination
-	// or
-	Cursor string `json:"cursor,omitempty"` // last message ID (cursor pagination)
-}
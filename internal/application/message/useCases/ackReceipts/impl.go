@@ -0,0 +1,118 @@
+package ackReceipts
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageAckReceiptsUseCase struct {
+	log       appPorts.Logger
+	txManager sharedPorts.TransactionManager
+	store     store.ReceiptStore
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewMessageAckReceiptsUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	store store.ReceiptStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessageAckReceiptsUseCase {
+	return &MessageAckReceiptsUseCase{
+		log:       log,
+		txManager: txManager,
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// Execute moves the receipt cursors of the user forward. In small chats the new cursors go to the
+// whole chat, so senders see their messages delivered and read, and the other devices of the user
+// catch up. Messages are saved asynchronously, so the cursors are shared instead of looking up
+// the senders of the messages they pass. Large chats queue a recount of the per message counts instead.
+func (uc *MessageAckReceiptsUseCase) Execute(
+	ctx context.Context,
+	req dto.AckReceiptsRequest,
+) (
+	cursors dto.ReceiptCursors,
+	err error,
+) {
+	const op = "MessageAckReceiptsUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID}, args...)
+	}
+
+	// clients ack on every received message, so the happy path logs at debug level
+	uc.log.Debug("Attempting to ack receipts", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.ReceiptCursors{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.ReceiptCursors{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	acked, err := vo.ParseReceiptCursors(req.DeliveredUntil, req.ReadUntil, time.Now().UTC())
+	if err != nil {
+		return dto.ReceiptCursors{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.ReceiptCursors{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	advance, err := uc.store.Advance(ctx, userID, chatID, acked)
+	if err != nil {
+		return dto.ReceiptCursors{}, fmt.Errorf("%s: %w", op, err)
+	}
+	cursors = dto.NewReceiptCursors(req.ChatID, req.UserID, advance.Current)
+
+	var (
+		event   eventEntity.ChatEvent
+		publish bool
+	)
+	switch {
+	case !advance.Moved():
+	case vo.CountsReceipts(advance.Members):
+		err = uc.store.Enqueue(ctx, chatID, advance.Since())
+	default:
+		event, err = eventEntity.NewChatEvent(eventVo.ReceiptUpdated, chatID, cursors)
+		publish = err == nil
+	}
+	if err != nil {
+		return dto.ReceiptCursors{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return dto.ReceiptCursors{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if publish {
+		if eventErr := uc.publisher.PublishChatEvent(ctx, event); eventErr != nil {
+			// the cursors are stored, the next ack or a receipts fetch brings senders up to date
+			uc.log.Error("Failed to publish receipt_updated event", withFields("error", eventErr.Error())...)
+		}
+	}
+
+	uc.log.Debug("Successfully acked receipts", withFields("moved", advance.Moved())...)
+
+	return cursors, nil
+}
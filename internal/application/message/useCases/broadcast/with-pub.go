@@ -3,6 +3,7 @@ package broadcast
 import (
 	"awesome-chat/internal/application/message/dto"
 	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
 	"awesome-chat/internal/domain/core/message/vo"
 	"awesome-chat/internal/domain/core/shared/ports"
 	"awesome-chat/internal/domain/core/shared/ports/ws"
@@ -10,30 +11,52 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+type draftClearer interface {
+	Execute(ctx context.Context, userID, chatID string, sentAt time.Time) error
+}
+
+type mentionChecker interface {
+	Execute(ctx context.Context, chatID string, mentions vo.Mentions) error
+}
+
 type MessageBroadcastWithPubImpl struct {
-	log appPorts.Logger
-	pub ports.StreamPublisher
-	br  ws.MessageBroadcaster
+	log         appPorts.Logger
+	permissions chatPorts.PermissionChecker
+	pub         ports.StreamPublisher
+	ids         ports.IDGenerator
+	br          ws.MessageBroadcaster
+	drafts      draftClearer
+	mentions    mentionChecker
 }
 
 func NewMessageBroadcastWithPubImpl(
 	log appPorts.Logger,
+	permissions chatPorts.PermissionChecker,
 	pub ports.StreamPublisher,
+	ids ports.IDGenerator,
 	br ws.MessageBroadcaster,
+	drafts draftClearer,
+	mentions mentionChecker,
 ) *MessageBroadcastWithPubImpl {
 	return &MessageBroadcastWithPubImpl{
-		log: log,
-		pub: pub,
-		br:  br,
+		log:         log,
+		permissions: permissions,
+		pub:         pub,
+		ids:         ids,
+		br:          br,
+		drafts:      drafts,
+		mentions:    mentions,
 	}
 }
 
 func (m *MessageBroadcastWithPubImpl) Execute(
 	ctx context.Context,
 	req dto.BroadcastWithPubRequest,
-) error {
+) (dto.SentMessage, error) {
 	const op = "MessageBroadcastWithPub.Execute"
 	withFields := func(fields ...any) []any {
 		return append([]any{
@@ -42,30 +65,92 @@ func (m *MessageBroadcastWithPubImpl) Execute(
 	}
 	m.log.Info("Starting operation", withFields()...)
 
+	ttl, err := vo.ParseTTL(req.TTLSeconds)
+	if err != nil {
+		return dto.SentMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	format, err := vo.ParseFormat(req.Format)
+	if err != nil {
+		return dto.SentMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+	rich, err := vo.ParseRichText(req.Content, format)
+	if err != nil {
+		return dto.SentMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.SentMessage{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.SentMessage{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	if err = m.permissions.RequireSend(ctx, chatID, userID); err != nil {
+		m.log.Warn("Send rejected.", withFields("chat_id", req.ChatID, "error", err.Error())...)
+		return dto.SentMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
 	timestamp := time.Now().UTC()
 
-	if err := m.pub.Publish(ctx, vo.StreamMessage{
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = timestamp.Add(ttl)
+	}
+
+	// the id is known before the worker stores the message, so the sender can refer to it right away
+	streamMessage := vo.StreamMessage{
+		ID:        m.ids.NextID(),
 		Event:     vo.SentMessageEvent,
 		UserID:    req.UserID,
 		ChatID:    req.ChatID,
-		Content:   req.Content,
+		Content:   rich.Text,
 		Timestamp: timestamp,
-	}.ToMap()); err != nil {
+		ExpiresAt: expiresAt,
+		Format:    rich.Format,
+		Entities:  rich.Entities,
+	}
+
+	if err = m.mentions.Execute(ctx, req.ChatID, streamMessage.Mentions()); err != nil {
+		return dto.SentMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = m.pub.Publish(ctx, streamMessage.ToMap()); err != nil {
 		m.log.Error("Failed to publish message.",
 			withFields("error", err.Error())...)
-		return fmt.Errorf("%s: %w", op, err)
+		return dto.SentMessage{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	if err := m.br.Broadcast(ctx, chathub.Message{ // TODO: prefer to replace into domain
+	// the message is already published, a draft left behind is not worth failing the send
+	if err = m.drafts.Execute(ctx, req.UserID, req.ChatID, timestamp); err != nil {
+		m.log.Warn("Failed to clear draft.", withFields("error", err.Error())...)
+	}
+
+	message := chathub.Message{ // TODO: prefer to replace into domain
+		ID:        streamMessage.ID,
 		UserID:    req.UserID,
 		ChatID:    req.ChatID,
-		Content:   req.Content,
+		Content:   rich.Text,
 		Timestamp: timestamp.Format(time.RFC3339Nano),
-	}); err != nil {
+		Format:    string(rich.Format),
+		Entities:  rich.Entities,
+	}
+	if !expiresAt.IsZero() {
+		message.ExpiresAt = expiresAt.Format(time.RFC3339Nano)
+	}
+
+	if err = m.br.Broadcast(ctx, message); err != nil {
 		m.log.Error("Failed to broadcast message. Message will be saved to DB.",
 			withFields("error", err.Error())...)
-		return fmt.Errorf("%s: %w", op, err)
+		return dto.SentMessage{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return dto.SentMessage{
+		ID:        streamMessage.ID,
+		ChatID:    req.ChatID,
+		Status:    string(vo.DeliverySent),
+		Timestamp: message.Timestamp,
+		ExpiresAt: message.ExpiresAt,
+	}, nil
 }
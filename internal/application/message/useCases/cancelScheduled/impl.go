@@ -0,0 +1,52 @@
+package cancelScheduled
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type MessageCancelScheduledUseCase struct {
+	log   appPorts.Logger
+	store store.ScheduledStore
+}
+
+func NewMessageCancelScheduledUseCase(
+	log appPorts.Logger,
+	store store.ScheduledStore,
+) *MessageCancelScheduledUseCase {
+	return &MessageCancelScheduledUseCase{
+		log:   log,
+		store: store,
+	}
+}
+
+func (uc *MessageCancelScheduledUseCase) Execute(ctx context.Context, req dto.CancelScheduledRequest) error {
+	const op = "MessageCancelScheduledUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "id", req.ID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to cancel scheduled message", withFields()...)
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	if err = uc.store.Cancel(ctx, id, userID); err != nil {
+		uc.log.Error("Failed to cancel scheduled message", withFields("error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully cancelled scheduled message", withFields()...)
+	return nil
+}
@@ -0,0 +1,63 @@
+package checkMentions
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	chatVo "awesome-chat/internal/domain/core/chat/vo"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type MessageCheckMentionsUseCase struct {
+	log   appPorts.Logger
+	store chatPorts.MentionStore
+}
+
+func NewMessageCheckMentionsUseCase(
+	log appPorts.Logger,
+	store chatPorts.MentionStore,
+) *MessageCheckMentionsUseCase {
+	return &MessageCheckMentionsUseCase{
+		log:   log,
+		store: store,
+	}
+}
+
+// Execute refuses a message mentioning non-members when the chat asks for it.
+// With the default policy nothing is checked here, the saver keeps mentions of members only.
+func (uc *MessageCheckMentionsUseCase) Execute(ctx context.Context, chatID string, mentions vo.Mentions) error {
+	const op = "MessageCheckMentionsUseCase.Execute"
+
+	if len(mentions.Usernames) == 0 {
+		return nil
+	}
+
+	id, err := uuid.Parse(chatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	policy, err := uc.store.MentionPolicy(ctx, id)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if policy != chatVo.MentionPolicyReject {
+		return nil
+	}
+
+	missing, err := uc.store.MissingMembers(ctx, id, mentions.Usernames)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if len(missing) > 0 {
+		uc.log.Debug("Rejected mentions of non-members", "op", op, "chat_id", chatID, "usernames", missing)
+		return fmt.Errorf("%s: %w: @%s", op, msgErrors.ErrMentionNotMember, strings.Join(missing, ", @"))
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package countReceipts
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	chatsPerRun     = 20
+	messagesPerChat = 500
+)
+
+type MessageCountReceiptsUseCase struct {
+	log       appPorts.Logger
+	txManager sharedPorts.TransactionManager
+	store     store.ReceiptCountStore
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewMessageCountReceiptsUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	store store.ReceiptCountStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessageCountReceiptsUseCase {
+	return &MessageCountReceiptsUseCase{
+		log:       log,
+		txManager: txManager,
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// Execute redoes the receipt counts of the queued large chats and reports how many chats it handled.
+// Every sender gets one receipt_counts_updated with the counts of their messages that changed,
+// however many members moved their cursors since the last run.
+func (uc *MessageCountReceiptsUseCase) Execute(ctx context.Context) (counted int, err error) {
+	const op = "MessageCountReceiptsUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op}, args...)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil || counted == 0 {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	queued, err := uc.store.ClaimQueued(ctx, chatsPerRun)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(queued) == 0 {
+		return 0, nil
+	}
+
+	var events []eventEntity.ChatEvent
+	for _, q := range queued {
+		recount, recountErr := uc.store.Recount(ctx, q.ChatID, q.Since, messagesPerChat)
+		if recountErr != nil {
+			return 0, fmt.Errorf("%s: %w", op, recountErr)
+		}
+
+		if recount.Scanned == messagesPerChat {
+			if err = uc.store.Enqueue(ctx, q.ChatID, recount.Last); err != nil {
+				return 0, fmt.Errorf("%s: %w", op, err)
+			}
+		}
+
+		chatEvents, eventsErr := senderEvents(q.ChatID, recount)
+		if eventsErr != nil {
+			return 0, fmt.Errorf("%s: %w", op, eventsErr)
+		}
+		events = append(events, chatEvents...)
+	}
+
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	counted = len(queued)
+
+	for _, event := range events {
+		if eventErr := uc.publisher.PublishChatEvent(ctx, event); eventErr != nil {
+			// the counts are stored, the sender sees them on the next change or fetch
+			uc.log.Error("Failed to publish receipt_counts_updated event", withFields("error", eventErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully counted receipts", withFields("chats", counted, "events", len(events))...)
+
+	return counted, nil
+}
+
+// senderEvents groups the changed counts of one chat by sender.
+func senderEvents(chatID uuid.UUID, recount entity.ReceiptRecount) ([]eventEntity.ChatEvent, error) {
+	recipients := max(recount.Members-1, 0)
+
+	var senders []uuid.UUID
+	bySender := make(map[uuid.UUID][]dto.MessageReceiptCount)
+	for _, c := range recount.Changed {
+		if _, ok := bySender[c.SenderID]; !ok {
+			senders = append(senders, c.SenderID)
+		}
+		bySender[c.SenderID] = append(bySender[c.SenderID], dto.MessageReceiptCount{
+			MessageID: c.MessageID,
+			Timestamp: c.Timestamp.Format(time.RFC3339Nano),
+			Status:    string(vo.AggregateStatus(recipients, c.Delivered, c.Read)),
+			Delivered: c.Delivered,
+			Read:      c.Read,
+		})
+	}
+
+	events := make([]eventEntity.ChatEvent, 0, len(senders))
+	for _, sender := range senders {
+		event, err := eventEntity.NewUserChatEvent(eventVo.ReceiptCountsUpdated, chatID, sender, dto.ReceiptCounts{
+			ChatID:     chatID.String(),
+			Recipients: recipients,
+			Messages:   bySender[sender],
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,106 @@
+package createPoll
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageCreatePollUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions chatPorts.PermissionChecker
+	store       store.PollStore
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewMessageCreatePollUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions chatPorts.PermissionChecker,
+	store store.PollStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessageCreatePollUseCase {
+	return &MessageCreatePollUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		store:       store,
+		publisher:   publisher,
+	}
+}
+
+// Execute stores the poll message with its options and announces it to the chat.
+// Polls skip the message stream, the id is needed right away to vote.
+func (uc *MessageCreatePollUseCase) Execute(ctx context.Context, req dto.CreatePollRequest) (poll dto.Poll, err error) {
+	const op = "MessageCreatePollUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID}, args...)
+	}
+
+	uc.log.Info("Attempting to create poll", withFields("options", len(req.Options))...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+	draft, err := vo.NewPollDraft(req.Question, req.Options, req.MultipleChoice, req.Anonymous, req.ClosesAt, now)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.permissions.RequireSend(ctx, chatID, userID); err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	created, err := uc.store.Create(ctx, userID, chatID, draft)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	poll = dto.NewPoll(created, now)
+
+	event, eventErr := eventEntity.NewChatEvent(eventVo.PollCreated, chatID, poll)
+	if eventErr == nil {
+		eventErr = uc.publisher.PublishChatEvent(ctx, event)
+	}
+	if eventErr != nil {
+		// the poll is stored, clients see it on the next fetch
+		uc.log.Error("Failed to publish poll_created event", withFields("error", eventErr.Error())...)
+	}
+
+	poll.MyVote = created.MyVote
+
+	uc.log.Info("Successfully created poll", withFields("message_id", created.MessageID)...)
+
+	return poll, nil
+}
@@ -0,0 +1,113 @@
+package deleteMessage
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type MessageDeleteUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	permissions chatPorts.PermissionChecker
+	store       store.DeleteStore
+	publisher   sharedPorts.ChatEventPublisher
+	pins        pinInvalidator
+}
+
+// pinInvalidator drops the cached pins of the chat, a deleted message no longer shows among them.
+type pinInvalidator interface {
+	Invalidate(ctx context.Context, chatID uuid.UUID) error
+}
+
+func NewMessageDeleteUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	permissions chatPorts.PermissionChecker,
+	store store.DeleteStore,
+	publisher sharedPorts.ChatEventPublisher,
+	pins pinInvalidator,
+) *MessageDeleteUseCase {
+	return &MessageDeleteUseCase{
+		log:         log,
+		txManager:   txManager,
+		permissions: permissions,
+		store:       store,
+		publisher:   publisher,
+		pins:        pins,
+	}
+}
+
+// Execute deletes a message of the chat. Members delete their own messages,
+// the messages of others take the delete permission.
+func (uc *MessageDeleteUseCase) Execute(ctx context.Context, req dto.DeleteMessageRequest) (err error) {
+	const op = "MessageDeleteUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID, "message_id", req.MessageID}, args...)
+	}
+
+	uc.log.Info("Attempting to delete message", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	txCtx, err := uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(txCtx)
+		}
+	}()
+
+	authorID, err := uc.store.Author(txCtx, chatID, req.MessageID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = uc.permissions.RequireDelete(txCtx, chatID, userID, authorID); err != nil {
+		uc.log.Warn("Message delete rejected", withFields("author_id", authorID, "error", err.Error())...)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.store.Delete(txCtx, chatID, req.MessageID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	deleted, err := eventEntity.NewChatEvent(eventVo.MessageDeleted, chatID, dto.MessageDeletedEvent{
+		MessageID: req.MessageID,
+		UserID:    req.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(txCtx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if cacheErr := uc.pins.Invalidate(ctx, chatID); cacheErr != nil {
+		uc.log.Error("Failed to invalidate cached pins", withFields("error", cacheErr.Error())...)
+	}
+
+	if pubErr := uc.publisher.PublishChatEvent(ctx, deleted); pubErr != nil {
+		uc.log.Error("Failed to publish message_deleted event", withFields("error", pubErr.Error())...)
+	}
+
+	uc.log.Info("Successfully deleted message", withFields()...)
+
+	return nil
+}
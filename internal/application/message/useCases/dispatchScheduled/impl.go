@@ -0,0 +1,133 @@
+package dispatchScheduled
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const batchSize = 100
+
+type MessageDispatchScheduledUseCase struct {
+	log       appPorts.Logger
+	txManager sharedPorts.TransactionManager
+	store     store.ScheduledDispatchStore
+	stream    sharedPorts.StreamPublisher
+	ids       sharedPorts.IDGenerator
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewMessageDispatchScheduledUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	store store.ScheduledDispatchStore,
+	stream sharedPorts.StreamPublisher,
+	ids sharedPorts.IDGenerator,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessageDispatchScheduledUseCase {
+	return &MessageDispatchScheduledUseCase{
+		log:       log,
+		txManager: txManager,
+		store:     store,
+		stream:    stream,
+		ids:       ids,
+		publisher: publisher,
+	}
+}
+
+// Execute sends one batch of due messages and reports how many went out.
+// Messages go into the sent-message stream like any other message, so the batch saver stores them.
+// The rows stay locked until commit: a crash before MarkSent may resend a message, but never loses one.
+func (uc *MessageDispatchScheduledUseCase) Execute(ctx context.Context) (sent int, err error) {
+	const op = "MessageDispatchScheduledUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op}, args...)
+	}
+
+	cancelled, err := uc.store.CancelOrphaned(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if cancelled > 0 {
+		uc.log.Info("Cancelled scheduled messages of former chat members", withFields("count", cancelled)...)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil || sent == 0 {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	due, err := uc.store.ClaimDue(ctx, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(due) == 0 {
+		return 0, nil
+	}
+
+	uc.log.Info("Attempting to dispatch scheduled messages", withFields("count", len(due))...)
+
+	ids := make([]uuid.UUID, 0, len(due))
+	for _, m := range due {
+		timestamp := time.Now().UTC()
+		messageID := uc.ids.NextID()
+
+		if pubErr := uc.stream.Publish(ctx, vo.StreamMessage{
+			ID:        messageID,
+			Event:     vo.SentMessageEvent,
+			UserID:    m.UserID.String(),
+			ChatID:    m.ChatID.String(),
+			Content:   m.Content,
+			Timestamp: timestamp,
+		}.ToMap()); pubErr != nil {
+			// the rest of the batch stays pending and is retried on the next run
+			uc.log.Error("Failed to publish scheduled message", withFields("id", m.ID, "error", pubErr.Error())...)
+			break
+		}
+		ids = append(ids, m.ID)
+
+		event, eventErr := eventEntity.NewChatEvent(eventVo.MessageSent, m.ChatID, dto.Message{
+			ID:        messageID,
+			UserID:    m.UserID.String(),
+			ChatID:    m.ChatID.String(),
+			Content:   m.Content,
+			Timestamp: timestamp.Format(time.RFC3339Nano),
+		})
+		if eventErr == nil {
+			eventErr = uc.publisher.PublishChatEvent(ctx, event)
+		}
+		if eventErr != nil {
+			// the message is in the stream and will be saved, clients see it on the next fetch
+			uc.log.Error("Failed to publish message_sent event", withFields("id", m.ID, "error", eventErr.Error())...)
+		}
+	}
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%s: no scheduled message could be published", op)
+	}
+
+	if err = uc.store.MarkSent(ctx, ids); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	sent = len(ids)
+
+	uc.log.Info("Successfully dispatched scheduled messages", withFields("count", sent)...)
+
+	return sent, nil
+}
@@ -0,0 +1,89 @@
+package editScheduled
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageEditScheduledUseCase struct {
+	log   appPorts.Logger
+	store store.ScheduledStore
+}
+
+func NewMessageEditScheduledUseCase(
+	log appPorts.Logger,
+	store store.ScheduledStore,
+) *MessageEditScheduledUseCase {
+	return &MessageEditScheduledUseCase{
+		log:   log,
+		store: store,
+	}
+}
+
+func (uc *MessageEditScheduledUseCase) Execute(
+	ctx context.Context,
+	req dto.EditScheduledRequest,
+) (
+	dto.ScheduledMessage,
+	error,
+) {
+	const op = "MessageEditScheduledUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "id", req.ID, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to edit scheduled message", withFields()...)
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: invalid id: %w", op, err)
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	var update vo.ScheduledUpdate
+	if req.Content != nil {
+		content, contentErr := vo.NormalizeContent(*req.Content)
+		if contentErr != nil {
+			return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, contentErr)
+		}
+		update.Content = &content
+	}
+	if req.SendAt != nil {
+		sendAt, sendAtErr := vo.ParseSendAt(*req.SendAt, time.Now().UTC())
+		if sendAtErr != nil {
+			return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, sendAtErr)
+		}
+		update.SendAt = &sendAt
+	}
+	if update.Content == nil && update.SendAt == nil {
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, msgErrors.ErrNothingToUpdate)
+	}
+
+	m, err := uc.store.Update(ctx, id, userID, update)
+	if err != nil {
+		uc.log.Error("Failed to edit scheduled message", withFields("error", err.Error())...)
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully edited scheduled message", withFields()...)
+
+	return dto.ScheduledMessage{
+		ID:        m.ID.String(),
+		ChatID:    m.ChatID.String(),
+		Content:   m.Content,
+		SendAt:    m.SendAt.Format(time.RFC3339),
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: m.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
@@ -4,8 +4,8 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
-	"sync"
 	"time"
 )
 
@@ -43,97 +43,44 @@ func NewMessageSaveAndBroadcastUseCase(broadcastURL, saveURL string) *MessageSav
 	}
 }
 
+// Execute saves the message and broadcasts it once the save went through. The save endpoint checks
+// the sender may post, so a rejected message never reaches the other members.
 func (uc *MessageSaveAndBroadcastUseCase) Execute(ctx context.Context, payload []byte) error {
-	broadcastBuff := bytes.NewBuffer(payload)
-	saveBuff := bytes.NewBuffer(payload)
-
-	errChan := make(chan error, 2)
-	var wg sync.WaitGroup
-
-	wg.Add(2)
-	go uc.broadcastMessage(ctx, broadcastBuff, errChan, &wg)
-	go uc.saveMessage(ctx, saveBuff, errChan, &wg)
-
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
-
-	errs := make([]error, 0, 2)
-	for err := range errChan {
-		if err != nil {
-			errs = append(errs, err)
-		}
+	if err := uc.post(ctx, uc.saveURL, payload, uc.timeout.save); err != nil {
+		return fmt.Errorf("save failed: %w", err)
 	}
 
-	if len(errs) > 0 {
-		return errs[0]
+	if err := uc.post(ctx, uc.broadcastURL, payload, uc.timeout.broadcast); err != nil {
+		return fmt.Errorf("broadcast failed: %w", err)
 	}
-	return nil
-}
 
-func (uc *MessageSaveAndBroadcastUseCase) broadcastMessage(
-	ctx context.Context,
-	msgBuff *bytes.Buffer,
-	errChan chan<- error,
-	wg *sync.WaitGroup,
-) {
-	defer wg.Done()
-
-	ctx, cancel := context.WithTimeout(ctx, uc.timeout.broadcast)
-	defer cancel()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uc.broadcastURL, msgBuff)
-	if err != nil {
-		errChan <- err
-		return
-	}
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := uc.client.Do(req)
-	if err != nil {
-		errChan <- err
-		return
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode >= http.StatusBadRequest {
-		errChan <- errors.New("broadcast failed with status: " + resp.Status)
-		return
-	}
-
-	errChan <- nil
+	return nil
 }
 
-func (uc *MessageSaveAndBroadcastUseCase) saveMessage(
+func (uc *MessageSaveAndBroadcastUseCase) post(
 	ctx context.Context,
-	msgBuff *bytes.Buffer,
-	errChan chan<- error,
-	wg *sync.WaitGroup,
-) {
-	defer wg.Done()
-
-	ctx, cancel := context.WithTimeout(ctx, uc.timeout.save)
+	url string,
+	payload []byte,
+	timeout time.Duration,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uc.saveURL, msgBuff)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
 	if err != nil {
-		errChan <- err
-		return
+		return err
 	}
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := uc.client.Do(req)
 	if err != nil {
-		errChan <- err
-		return
+		return err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode >= http.StatusBadRequest {
-		errChan <- errors.New("save failed with status: " + resp.Status)
-		return
+		return errors.New("status: " + resp.Status)
 	}
 
-	errChan <- nil
+	return nil
 }
@@ -0,0 +1,204 @@
+package forward
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageForwardUseCase struct {
+	log         appPorts.Logger
+	validator   chatPorts.ValidateStore
+	permissions chatPorts.PermissionChecker
+	store       store.ForwardStore
+	stream      sharedPorts.StreamPublisher
+	ids         sharedPorts.IDGenerator
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewMessageForwardUseCase(
+	log appPorts.Logger,
+	validator chatPorts.ValidateStore,
+	permissions chatPorts.PermissionChecker,
+	store store.ForwardStore,
+	stream sharedPorts.StreamPublisher,
+	ids sharedPorts.IDGenerator,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessageForwardUseCase {
+	return &MessageForwardUseCase{
+		log:         log,
+		validator:   validator,
+		permissions: permissions,
+		store:       store,
+		stream:      stream,
+		ids:         ids,
+		publisher:   publisher,
+	}
+}
+
+// Execute copies messages into other chats through the sent-message stream.
+// The saver stores the attribution, the database copies attachments and voice rows of the source,
+// so media objects are shared instead of uploaded again.
+func (uc *MessageForwardUseCase) Execute(ctx context.Context, req dto.ForwardRequest) (dto.ForwardResponse, error) {
+	const op = "MessageForwardUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "from_chat_id", req.FromChatID}, args...)
+	}
+
+	uc.log.Info("Attempting to forward messages", withFields("messages", len(req.MessageIDs))...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.ForwardResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	fromChatID, err := uuid.Parse(req.FromChatID)
+	if err != nil {
+		return dto.ForwardResponse{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	messageIDs := uniqueInts(req.MessageIDs)
+	switch {
+	case len(messageIDs) == 0 || len(req.ToChatIDs) == 0:
+		return dto.ForwardResponse{}, fmt.Errorf("%s: %w", op, msgErrors.ErrNothingToForward)
+	case len(messageIDs) > vo.MaxForwardMessages:
+		return dto.ForwardResponse{}, fmt.Errorf("%s: %w", op, msgErrors.ErrTooManyForwards)
+	}
+
+	targets := make([]uuid.UUID, 0, len(req.ToChatIDs))
+	seen := make(map[uuid.UUID]struct{}, len(req.ToChatIDs))
+	for _, raw := range req.ToChatIDs {
+		chatID, parseErr := uuid.Parse(raw)
+		if parseErr != nil {
+			return dto.ForwardResponse{}, fmt.Errorf("%s: invalid target chat id: %w", op, parseErr)
+		}
+		if _, ok := seen[chatID]; ok {
+			continue
+		}
+		seen[chatID] = struct{}{}
+		targets = append(targets, chatID)
+	}
+	if len(targets) > vo.MaxForwardTargets {
+		return dto.ForwardResponse{}, fmt.Errorf("%s: %w", op, msgErrors.ErrTooManyForwardTarget)
+	}
+
+	isMember, err := uc.validator.IsMember(ctx, fromChatID, userID)
+	if err != nil {
+		return dto.ForwardResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		uc.log.Warn("Forward rejected, user is not a chat member", withFields("chat_id", fromChatID)...)
+		return dto.ForwardResponse{}, fmt.Errorf("%s: chat %s: %w", op, fromChatID, chatErrors.ErrNotChatMember)
+	}
+	// reading a message is enough to forward it, the target chats have to let the user post
+	for _, chatID := range targets {
+		if err = uc.permissions.RequireSend(ctx, chatID, userID); err != nil {
+			uc.log.Warn("Forward rejected", withFields("chat_id", chatID, "error", err.Error())...)
+			return dto.ForwardResponse{}, fmt.Errorf("%s: chat %s: %w", op, chatID, err)
+		}
+	}
+
+	sources, err := uc.store.GetSources(ctx, fromChatID, messageIDs)
+	if err != nil {
+		return dto.ForwardResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(sources) != len(messageIDs) {
+		return dto.ForwardResponse{}, fmt.Errorf("%s: %w", op, msgErrors.ErrForwardSourceMissing)
+	}
+
+	var forwardedIDs []int
+	for _, chatID := range targets {
+		// messages keep their relative order inside each target chat
+		base := time.Now().UTC()
+		for i, src := range sources {
+			timestamp := base.Add(time.Duration(i) * time.Microsecond)
+
+			msg := vo.StreamMessage{
+				ID:            uc.ids.NextID(),
+				Event:         vo.SentMessageEvent,
+				UserID:        req.UserID,
+				ChatID:        chatID.String(),
+				Content:       src.Content,
+				Format:        src.Format,
+				Entities:      src.Entities,
+				Timestamp:     timestamp,
+				ForwardedFrom: src.Origin,
+			}
+			// a forward must not outlive a self-destructing original
+			if src.ExpiresAt != nil {
+				msg.ExpiresAt = *src.ExpiresAt
+			}
+
+			if err = uc.stream.Publish(ctx, msg.ToMap()); err != nil {
+				uc.log.Error("Failed to publish forwarded message",
+					withFields("chat_id", chatID, "forwarded", len(forwardedIDs), "error", err.Error())...)
+				return dto.ForwardResponse{}, fmt.Errorf("%s: %w", op, err)
+			}
+			forwardedIDs = append(forwardedIDs, msg.ID)
+
+			uc.broadcast(ctx, chatID, msg, withFields)
+		}
+	}
+
+	uc.log.Info("Successfully forwarded messages", withFields("forwarded", len(forwardedIDs), "targets", len(targets))...)
+
+	return dto.ForwardResponse{Forwarded: len(forwardedIDs), MessageIDs: forwardedIDs}, nil
+}
+
+func (uc *MessageForwardUseCase) broadcast(
+	ctx context.Context,
+	chatID uuid.UUID,
+	msg vo.StreamMessage,
+	withFields func(args ...any) []any,
+) {
+	payload := dto.Message{
+		ID:        msg.ID,
+		UserID:    msg.UserID,
+		ChatID:    msg.ChatID,
+		Content:   msg.Content,
+		Format:    string(msg.Format),
+		Entities:  msg.Entities,
+		Timestamp: msg.Timestamp.Format(time.RFC3339Nano),
+		ForwardedFrom: &dto.ForwardOrigin{
+			MessageID: msg.ForwardedFrom.MessageID,
+			UserID:    msg.ForwardedFrom.UserID,
+			ChatID:    msg.ForwardedFrom.ChatID,
+		},
+	}
+	if !msg.ExpiresAt.IsZero() {
+		payload.ExpiresAt = msg.ExpiresAt.Format(time.RFC3339Nano)
+	}
+
+	event, err := eventEntity.NewChatEvent(eventVo.MessageSent, chatID, payload)
+	if err == nil {
+		err = uc.publisher.PublishChatEvent(ctx, event)
+	}
+	if err != nil {
+		// the message is in the stream and will be saved, clients see it on the next fetch
+		uc.log.Error("Failed to publish message_sent event", withFields("chat_id", chatID, "error", err.Error())...)
+	}
+}
+
+func uniqueInts(values []int) []int {
+	seen := make(map[int]struct{}, len(values))
+	result := make([]int, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package getPage
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type pollStore interface {
+	GetMany(ctx context.Context, messageIDs []int, viewer uuid.UUID) (map[int]entity.Poll, error)
+}
+
+type MessageGetPageUseCase struct {
+	log   appPorts.Logger
+	store store.PageStore
+	polls pollStore
+}
+
+func NewMessageGetPageUseCase(
+	log appPorts.Logger,
+	store store.PageStore,
+	polls pollStore,
+) *MessageGetPageUseCase {
+	return &MessageGetPageUseCase{
+		log:   log,
+		store: store,
+		polls: polls,
+	}
+}
+
+// Execute reads one page of the chat history. The returned cursors are opaque,
+// older_cursor goes into before and newer_cursor into after of the next request.
+func (uc *MessageGetPageUseCase) Execute(ctx context.Context, req dto.PageRequest) (dto.PageResponse, error) {
+	const op = "MessageGetPageUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "chat_id", req.ChatID}, args...)
+	}
+
+	uc.log.Info("Attempting to get messages page", withFields()...)
+
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.PageResponse{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	// the viewer is optional, without it polls come without my_vote
+	var viewer uuid.UUID
+	if req.UserID != "" {
+		if viewer, err = uuid.Parse(req.UserID); err != nil {
+			return dto.PageResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+		}
+	}
+
+	q, err := vo.NewPageQuery(chatID, req.Before, req.After, req.AroundID, req.Limit)
+	if err != nil {
+		return dto.PageResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	page, err := uc.store.Page(ctx, q)
+	if err != nil {
+		return dto.PageResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var pollIDs []int
+	for _, message := range page.Messages {
+		if message.Type == vo.MessageTypePoll {
+			pollIDs = append(pollIDs, message.ID)
+		}
+	}
+	polls, err := uc.polls.GetMany(ctx, pollIDs, viewer)
+	if err != nil {
+		return dto.PageResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	now := time.Now().UTC()
+
+	resp := dto.PageResponse{
+		Messages: make([]dto.PagedMessage, 0, len(page.Messages)),
+		Count:    len(page.Messages),
+	}
+	for _, message := range page.Messages {
+		msg := dto.PagedMessage{
+			ID:        message.ID,
+			Type:      message.Type,
+			Text:      message.Text,
+			Format:    string(message.Format),
+			Entities:  message.Entities,
+			SenderID:  message.SenderID.String(),
+			Timestamp: message.Timestamp.Format(time.RFC3339Nano),
+			System:    message.System,
+		}
+		if message.LinkPreview != nil {
+			msg.LinkPreview = &dto.LinkPreview{
+				URL:         message.LinkPreview.URL,
+				Title:       message.LinkPreview.Title,
+				Description: message.LinkPreview.Description,
+				ImageURL:    message.LinkPreview.ImageURL,
+				SiteName:    message.LinkPreview.SiteName,
+			}
+		}
+		if message.ForwardedFrom != nil {
+			msg.ForwardedFrom = &dto.ForwardOrigin{
+				MessageID: message.ForwardedFrom.MessageID,
+				UserID:    message.ForwardedFrom.UserID,
+				ChatID:    message.ForwardedFrom.ChatID,
+			}
+		}
+		if p, ok := polls[message.ID]; ok {
+			poll := dto.NewPoll(p, now)
+			poll.MyVote = p.MyVote
+			msg.Poll = &poll
+		}
+		resp.Messages = append(resp.Messages, msg)
+	}
+
+	if n := len(page.Messages); n > 0 {
+		newest, oldest := page.Messages[0], page.Messages[n-1]
+		if page.HasNewer {
+			resp.NewerCursor = vo.Cursor{CreatedAt: newest.Timestamp, ID: newest.ID}.Encode()
+		}
+		if page.HasOlder {
+			resp.OlderCursor = vo.Cursor{CreatedAt: oldest.Timestamp, ID: oldest.ID}.Encode()
+		}
+	}
+
+	uc.log.Info("Successfully got messages page", withFields("direction", q.Direction, "count", resp.Count)...)
+
+	return resp, nil
+}
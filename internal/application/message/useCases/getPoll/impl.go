@@ -0,0 +1,68 @@
+package getPoll
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageGetPollUseCase struct {
+	log       appPorts.Logger
+	validator chatPorts.ValidateStore
+	store     store.PollStore
+}
+
+func NewMessageGetPollUseCase(
+	log appPorts.Logger,
+	validator chatPorts.ValidateStore,
+	store store.PollStore,
+) *MessageGetPollUseCase {
+	return &MessageGetPollUseCase{
+		log:       log,
+		validator: validator,
+		store:     store,
+	}
+}
+
+// Execute returns the tally of the poll together with the vote of the caller.
+func (uc *MessageGetPollUseCase) Execute(ctx context.Context, req dto.GetPollRequest) (dto.Poll, error) {
+	const op = "MessageGetPollUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "message_id", req.MessageID}, args...)
+	}
+
+	uc.log.Info("Attempting to get poll", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	p, err := uc.store.Get(ctx, req.MessageID, userID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	isMember, err := uc.validator.IsMember(ctx, p.ChatID, userID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		uc.log.Warn("Poll read rejected, user is not a chat member", withFields("chat_id", p.ChatID)...)
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	poll := dto.NewPoll(p, time.Now().UTC())
+	poll.MyVote = p.MyVote
+
+	uc.log.Info("Successfully got poll", withFields()...)
+
+	return poll, nil
+}
@@ -0,0 +1,92 @@
+package getReceipts
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageGetReceiptsUseCase struct {
+	log   appPorts.Logger
+	store store.ReceiptStore
+}
+
+func NewMessageGetReceiptsUseCase(
+	log appPorts.Logger,
+	store store.ReceiptStore,
+) *MessageGetReceiptsUseCase {
+	return &MessageGetReceiptsUseCase{
+		log:   log,
+		store: store,
+	}
+}
+
+// Execute returns the delivery state of a message to its sender. Chats up to the receipt
+// threshold list every recipient, larger ones report the stored counts only.
+func (uc *MessageGetReceiptsUseCase) Execute(ctx context.Context, req dto.GetReceiptsRequest) (dto.MessageReceipts, error) {
+	const op = "MessageGetReceiptsUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "message_id", req.MessageID}, args...)
+	}
+
+	uc.log.Info("Attempting to get message receipts", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.MessageReceipts{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	r, err := uc.store.Get(ctx, req.MessageID)
+	if err != nil {
+		return dto.MessageReceipts{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if r.SenderID != userID {
+		uc.log.Warn("Receipts read rejected, user is not the sender", withFields()...)
+		return dto.MessageReceipts{}, fmt.Errorf("%s: %w", op, msgErrors.ErrNotMessageSender)
+	}
+
+	resp := dto.MessageReceipts{
+		MessageID:  r.MessageID,
+		ChatID:     r.ChatID.String(),
+		Timestamp:  r.Timestamp.Format(time.RFC3339Nano),
+		Recipients: max(r.Members-1, 0),
+		Delivered:  r.Delivered,
+		Read:       r.Read,
+	}
+
+	if !vo.CountsReceipts(r.Members) {
+		recipients, recipientsErr := uc.store.Recipients(ctx, r.ChatID, r.SenderID)
+		if recipientsErr != nil {
+			return dto.MessageReceipts{}, fmt.Errorf("%s: %w", op, recipientsErr)
+		}
+
+		resp.Recipients, resp.Delivered, resp.Read = len(recipients), 0, 0
+		resp.Members = make([]dto.RecipientReceipt, 0, len(recipients))
+		for _, recipient := range recipients {
+			status := recipient.Cursors.StatusOf(r.Timestamp)
+			switch status {
+			case vo.DeliveryRead:
+				resp.Read++
+				resp.Delivered++
+			case vo.DeliveryDelivered:
+				resp.Delivered++
+			}
+			resp.Members = append(resp.Members, dto.RecipientReceipt{
+				UserID: recipient.UserID.String(),
+				Status: string(status),
+			})
+		}
+	}
+	resp.Status = string(vo.AggregateStatus(resp.Recipients, resp.Delivered, resp.Read))
+
+	uc.log.Info("Successfully got message receipts", withFields("status", resp.Status)...)
+
+	return resp, nil
+}
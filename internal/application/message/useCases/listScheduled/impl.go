@@ -0,0 +1,77 @@
+package listScheduled
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageListScheduledUseCase struct {
+	log   appPorts.Logger
+	store store.ScheduledStore
+}
+
+func NewMessageListScheduledUseCase(
+	log appPorts.Logger,
+	store store.ScheduledStore,
+) *MessageListScheduledUseCase {
+	return &MessageListScheduledUseCase{
+		log:   log,
+		store: store,
+	}
+}
+
+func (uc *MessageListScheduledUseCase) Execute(
+	ctx context.Context,
+	req dto.ListScheduledRequest,
+) (
+	dto.ScheduledMessages,
+	error,
+) {
+	const op = "MessageListScheduledUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to list scheduled messages", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	var chatID *uuid.UUID
+	if req.ChatID != "" {
+		id, parseErr := uuid.Parse(req.ChatID)
+		if parseErr != nil {
+			return nil, fmt.Errorf("%s: invalid chat id: %w", op, parseErr)
+		}
+		chatID = &id
+	}
+
+	messages, err := uc.store.ListPending(ctx, userID, chatID)
+	if err != nil {
+		uc.log.Error("Failed to list scheduled messages", withFields("error", err.Error())...)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make(dto.ScheduledMessages, 0, len(messages))
+	for _, m := range messages {
+		result = append(result, dto.ScheduledMessage{
+			ID:        m.ID.String(),
+			ChatID:    m.ChatID.String(),
+			Content:   m.Content,
+			SendAt:    m.SendAt.Format(time.RFC3339),
+			CreatedAt: m.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: m.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	uc.log.Info("Successfully listed scheduled messages", withFields("count", len(result))...)
+	return result, nil
+}
@@ -0,0 +1,124 @@
+package mentionsFeed
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type MessageMentionsFeedUseCase struct {
+	log   appPorts.Logger
+	store store.MentionStore
+}
+
+func NewMessageMentionsFeedUseCase(
+	log appPorts.Logger,
+	store store.MentionStore,
+) *MessageMentionsFeedUseCase {
+	return &MessageMentionsFeedUseCase{
+		log:   log,
+		store: store,
+	}
+}
+
+func (uc *MessageMentionsFeedUseCase) Execute(
+	ctx context.Context,
+	req dto.MentionsFeedRequest,
+) (
+	dto.MentionsFeedResponse,
+	error,
+) {
+	const op = "MessageMentionsFeedUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to get mentions", withFields()...)
+
+	filter, err := buildFilter(req)
+	if err != nil {
+		return dto.MentionsFeedResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// one extra row tells whether there is a next page
+	filter.Limit++
+	mentions, err := uc.store.Feed(ctx, filter)
+	if err != nil {
+		uc.log.Error("Failed to get mentions", withFields("error", err.Error())...)
+		return dto.MentionsFeedResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	filter.Limit--
+
+	var nextCursor string
+	if len(mentions) > filter.Limit {
+		mentions = mentions[:filter.Limit]
+		last := mentions[len(mentions)-1]
+		nextCursor = vo.Cursor{CreatedAt: last.Timestamp, ID: last.MessageID}.Encode()
+	}
+
+	resp := dto.MentionsFeedResponse{
+		Mentions:   make([]dto.Mention, 0, len(mentions)),
+		NextCursor: nextCursor,
+	}
+	for _, m := range mentions {
+		resp.Mentions = append(resp.Mentions, dto.Mention{
+			MessageID: m.MessageID,
+			ChatID:    m.ChatID.String(),
+			SenderID:  m.SenderID.String(),
+			Content:   m.Content,
+			IsAll:     m.IsAll,
+			Timestamp: m.Timestamp.Format(time.RFC3339Nano),
+			Read:      m.ReadAt != nil,
+		})
+	}
+	resp.Count = len(resp.Mentions)
+
+	uc.log.Info("Successfully got mentions", withFields("count", resp.Count)...)
+	return resp, nil
+}
+
+func buildFilter(req dto.MentionsFeedRequest) (vo.MentionFilter, error) {
+	var filter vo.MentionFilter
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return vo.MentionFilter{}, fmt.Errorf("invalid user id: %w", err)
+	}
+	filter.UserID = userID
+
+	if req.ChatID != "" {
+		chatID, parseErr := uuid.Parse(req.ChatID)
+		if parseErr != nil {
+			return vo.MentionFilter{}, fmt.Errorf("invalid chat id: %w", parseErr)
+		}
+		filter.ChatID = &chatID
+	}
+	filter.UnreadOnly = req.UnreadOnly
+
+	filter.Cursor, err = vo.ParseCursor(req.Cursor)
+	if err != nil {
+		return vo.MentionFilter{}, err
+	}
+
+	switch {
+	case req.Limit <= 0:
+		filter.Limit = defaultLimit
+	case req.Limit > maxLimit:
+		filter.Limit = maxLimit
+	default:
+		filter.Limit = req.Limit
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,107 @@
+package notifyMentions
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+)
+
+const batchSize = 200
+
+type MessageNotifyMentionsUseCase struct {
+	log       appPorts.Logger
+	txManager sharedPorts.TransactionManager
+	store     store.MentionNotifyStore
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewMessageNotifyMentionsUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	store store.MentionNotifyStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessageNotifyMentionsUseCase {
+	return &MessageNotifyMentionsUseCase{
+		log:       log,
+		txManager: txManager,
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// Execute notifies one batch of mentioned users and reports how many notices went out.
+// A personal mention gets through a muted chat flagged as muted, an @all mention is dropped for members who muted it.
+func (uc *MessageNotifyMentionsUseCase) Execute(ctx context.Context) (notified int, err error) {
+	const op = "MessageNotifyMentionsUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op}, args...)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil || notified == 0 {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	notices, err := uc.store.ClaimUnnotified(ctx, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(notices) == 0 {
+		return 0, nil
+	}
+
+	sent := make([]entity.MentionNotice, 0, len(notices))
+	for _, n := range notices {
+		if n.IsAll && n.Muted {
+			// marked as notified with the rest, unmuting later does not replay old @all mentions
+			sent = append(sent, n)
+			continue
+		}
+
+		event, eventErr := eventEntity.NewUserChatEvent(eventVo.Mentioned, n.ChatID, n.UserID, dto.Mention{
+			MessageID: n.MessageID,
+			ChatID:    n.ChatID.String(),
+			SenderID:  n.SenderID.String(),
+			Content:   n.Content,
+			IsAll:     n.IsAll,
+			Timestamp: n.Timestamp.Format(time.RFC3339Nano),
+			Muted:     n.Muted,
+		})
+		if eventErr == nil {
+			eventErr = uc.publisher.PublishChatEvent(ctx, event)
+		}
+		if eventErr != nil {
+			// the rest of the batch stays unnotified and is retried on the next run
+			uc.log.Error("Failed to publish mention", withFields("message_id", n.MessageID, "error", eventErr.Error())...)
+			break
+		}
+		sent = append(sent, n)
+	}
+	if len(sent) == 0 {
+		return 0, fmt.Errorf("%s: no mention could be published", op)
+	}
+
+	if err = uc.store.MarkNotified(ctx, sent); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	notified = len(sent)
+
+	uc.log.Info("Successfully notified mentioned users", withFields("count", notified)...)
+
+	return notified, nil
+}
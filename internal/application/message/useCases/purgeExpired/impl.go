@@ -0,0 +1,118 @@
+package purgeExpired
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"awesome-chat/internal/domain/core/shared/ports/s3"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const batchSize = 200
+
+type MessagePurgeExpiredUseCase struct {
+	log         appPorts.Logger
+	txManager   sharedPorts.TransactionManager
+	store       store.ExpiryStore
+	attachments s3.Storage
+	voices      s3.Storage
+	publisher   sharedPorts.ChatEventPublisher
+}
+
+func NewMessagePurgeExpiredUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	store store.ExpiryStore,
+	attachments s3.Storage,
+	voices s3.Storage,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessagePurgeExpiredUseCase {
+	return &MessagePurgeExpiredUseCase{
+		log:         log,
+		txManager:   txManager,
+		store:       store,
+		attachments: attachments,
+		voices:      voices,
+		publisher:   publisher,
+	}
+}
+
+// Execute purges one batch of expired messages and reports how many were removed.
+// Objects are removed before the rows: if the commit fails the next run repeats the batch,
+// deleting a missing object is a no-op, so nothing expired is left behind in storage.
+func (uc *MessagePurgeExpiredUseCase) Execute(ctx context.Context) (purged int, err error) {
+	const op = "MessagePurgeExpiredUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op}, args...)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil || purged == 0 {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	expired, err := uc.store.ClaimExpired(ctx, batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	uc.log.Info("Attempting to purge expired messages", withFields("count", len(expired))...)
+
+	ids := make([]int, 0, len(expired))
+	byChat := make(map[uuid.UUID][]int)
+	for _, m := range expired {
+		for _, key := range m.AttachmentKeys {
+			if err = uc.attachments.Delete(ctx, key); err != nil {
+				return 0, fmt.Errorf("%s: attachment %s: %w", op, key, err)
+			}
+		}
+		for _, key := range m.VoiceKeys {
+			if err = uc.voices.Delete(ctx, key); err != nil {
+				return 0, fmt.Errorf("%s: voice %s: %w", op, key, err)
+			}
+		}
+
+		ids = append(ids, m.ID)
+		byChat[m.ChatID] = append(byChat[m.ChatID], m.ID)
+	}
+
+	if err = uc.store.Delete(ctx, ids); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	purged = len(ids)
+
+	// reads already hide expired messages, the event only lets open clients drop them right away
+	for chatID, messageIDs := range byChat {
+		event, eventErr := eventEntity.NewChatEvent(eventVo.MessagesExpired, chatID, dto.MessagesExpiredEvent{
+			MessageIDs: messageIDs,
+		})
+		if eventErr == nil {
+			eventErr = uc.publisher.PublishChatEvent(ctx, event)
+		}
+		if eventErr != nil {
+			uc.log.Error("Failed to publish messages_expired event",
+				withFields("chat_id", chatID, "error", eventErr.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully purged expired messages", withFields("count", purged)...)
+
+	return purged, nil
+}
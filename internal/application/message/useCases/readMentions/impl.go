@@ -0,0 +1,70 @@
+package readMentions
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type MessageReadMentionsUseCase struct {
+	log      appPorts.Logger
+	store    store.MentionStore
+	previews chatPorts.PreviewCache
+}
+
+func NewMessageReadMentionsUseCase(
+	log appPorts.Logger,
+	store store.MentionStore,
+	previews chatPorts.PreviewCache,
+) *MessageReadMentionsUseCase {
+	return &MessageReadMentionsUseCase{
+		log:      log,
+		store:    store,
+		previews: previews,
+	}
+}
+
+// Execute resets the mention counter of the chat preview.
+func (uc *MessageReadMentionsUseCase) Execute(
+	ctx context.Context,
+	req dto.ReadMentionsRequest,
+) (
+	dto.ReadMentionsResponse,
+	error,
+) {
+	const op = "MessageReadMentionsUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID}, args...)
+	}
+
+	uc.log.Info("Attempting to mark mentions as read", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.ReadMentionsResponse{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.ReadMentionsResponse{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	read, err := uc.store.MarkRead(ctx, userID, chatID)
+	if err != nil {
+		uc.log.Error("Failed to mark mentions as read", withFields("error", err.Error())...)
+		return dto.ReadMentionsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if read > 0 {
+		if err = uc.previews.Invalidate(ctx, []uuid.UUID{userID}); err != nil {
+			uc.log.Warn("Failed to invalidate previews", withFields("error", err.Error())...)
+		}
+	}
+
+	uc.log.Info("Successfully marked mentions as read", withFields("count", read)...)
+	return dto.ReadMentionsResponse{Read: read}, nil
+}
@@ -1,32 +1,74 @@
 package save
 
 import (
+	chatDto "awesome-chat/internal/application/chat/dto"
 	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
 	"awesome-chat/internal/domain/core/message/ports"
 	"context"
+	"fmt"
+
+	"github.com/google/uuid"
 )
 
+type previewInvalidator interface {
+	Execute(ctx context.Context, req chatDto.InvalidatePreviewsRequest) error
+}
+
 type MessageSaveUseCase struct {
+	log           appPorts.Logger
 	entityCreator ports.EntityCreator
 	msgRepo       ports.Repository
+	sendGuard     chatPorts.SendGuard
+	previews      previewInvalidator
 }
 
 func NewMessageSaveUseCase(
+	log appPorts.Logger,
 	entityCreator ports.EntityCreator,
 	msgRepo ports.Repository,
+	sendGuard chatPorts.SendGuard,
+	previews previewInvalidator,
 ) *MessageSaveUseCase {
 	return &MessageSaveUseCase{
+		log:           log,
 		entityCreator: entityCreator,
 		msgRepo:       msgRepo,
+		sendGuard:     sendGuard,
+		previews:      previews,
 	}
 }
 
 func (uc *MessageSaveUseCase) Execute(ctx context.Context, msg dto.Message) error {
+	const op = "MessageSaveUseCase.Execute"
+
+	chatID, err := uuid.Parse(msg.ChatID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+	userID, err := uuid.Parse(msg.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	if err = uc.sendGuard.RequireSend(ctx, chatID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	entity := uc.entityCreator.Do(
 		msg.UserID,
 		msg.ChatID,
 		msg.Content,
 	)
 
-	return uc.msgRepo.Save(ctx, entity)
+	if err = uc.msgRepo.Save(ctx, entity); err != nil {
+		return err
+	}
+
+	// the save is not undone for the previews, a failed drop only delays the message showing in them
+	if err = uc.previews.Execute(ctx, chatDto.InvalidatePreviewsRequest{ChatIDs: []string{msg.ChatID}}); err != nil {
+		uc.log.Error("Failed to invalidate previews", "op", op, "chat_id", msg.ChatID, "error", err.Error())
+	}
+
+	return nil
 }
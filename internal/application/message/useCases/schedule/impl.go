@@ -0,0 +1,99 @@
+package schedule
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageScheduleUseCase struct {
+	log         appPorts.Logger
+	permissions chatPorts.PermissionChecker
+	store       store.ScheduledStore
+}
+
+func NewMessageScheduleUseCase(
+	log appPorts.Logger,
+	permissions chatPorts.PermissionChecker,
+	store store.ScheduledStore,
+) *MessageScheduleUseCase {
+	return &MessageScheduleUseCase{
+		log:         log,
+		permissions: permissions,
+		store:       store,
+	}
+}
+
+func (uc *MessageScheduleUseCase) Execute(
+	ctx context.Context,
+	req dto.ScheduleRequest,
+) (
+	dto.ScheduledMessage,
+	error,
+) {
+	const op = "MessageScheduleUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "chat_id", req.ChatID}, args...)
+	}
+
+	uc.log.Info("Attempting to schedule message", withFields()...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+	chatID, err := uuid.Parse(req.ChatID)
+	if err != nil {
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: invalid chat id: %w", op, err)
+	}
+
+	content, err := vo.NormalizeContent(req.Content)
+	if err != nil {
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now().UTC()
+	sendAt, err := vo.ParseSendAt(req.SendAt, now)
+	if err != nil {
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.permissions.RequireSend(ctx, chatID, userID); err != nil {
+		uc.log.Error("Failed to check chat membership", withFields("error", err.Error())...)
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	message := entity.ScheduledMessage{
+		ID:        uuid.New(),
+		UserID:    userID,
+		ChatID:    chatID,
+		Content:   content,
+		SendAt:    sendAt,
+		Status:    vo.ScheduledStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err = uc.store.Create(ctx, message); err != nil {
+		uc.log.Error("Failed to store scheduled message", withFields("error", err.Error())...)
+		return dto.ScheduledMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uc.log.Info("Successfully scheduled message", withFields("id", message.ID, "send_at", sendAt)...)
+
+	return dto.ScheduledMessage{
+		ID:        message.ID.String(),
+		ChatID:    message.ChatID.String(),
+		Content:   message.Content,
+		SendAt:    message.SendAt.Format(time.RFC3339),
+		CreatedAt: message.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: message.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
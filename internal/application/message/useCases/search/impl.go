@@ -0,0 +1,157 @@
+package search
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+type MessageSearchUseCase struct {
+	log   appPorts.Logger
+	store store.Search
+}
+
+func NewMessageSearchUseCase(
+	log appPorts.Logger,
+	store store.Search,
+) *MessageSearchUseCase {
+	return &MessageSearchUseCase{
+		log:   log,
+		store: store,
+	}
+}
+
+func (uc *MessageSearchUseCase) Execute(
+	ctx context.Context,
+	req dto.SearchRequest,
+) (
+	dto.SearchResponse,
+	error,
+) {
+	const op = "MessageSearchUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID}, args...)
+	}
+
+	uc.log.Info("Attempting to search messages", withFields()...)
+
+	filter, err := buildFilter(req)
+	if err != nil {
+		uc.log.Error("Invalid search request", withFields("error", err.Error())...)
+		return dto.SearchResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// one extra row tells whether there is a next page
+	filter.Limit++
+	hits, err := uc.store.Execute(ctx, filter)
+	if err != nil {
+		uc.log.Error("Failed to search messages", withFields("error", err.Error())...)
+		return dto.SearchResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	filter.Limit--
+
+	var nextCursor string
+	if len(hits) > filter.Limit {
+		hits = hits[:filter.Limit]
+		last := hits[len(hits)-1]
+		nextCursor = vo.Cursor{CreatedAt: last.Timestamp, ID: last.ID}.Encode()
+	}
+
+	resp := dto.SearchResponse{
+		Hits:       make([]dto.SearchHit, 0, len(hits)),
+		NextCursor: nextCursor,
+	}
+	for _, hit := range hits {
+		resp.Hits = append(resp.Hits, dto.SearchHit{
+			ID:        hit.ID,
+			ChatID:    hit.ChatID.String(),
+			SenderID:  hit.SenderID.String(),
+			Text:      hit.Text,
+			Snippet:   hit.Snippet,
+			Timestamp: hit.Timestamp.Format(time.RFC3339Nano),
+		})
+	}
+	resp.Count = len(resp.Hits)
+
+	uc.log.Info("Successfully searched messages", withFields("count", resp.Count)...)
+	return resp, nil
+}
+
+func buildFilter(req dto.SearchRequest) (vo.SearchFilter, error) {
+	var filter vo.SearchFilter
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return vo.SearchFilter{}, fmt.Errorf("invalid user id: %w", err)
+	}
+	filter.UserID = userID
+
+	filter.Query = strings.TrimSpace(req.Query)
+	if filter.Query == "" {
+		return vo.SearchFilter{}, msgErrors.ErrEmptySearchQuery
+	}
+
+	if req.ChatID != "" {
+		chatID, parseErr := uuid.Parse(req.ChatID)
+		if parseErr != nil {
+			return vo.SearchFilter{}, fmt.Errorf("invalid chat id: %w", parseErr)
+		}
+		filter.ChatID = &chatID
+	}
+	if req.SenderID != "" {
+		senderID, parseErr := uuid.Parse(req.SenderID)
+		if parseErr != nil {
+			return vo.SearchFilter{}, fmt.Errorf("invalid sender id: %w", parseErr)
+		}
+		filter.SenderID = &senderID
+	}
+
+	if req.From != "" {
+		from, parseErr := time.Parse(time.RFC3339, req.From)
+		if parseErr != nil {
+			return vo.SearchFilter{}, fmt.Errorf("%w: from: %w", msgErrors.ErrInvalidDateRange, parseErr)
+		}
+		from = from.UTC()
+		filter.From = &from
+	}
+	if req.To != "" {
+		to, parseErr := time.Parse(time.RFC3339, req.To)
+		if parseErr != nil {
+			return vo.SearchFilter{}, fmt.Errorf("%w: to: %w", msgErrors.ErrInvalidDateRange, parseErr)
+		}
+		to = to.UTC()
+		filter.To = &to
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return vo.SearchFilter{}, msgErrors.ErrInvalidDateRange
+	}
+
+	filter.Cursor, err = vo.ParseCursor(req.Cursor)
+	if err != nil {
+		return vo.SearchFilter{}, err
+	}
+
+	switch {
+	case req.Limit <= 0:
+		filter.Limit = defaultLimit
+	case req.Limit > maxLimit:
+		filter.Limit = maxLimit
+	default:
+		filter.Limit = req.Limit
+	}
+
+	return filter, nil
+}
@@ -2,12 +2,15 @@ package send
 
 import (
 	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
 	"awesome-chat/internal/domain/core/message/ports"
 	outboxPorts "awesome-chat/internal/domain/core/shared/outbox/ports"
 	"awesome-chat/internal/domain/core/shared/outbox/vo"
 	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 
 	"github.com/google/uuid"
@@ -25,11 +28,14 @@ type work struct {
 }
 
 type UseCase struct {
+	log           appPorts.Logger
 	entityCreator ports.EntityCreator
 	outboxCreator outboxPorts.EntityCreator
 	txManager     sharedPorts.TransactionManager
 	msgRepo       ports.Repository
 	outboxRepo    outboxPorts.Repository
+	sendGuard     chatPorts.SendGuard
+	previews      previewInvalidator
 	wg            sync.WaitGroup
 	jobs          chan work
 }
@@ -85,18 +91,24 @@ func (uc *UseCase) worker(id int) { // TODO: log with worker id
 }
 
 func NewUseCase(
+	log appPorts.Logger,
 	entityCreator ports.EntityCreator,
 	outboxCreator outboxPorts.EntityCreator,
 	txManager sharedPorts.TransactionManager,
 	msgRepo ports.Repository,
 	outboxRepo outboxPorts.Repository,
+	sendGuard chatPorts.SendGuard,
+	previews previewInvalidator,
 ) *UseCase {
 	uc := &UseCase{
+		log:           log,
 		entityCreator: entityCreator,
 		outboxCreator: outboxCreator,
 		txManager:     txManager,
 		msgRepo:       msgRepo,
 		outboxRepo:    outboxRepo,
+		sendGuard:     sendGuard,
+		previews:      previews,
 		wg:            sync.WaitGroup{},
 		jobs:          make(chan work, jobsBuff),
 	}
@@ -110,6 +122,13 @@ func NewUseCase(
 }
 
 func (uc *UseCase) Execute(ctx context.Context, req dto.SendRequest) error {
+	const op = "MessageSendUseCase.Execute"
+
+	// checked before queueing, a rejected message never takes a worker
+	if err := requireSend(ctx, uc.sendGuard, req.ChatID, req.UserID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	resChan := make(chan error, 1)
 	w := work{
 		ctx:     ctx,
@@ -124,7 +143,11 @@ func (uc *UseCase) Execute(ctx context.Context, req dto.SendRequest) error {
 
 	select {
 	case err := <-resChan:
-		return err
+		if err != nil {
+			return err
+		}
+		dropPreviews(ctx, uc.log, uc.previews, op, req.ChatID)
+		return nil
 	case <-ctx.Done():
 		return ctx.Err()
 	}
@@ -0,0 +1,22 @@
+package send
+
+import (
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+func requireSend(ctx context.Context, guard chatPorts.SendGuard, rawChatID, rawUserID string) error {
+	chatID, err := uuid.Parse(rawChatID)
+	if err != nil {
+		return fmt.Errorf("invalid chat id: %w", err)
+	}
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	return guard.RequireSend(ctx, chatID, userID)
+}
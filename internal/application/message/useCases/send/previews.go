@@ -0,0 +1,18 @@
+package send
+
+import (
+	chatDto "awesome-chat/internal/application/chat/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+)
+
+type previewInvalidator interface {
+	Execute(ctx context.Context, req chatDto.InvalidatePreviewsRequest) error
+}
+
+// dropPreviews runs once the message is committed, a failed drop only leaves the lists stale until they expire.
+func dropPreviews(ctx context.Context, log appPorts.Logger, previews previewInvalidator, op, chatID string) {
+	if err := previews.Execute(ctx, chatDto.InvalidatePreviewsRequest{ChatIDs: []string{chatID}}); err != nil {
+		log.Error("Failed to invalidate previews", "op", op, "chat_id", chatID, "error", err.Error())
+	}
+}
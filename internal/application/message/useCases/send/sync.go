@@ -2,31 +2,43 @@ package send
 
 import (
 	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
 	"awesome-chat/internal/domain/core/message/ports"
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 )
 
 type MessageSendSyncUseCase struct {
+	log            appPorts.Logger
 	repo           ports.Repository
 	entityCreator  ports.EntityCreator
 	wsServerClient *http.Client
 	wsServerURL    string
+	sendGuard      chatPorts.SendGuard
+	previews       previewInvalidator
 }
 
 func NewMessageSendSyncUseCase(
+	log appPorts.Logger,
 	repo ports.Repository,
 	entityCreator ports.EntityCreator,
 	wsServerURL string,
+	sendGuard chatPorts.SendGuard,
+	previews previewInvalidator,
 ) *MessageSendSyncUseCase {
 	return &MessageSendSyncUseCase{
+		log:            log,
 		repo:           repo,
 		entityCreator:  entityCreator,
 		wsServerClient: http.DefaultClient,
 		wsServerURL:    wsServerURL,
+		sendGuard:      sendGuard,
+		previews:       previews,
 	}
 }
 
@@ -35,6 +47,12 @@ func (uc *MessageSendSyncUseCase) Execute(
 	req dto.SendSyncRequest,
 	raw []byte,
 ) error {
+	const op = "MessageSendSyncUseCase.Execute"
+
+	if err := requireSend(ctx, uc.sendGuard, req.ChatID, req.UserID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	entity := uc.entityCreator.Do(
 		req.UserID,
 		req.ChatID,
@@ -44,6 +62,7 @@ func (uc *MessageSendSyncUseCase) Execute(
 	if err := uc.repo.Save(ctx, entity); err != nil {
 		return err
 	}
+	dropPreviews(ctx, uc.log, uc.previews, op, req.ChatID)
 
 	reqCtx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
@@ -3,6 +3,7 @@ package sendVoice
 import (
 	"awesome-chat/internal/application/message/dto"
 	appPorts "awesome-chat/internal/domain/app/ports"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
 	"awesome-chat/internal/domain/core/message/ports/store"
 	"awesome-chat/internal/domain/core/message/vo"
 	"awesome-chat/internal/domain/core/shared/ports"
@@ -18,6 +19,7 @@ type MessageSendVoiceUseCase struct {
 	store     store.SaveVoice
 	s3Storage s3.Storage
 	s3UrlSvc  s3.URLService
+	sendGuard chatPorts.SendGuard
 }
 
 func NewMessageSendVoiceUseCase(
@@ -26,6 +28,7 @@ func NewMessageSendVoiceUseCase(
 	store store.SaveVoice,
 	s3Storage s3.Storage,
 	s3UrlSvc s3.URLService,
+	sendGuard chatPorts.SendGuard,
 ) *MessageSendVoiceUseCase {
 	return &MessageSendVoiceUseCase{
 		log:       log,
@@ -33,6 +36,7 @@ func NewMessageSendVoiceUseCase(
 		store:     store,
 		s3Storage: s3Storage,
 		s3UrlSvc:  s3UrlSvc,
+		sendGuard: sendGuard,
 	}
 }
 
@@ -58,6 +62,10 @@ func (uc *MessageSendVoiceUseCase) Execute(
 	if err != nil {
 		return dto.SendVoiceResponse{}, fmt.Errorf("%s, %w", op, err)
 	}
+	if err = uc.sendGuard.RequireSend(ctx, chatID, userID); err != nil {
+		uc.log.Warn("Voice message rejected", withFields("error", err.Error())...)
+		return dto.SendVoiceResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
 	voiceID := uuid.New()
 
 	if err = uc.s3Storage.Add(ctx, voiceID.String(), []byte(req.Blob)); err != nil {
@@ -87,11 +95,12 @@ func (uc *MessageSendVoiceUseCase) Execute(
 	}()
 
 	if err = uc.store.Execute(ctx, vo.SaveVoiceData{
-		UserID:   userID,
-		ChatID:   chatID,
-		AudioURL: audioURL,
-		Duration: req.Duration,
-		Waveform: nil, // TODO generate
+		UserID:    userID,
+		ChatID:    chatID,
+		AudioURL:  audioURL,
+		ObjectKey: voiceID.String(),
+		Duration:  req.Duration,
+		Waveform:  nil, // TODO generate
 	}); err != nil {
 		return dto.SendVoiceResponse{}, fmt.Errorf("%s: %w", op, err)
 	}
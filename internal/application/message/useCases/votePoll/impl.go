@@ -0,0 +1,124 @@
+package votePoll
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	appPorts "awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	eventEntity "awesome-chat/internal/domain/core/shared/events/entity"
+	eventVo "awesome-chat/internal/domain/core/shared/events/vo"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageVotePollUseCase struct {
+	log       appPorts.Logger
+	txManager sharedPorts.TransactionManager
+	validator chatPorts.ValidateStore
+	store     store.PollStore
+	publisher sharedPorts.ChatEventPublisher
+}
+
+func NewMessageVotePollUseCase(
+	log appPorts.Logger,
+	txManager sharedPorts.TransactionManager,
+	validator chatPorts.ValidateStore,
+	store store.PollStore,
+	publisher sharedPorts.ChatEventPublisher,
+) *MessageVotePollUseCase {
+	return &MessageVotePollUseCase{
+		log:       log,
+		txManager: txManager,
+		validator: validator,
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// Execute replaces the vote of the user and broadcasts the new tally to the chat.
+// The poll row stays locked until the tally is read, so concurrent votes never publish a stale tally last.
+func (uc *MessageVotePollUseCase) Execute(ctx context.Context, req dto.VotePollRequest) (poll dto.Poll, err error) {
+	const op = "MessageVotePollUseCase.Execute"
+	withFields := func(args ...any) []any {
+		return append([]any{"op", op, "user_id", req.UserID, "message_id", req.MessageID}, args...)
+	}
+
+	uc.log.Info("Attempting to vote in poll", withFields("choices", len(req.Choices))...)
+
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: invalid user id: %w", op, err)
+	}
+
+	ctx, err = uc.txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = uc.txManager.RollbackTx(ctx)
+		}
+	}()
+
+	locked, err := uc.store.LockForVote(ctx, req.MessageID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	isMember, err := uc.validator.IsMember(ctx, locked.ChatID, userID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !isMember {
+		uc.log.Warn("Vote rejected, user is not a chat member", withFields("chat_id", locked.ChatID)...)
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	now := time.Now().UTC()
+	if locked.IsClosed(now) {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, msgErrors.ErrPollClosed)
+	}
+
+	choices, err := vo.NormalizeChoices(req.Choices, len(locked.Options), locked.MultipleChoice)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.store.ReplaceVote(ctx, req.MessageID, userID, choices); err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	tally, err := uc.store.Get(ctx, req.MessageID, userID)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	poll = dto.NewPoll(tally, now)
+
+	event, err := eventEntity.NewChatEvent(eventVo.PollUpdated, tally.ChatID, poll)
+	if err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = uc.txManager.CommitTx(ctx); err != nil {
+		return dto.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if eventErr := uc.publisher.PublishChatEvent(ctx, event); eventErr != nil {
+		// the vote is stored, the next vote or fetch brings the tally up to date
+		uc.log.Error("Failed to publish poll_updated event", withFields("error", eventErr.Error())...)
+	}
+
+	poll.MyVote = tally.MyVote
+
+	uc.log.Info("Successfully voted in poll", withFields("choices", choices)...)
+
+	return poll, nil
+}
@@ -1,38 +1,97 @@
 package api
 
 import (
+	"awesome-chat/internal/application/attachment/useCases/completeUpload"
+	"awesome-chat/internal/application/attachment/useCases/getDownloadURL"
+	"awesome-chat/internal/application/attachment/useCases/requestUpload"
 	chatAddMember "awesome-chat/internal/application/chat/useCases/addMember"
 	chatCreate "awesome-chat/internal/application/chat/useCases/create"
-	"awesome-chat/internal/application/chat/useCases/getAllMessages"
+	"awesome-chat/internal/application/chat/useCases/createInvite"
+	"awesome-chat/internal/application/chat/useCases/decideJoinRequest"
+	"awesome-chat/internal/application/chat/useCases/getPins"
 	"awesome-chat/internal/application/chat/useCases/getUserChatPreview"
-	messageGet "awesome-chat/internal/application/message/useCases/get"
-	"awesome-chat/internal/application/message/useCases/getForChatWithFilter"
+	"awesome-chat/internal/application/chat/useCases/invalidatePreviews"
+	"awesome-chat/internal/application/chat/useCases/joinByInvite"
+	"awesome-chat/internal/application/chat/useCases/leaveChat"
+	"awesome-chat/internal/application/chat/useCases/listInvites"
+	"awesome-chat/internal/application/chat/useCases/listJoinRequests"
+	"awesome-chat/internal/application/chat/useCases/openDirect"
+	"awesome-chat/internal/application/chat/useCases/pinMessage"
+	"awesome-chat/internal/application/chat/useCases/removeChatAvatar"
+	"awesome-chat/internal/application/chat/useCases/removeMember"
+	"awesome-chat/internal/application/chat/useCases/revokeInvite"
+	"awesome-chat/internal/application/chat/useCases/setChatAvatar"
+	"awesome-chat/internal/application/chat/useCases/setMemberRole"
+	"awesome-chat/internal/application/chat/useCases/setMentionPolicy"
+	"awesome-chat/internal/application/chat/useCases/setMessageTTL"
+	"awesome-chat/internal/application/chat/useCases/setPreferences"
+	"awesome-chat/internal/application/chat/useCases/unpinMessage"
+	"awesome-chat/internal/application/chat/useCases/updateChat"
+	draftGet "awesome-chat/internal/application/draft/useCases/get"
+	exportGet "awesome-chat/internal/application/export/useCases/get"
+	exportRequest "awesome-chat/internal/application/export/useCases/request"
+	"awesome-chat/internal/application/message/useCases/cancelScheduled"
+	"awesome-chat/internal/application/message/useCases/createPoll"
+	"awesome-chat/internal/application/message/useCases/deleteMessage"
+	"awesome-chat/internal/application/message/useCases/editScheduled"
+	messageForward "awesome-chat/internal/application/message/useCases/forward"
+	"awesome-chat/internal/application/message/useCases/getPage"
+	"awesome-chat/internal/application/message/useCases/getPoll"
+	"awesome-chat/internal/application/message/useCases/getReceipts"
+	"awesome-chat/internal/application/message/useCases/listScheduled"
+	"awesome-chat/internal/application/message/useCases/mentionsFeed"
+	"awesome-chat/internal/application/message/useCases/readMentions"
 	messageSave "awesome-chat/internal/application/message/useCases/save"
+	"awesome-chat/internal/application/message/useCases/schedule"
+	messageSearch "awesome-chat/internal/application/message/useCases/search"
 	messageSend "awesome-chat/internal/application/message/useCases/send"
+	"awesome-chat/internal/application/message/useCases/votePoll"
 	"awesome-chat/internal/application/user/useCases/authJWT"
 	"awesome-chat/internal/application/user/useCases/getAllUsers"
 	"awesome-chat/internal/application/user/useCases/getUserChatIDs"
 	"awesome-chat/internal/application/user/useCases/login"
 	"awesome-chat/internal/application/user/useCases/register"
 	"awesome-chat/internal/domain/app/ports"
+	chatPermission "awesome-chat/internal/domain/core/chat/services/permission"
 	msgEntity "awesome-chat/internal/domain/core/message/services/entity"
 	outboxEntity "awesome-chat/internal/domain/core/shared/outbox/services/entity"
 	"awesome-chat/internal/infrastructure/config/apps/api"
+	"awesome-chat/internal/infrastructure/imaging"
 	"awesome-chat/internal/infrastructure/jwt/user"
+	"awesome-chat/internal/infrastructure/minio"
+	"awesome-chat/internal/infrastructure/minio/services/bucket"
+	minioURL "awesome-chat/internal/infrastructure/minio/services/url"
+	attachmentStorage "awesome-chat/internal/infrastructure/minio/storage/attachment"
 	"awesome-chat/internal/infrastructure/postgres"
 	"awesome-chat/internal/infrastructure/postgres/executor"
 	repos "awesome-chat/internal/infrastructure/postgres/repositories"
+	attachmentStore "awesome-chat/internal/infrastructure/postgres/store/attachment"
 	chatStore "awesome-chat/internal/infrastructure/postgres/store/chat"
+	draftStore "awesome-chat/internal/infrastructure/postgres/store/draft"
+	exportStore "awesome-chat/internal/infrastructure/postgres/store/export"
 	messageStore "awesome-chat/internal/infrastructure/postgres/store/message"
-	"awesome-chat/internal/infrastructure/postgres/store/message/getFunc"
 	userStore "awesome-chat/internal/infrastructure/postgres/store/user"
+	"awesome-chat/internal/infrastructure/redis"
+	channelCache "awesome-chat/internal/infrastructure/redis/channel"
+	draftCache "awesome-chat/internal/infrastructure/redis/draft"
+	previewCache "awesome-chat/internal/infrastructure/redis/preview"
+	"awesome-chat/internal/infrastructure/redis/pubsub"
+	redisStorage "awesome-chat/internal/infrastructure/redis/storage"
+	"awesome-chat/internal/infrastructure/redis/stream"
+	streamNames "awesome-chat/internal/infrastructure/redis/stream/names"
+	"awesome-chat/internal/infrastructure/snowflake"
 	fiberHttp "awesome-chat/internal/presentation/httpFiber"
+	attachmentHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/attachment"
 	chatHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/chat"
+	draftHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/draft"
+	exportHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/export"
 	"awesome-chat/internal/presentation/httpFiber/delivery/handlers/health"
+	inviteHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/invite"
+	mentionHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/mention"
 	messageHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/message"
+	scheduledMessageHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/scheduledMessage"
 	userHandler "awesome-chat/internal/presentation/httpFiber/delivery/handlers/user"
 	"context"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/sync/errgroup"
 	"log/slog"
 	"time"
@@ -56,6 +115,15 @@ func NewApp(ctx context.Context) *App {
 	pool := postgres.NewPool(ctx, &cfg.Storage)
 	txManager := executor.NewTransactionManager(pool)
 
+	redisConn := redis.NewConnection(&cfg.Cache)
+	idNodeLease := snowflake.MustLeaseNode(ctx, redisConn, cfg.IDs)
+	messageIDGenerator := snowflake.MustNewGenerator(idNodeLease.Config())
+	minioConn := minio.NewConnection(cfg.S3)
+	minioBucketSvc := bucket.NewService(log, minioConn)
+
+	chatEventPub := pubsub.NewPublisher(&cfg.EventPublisher)
+	chatEventPublisher := pubsub.NewChatEventPublisher(chatEventPub)
+
 	healthHandler := new(health.Handler)
 
 	userTokenCreator := user.NewTokenCreator(cfg.JWT.SecretKey)
@@ -83,91 +151,450 @@ func NewApp(ctx context.Context) *App {
 
 	chatCreateWithMembersStore := chatStore.NewCreateWithMembersStore(txManager)
 	chatValidatorStore := chatStore.NewValidatorStore(txManager)
-	chatPreviewStore := chatStore.NewGetUserChatPreviewStore(txManager)
-	chatGetAllMessagesStore := chatStore.NewGetAllMessagesStore(txManager)
+	chatPreviewStore := previewCache.NewCache(chatStore.NewGetUserChatPreviewStore(txManager), redisConn)
+	chatPreviewInvalidator := previewCache.NewInvalidator(redisConn)
+	chatInvalidatePreviewsUC := invalidatePreviews.NewChatInvalidatePreviewsUseCase(
+		log,
+		chatStore.NewPreviewAudienceStore(txManager),
+		chatPreviewInvalidator,
+	)
+	chatRoleStore := chatStore.NewRoleStore(txManager)
+	chatDirectStore := chatStore.NewDirectStore(txManager)
+	chatMembershipStore := chatStore.NewMembershipStore(txManager)
+	chatSystemMessageStore := chatStore.NewSystemMessageStore(txManager, messageIDGenerator)
+	chatMetadataStore := chatStore.NewMetadataStore(txManager)
+	chatPermissions := chatPermission.NewChecker(chatRoleStore)
+	chatTypeCache := channelCache.NewChatTypeCache(chatDirectStore, redisStorage.NewStorage(redisConn, redisStorage.ChatType))
+	chatPinStore := channelCache.NewPinCache(
+		chatStore.NewPinStore(txManager),
+		chatTypeCache,
+		redisStorage.NewStorage(redisConn, redisStorage.ChannelPins),
+	)
+	chatTTLStore := chatStore.NewTTLStore(txManager)
 
 	chatCreateUC := chatCreate.NewChatCreateUseCase(
 		log,
 		txManager,
 		chatCreateWithMembersStore,
+		chatSystemMessageStore,
 		userValidatorStore,
+		chatPreviewInvalidator,
 	)
 	chatAddMemberUC := chatAddMember.NewChatAddMemberUseCase(
+		log,
+		txManager,
 		chatCreateWithMembersStore,
 		chatValidatorStore,
 		userValidatorStore,
+		chatPermissions,
+		chatTypeCache,
+		chatSystemMessageStore,
+		userGetStore,
+		chatEventPublisher,
 	)
+	avatarObjStorage := attachmentStorage.NewStorage(
+		minioConn,
+		bucket.Avatars.String(),
+		minioBucketSvc,
+	)
+	avatarURLSvc := minioURL.NewUrlService(
+		minioConn.Client,
+		bucket.Avatars.String(),
+		redisStorage.NewStorage(redisConn, redisStorage.Avatar),
+	)
+
+	draftRedisCache := draftCache.NewCache(redisConn)
 	chatPreviewUC := getUserChatPreview.NewChatGetUserChatPreviewUseCase(
 		log,
 		chatPreviewStore,
+		avatarURLSvc,
+		draftRedisCache,
+	)
+
+	chatPinMessageUC := pinMessage.NewChatPinMessageUseCase(
+		log,
+		txManager,
+		chatPermissions,
+		chatPinStore,
+		chatSystemMessageStore,
+		userGetStore,
+		chatEventPublisher,
+		chatPinStore,
+	)
+	chatUnpinMessageUC := unpinMessage.NewChatUnpinMessageUseCase(
+		log,
+		txManager,
+		chatPermissions,
+		chatPinStore,
+		chatSystemMessageStore,
+		userGetStore,
+		chatEventPublisher,
+		chatPinStore,
+	)
+	chatGetPinsUC := getPins.NewChatGetPinsUseCase(
+		log,
+		chatValidatorStore,
+		chatPinStore,
+	)
+
+	chatSetMessageTTLUC := setMessageTTL.NewChatSetMessageTTLUseCase(
+		log,
+		chatPermissions,
+		chatTTLStore,
+		chatEventPublisher,
+	)
+
+	chatSetMentionPolicyUC := setMentionPolicy.NewChatSetMentionPolicyUseCase(
+		log,
+		chatPermissions,
+		chatStore.NewMentionStore(txManager),
 	)
-	chatGetAllMessagesUC := getAllMessages.NewChatGetAllMessagesUseCase(
+
+	chatSetMemberRoleUC := setMemberRole.NewChatSetMemberRoleUseCase(
+		log,
+		chatPermissions,
+		chatRoleStore,
+		chatTypeCache,
+		chatEventPublisher,
+	)
+
+	chatOpenDirectUC := openDirect.NewChatOpenDirectUseCase(
+		log,
+		txManager,
+		chatDirectStore,
+		chatCreateWithMembersStore,
+		userValidatorStore,
+		chatPreviewInvalidator,
+	)
+
+	chatLeaveUC := leaveChat.NewChatLeaveUseCase(
+		log,
+		txManager,
+		chatMembershipStore,
+		chatTypeCache,
+		chatSystemMessageStore,
+		userGetStore,
+		chatEventPublisher,
+	)
+	chatRemoveMemberUC := removeMember.NewChatRemoveMemberUseCase(
 		log,
-		chatGetAllMessagesStore,
+		txManager,
+		chatPermissions,
+		chatMembershipStore,
+		chatSystemMessageStore,
+		userGetStore,
+		chatEventPublisher,
 	)
 
 	chatHandlers := chatHandler.NewChatHandler(
 		chatCreateUC,
 		chatAddMemberUC,
 		chatPreviewUC,
-		chatGetAllMessagesUC,
+		chatPinMessageUC,
+		chatUnpinMessageUC,
+		chatGetPinsUC,
+		chatSetMessageTTLUC,
+		chatSetMentionPolicyUC,
+		chatSetMemberRoleUC,
+		chatOpenDirectUC,
+		chatLeaveUC,
+		chatRemoveMemberUC,
+		updateChat.NewChatUpdateUseCase(
+			log,
+			txManager,
+			chatPermissions,
+			chatMetadataStore,
+			chatSystemMessageStore,
+			userGetStore,
+			avatarURLSvc,
+			chatEventPublisher,
+		),
+		setChatAvatar.NewChatSetAvatarUseCase(
+			log,
+			txManager,
+			chatPermissions,
+			chatMetadataStore,
+			chatSystemMessageStore,
+			userGetStore,
+			imaging.NewPreviewGenerator(),
+			avatarObjStorage,
+			avatarObjStorage,
+			avatarURLSvc,
+			chatEventPublisher,
+		),
+		removeChatAvatar.NewChatRemoveAvatarUseCase(
+			log,
+			txManager,
+			chatPermissions,
+			chatMetadataStore,
+			chatSystemMessageStore,
+			userGetStore,
+			avatarObjStorage,
+			chatEventPublisher,
+		),
+		setPreferences.NewChatSetPreferencesUseCase(log, chatStore.NewPreferencesStore(txManager), chatEventPublisher),
+	)
+
+	chatInviteStore := chatStore.NewInviteStore(txManager)
+	chatJoinRequestStore := chatStore.NewJoinRequestStore(txManager)
+
+	inviteHandlers := inviteHandler.NewInviteHandler(
+		createInvite.NewChatCreateInviteUseCase(log, chatPermissions, chatTypeCache, chatInviteStore),
+		listInvites.NewChatListInvitesUseCase(log, chatPermissions, chatInviteStore),
+		revokeInvite.NewChatRevokeInviteUseCase(log, chatPermissions, chatInviteStore),
+		joinByInvite.NewChatJoinByInviteUseCase(
+			log,
+			txManager,
+			chatInviteStore,
+			chatJoinRequestStore,
+			chatCreateWithMembersStore,
+			chatValidatorStore,
+			chatTypeCache,
+			chatSystemMessageStore,
+			userGetStore,
+			chatEventPublisher,
+		),
+		listJoinRequests.NewChatListJoinRequestsUseCase(log, chatPermissions, chatJoinRequestStore),
+		decideJoinRequest.NewChatDecideJoinRequestUseCase(
+			log,
+			txManager,
+			chatPermissions,
+			chatJoinRequestStore,
+			chatCreateWithMembersStore,
+			chatTypeCache,
+			chatSystemMessageStore,
+			userGetStore,
+			chatEventPublisher,
+		),
 	)
 
 	outboxRepo := repos.NewOutboxRepo(txManager)
-	messageRepo := repos.NewMessageRepo(txManager)
-	messageGetStore := messageStore.NewGetStore(txManager)
-	messageGetForChatWithFilterStore := messageStore.NewGetForChatWithFilter(txManager)
+	messageRepo := repos.NewMessageRepo(txManager, messageIDGenerator)
+	messagePageStore := channelCache.NewPageCache(
+		messageStore.NewPageStore(txManager),
+		chatTypeCache,
+		redisStorage.NewStorage(redisConn, redisStorage.ChannelPage),
+	)
+	messageSearchStore := messageStore.NewSearchStore(txManager)
 
 	messageEntityCreator := new(msgEntity.Create)
 	outboxEntityCreator := new(outboxEntity.Create)
 
-	messageGetUC := messageGet.NewMessageGetUseCase(messageGetStore)
 	messageSendUC := messageSend.NewUseCase( // TODO: rebuild
+		log,
 		messageEntityCreator,
 		outboxEntityCreator,
 		txManager,
 		messageRepo,
 		outboxRepo,
+		chatPermissions,
+		chatInvalidatePreviewsUC,
 	)
 	messageSaveUC := messageSave.NewMessageSaveUseCase(
+		log,
 		messageEntityCreator,
 		messageRepo,
+		chatPermissions,
+		chatInvalidatePreviewsUC,
 	)
 	messageSendSyncUC := messageSend.NewMessageSendSyncUseCase(
+		log,
 		messageRepo,
 		messageEntityCreator,
 		cfg.WSServerAPI.BroadcastURL,
+		chatPermissions,
+		chatInvalidatePreviewsUC,
 	)
-	messageGetForChatWithFilter := getForChatWithFilter.NewMessageGetForChatWithFilterUseCase(
+	messagePollStore := messageStore.NewPollStore(txManager, messageIDGenerator)
+	messageGetPageUC := getPage.NewMessageGetPageUseCase(
 		log,
-		messageGetForChatWithFilterStore,
+		messagePageStore,
+		messagePollStore,
 	)
-	messageGetFunc := getFunc.NewGetMessagesFunc(
-		func() *pgxpool.Pool {
-			return pool.Pool
-		},
+	messageSearchUC := messageSearch.NewMessageSearchUseCase(
+		log,
+		messageSearchStore,
+	)
+	messageForwardUC := messageForward.NewMessageForwardUseCase(
+		log,
+		chatValidatorStore,
+		chatPermissions,
+		messageStore.NewForwardStore(txManager),
+		stream.NewPublisherImpl(redisConn, streamNames.SentMessage.String()),
+		messageIDGenerator,
+		chatEventPublisher,
+	)
+	messageCreatePollUC := createPoll.NewMessageCreatePollUseCase(
+		log,
+		txManager,
+		chatPermissions,
+		messagePollStore,
+		chatEventPublisher,
+	)
+	messageVotePollUC := votePoll.NewMessageVotePollUseCase(
+		log,
+		txManager,
+		chatValidatorStore,
+		messagePollStore,
+		chatEventPublisher,
+	)
+	messageGetPollUC := getPoll.NewMessageGetPollUseCase(
+		log,
+		chatValidatorStore,
+		messagePollStore,
+	)
+	messageGetReceiptsUC := getReceipts.NewMessageGetReceiptsUseCase(
+		log,
+		messageStore.NewReceiptStore(txManager),
+	)
+	messageDeleteUC := deleteMessage.NewMessageDeleteUseCase(
+		log,
+		txManager,
+		chatPermissions,
+		messageStore.NewDeleteStore(txManager),
+		chatEventPublisher,
+		chatPinStore,
 	)
-
 	messageHandlers := messageHandler.NewMessageHandler(
-		messageGetUC,
 		messageSaveUC,
 		messageSendUC,
 		messageSendSyncUC,
-		messageGetForChatWithFilter,
-		messageGetFunc,
+		messageGetPageUC,
+		nil, // voice messages are not routed by the api yet
+		messageSearchUC,
+		messageForwardUC,
+		messageCreatePollUC,
+		messageVotePollUC,
+		messageGetPollUC,
+		messageGetReceiptsUC,
+		messageDeleteUC,
+	)
+
+	messageScheduledStore := messageStore.NewScheduledStore(txManager)
+
+	messageScheduleUC := schedule.NewMessageScheduleUseCase(
+		log,
+		chatPermissions,
+		messageScheduledStore,
+	)
+	messageListScheduledUC := listScheduled.NewMessageListScheduledUseCase(
+		log,
+		messageScheduledStore,
+	)
+	messageEditScheduledUC := editScheduled.NewMessageEditScheduledUseCase(
+		log,
+		messageScheduledStore,
+	)
+	messageCancelScheduledUC := cancelScheduled.NewMessageCancelScheduledUseCase(
+		log,
+		messageScheduledStore,
+	)
+
+	scheduledMessageHandlers := scheduledMessageHandler.NewScheduledMessageHandler(
+		messageScheduleUC,
+		messageListScheduledUC,
+		messageEditScheduledUC,
+		messageCancelScheduledUC,
+	)
+
+	messageMentionStore := messageStore.NewMentionStore(txManager)
+
+	mentionHandlers := mentionHandler.NewMentionHandler(
+		mentionsFeed.NewMessageMentionsFeedUseCase(log, messageMentionStore),
+		readMentions.NewMessageReadMentionsUseCase(log, messageMentionStore, chatPreviewInvalidator),
+	)
+
+	draftGetUC := draftGet.NewDraftGetUseCase(
+		log,
+		chatValidatorStore,
+		draftRedisCache,
+		draftStore.NewStore(txManager),
+	)
+
+	draftHandlers := draftHandler.NewDraftHandler(draftGetUC)
+
+	attachmentCreateStore := attachmentStore.NewCreateStore(txManager)
+	attachmentGetStore := attachmentStore.NewGetStore(txManager)
+	attachmentCompleteStore := attachmentStore.NewCompleteStore(txManager, messageIDGenerator)
+	attachmentObjStorage := attachmentStorage.NewStorage(
+		minioConn,
+		bucket.Attachments.String(),
+		minioBucketSvc,
+	)
+	attachmentURLSvc := minioURL.NewUrlService(
+		minioConn.Client,
+		bucket.Attachments.String(),
+		redisStorage.NewStorage(redisConn, redisStorage.Attachment),
+	)
+
+	attachmentRequestUploadUC := requestUpload.NewAttachmentRequestUploadUseCase(
+		log,
+		attachmentCreateStore,
+		chatPermissions,
+		attachmentObjStorage,
+		attachmentURLSvc,
+	)
+	attachmentCompleteUploadUC := completeUpload.NewAttachmentCompleteUploadUseCase(
+		log,
+		txManager,
+		attachmentGetStore,
+		attachmentCompleteStore,
+		attachmentObjStorage,
+		chatPermissions,
+	)
+	attachmentGetDownloadURLUC := getDownloadURL.NewAttachmentGetDownloadURLUseCase(
+		log,
+		attachmentGetStore,
+		chatValidatorStore,
+		attachmentURLSvc,
+	)
+
+	attachmentHandlers := attachmentHandler.NewAttachmentHandler(
+		attachmentRequestUploadUC,
+		attachmentCompleteUploadUC,
+		attachmentGetDownloadURLUC,
+	)
+
+	chatExportStore := exportStore.NewStore(txManager)
+	exportHandlers := exportHandler.NewExportHandler(
+		exportRequest.NewExportRequestUseCase(
+			log,
+			chatExportStore,
+			chatValidatorStore,
+		),
+		exportGet.NewExportGetUseCase(
+			log,
+			chatExportStore,
+			chatValidatorStore,
+			minioURL.NewUrlService(
+				minioConn.Client,
+				bucket.Exports.String(),
+				redisStorage.NewStorage(redisConn, redisStorage.Export),
+			),
+		),
 	)
 
 	srv := fiberHttp.NewServer(
 		&cfg.HTTPServer,
 		healthHandler,
 		chatHandlers,
+		inviteHandlers,
 		userHandlers,
 		messageHandlers,
+		scheduledMessageHandlers,
+		mentionHandlers,
+		draftHandlers,
+		attachmentHandlers,
+		exportHandlers,
 	)
 
 	components := setupComponents(
 		srv,
 		messageSendUC, // todo: rebuild
+		idNodeLease,
+		minioConn,
+		redisConn,
+		chatEventPub,
 		pool,
 	)
 
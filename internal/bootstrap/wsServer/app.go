@@ -1,18 +1,44 @@
 package wsServer
 
 import (
+	draftClear "awesome-chat/internal/application/draft/useCases/clear"
+	draftSave "awesome-chat/internal/application/draft/useCases/save"
+	eventBroadcast "awesome-chat/internal/application/events/useCases/broadcast"
+	"awesome-chat/internal/application/message/useCases/ackReceipts"
 	"awesome-chat/internal/application/message/useCases/broadcast"
+	"awesome-chat/internal/application/message/useCases/checkMentions"
+	"awesome-chat/internal/application/message/useCases/deleteMessage"
+	messageForward "awesome-chat/internal/application/message/useCases/forward"
+	"awesome-chat/internal/application/message/useCases/votePoll"
 	"awesome-chat/internal/domain/app/ports"
+	chatPermission "awesome-chat/internal/domain/core/chat/services/permission"
 	"awesome-chat/internal/infrastructure/config/apps/wsServer"
 	"awesome-chat/internal/infrastructure/logger"
+	"awesome-chat/internal/infrastructure/postgres"
+	"awesome-chat/internal/infrastructure/postgres/executor"
+	chatStore "awesome-chat/internal/infrastructure/postgres/store/chat"
+	draftStore "awesome-chat/internal/infrastructure/postgres/store/draft"
+	messageStore "awesome-chat/internal/infrastructure/postgres/store/message"
 	"awesome-chat/internal/infrastructure/redis"
+	channelCache "awesome-chat/internal/infrastructure/redis/channel"
+	draftCache "awesome-chat/internal/infrastructure/redis/draft"
+	"awesome-chat/internal/infrastructure/redis/pubsub"
+	redisStorage "awesome-chat/internal/infrastructure/redis/storage"
 	"awesome-chat/internal/infrastructure/redis/stream"
 	streamNames "awesome-chat/internal/infrastructure/redis/stream/names"
+	"awesome-chat/internal/infrastructure/snowflake"
 	"awesome-chat/internal/infrastructure/ws/chathub"
 	"awesome-chat/internal/infrastructure/ws/chathub/transport"
+	wsAckReceipts "awesome-chat/internal/infrastructure/ws/chathub/transport/ackReceipts"
+	wsDeleteMessage "awesome-chat/internal/infrastructure/ws/chathub/transport/deleteMessage"
+	"awesome-chat/internal/infrastructure/ws/chathub/transport/forwardMessage"
+	"awesome-chat/internal/infrastructure/ws/chathub/transport/saveDraft"
 	"awesome-chat/internal/infrastructure/ws/chathub/transport/sendMessage"
+	wsVotePoll "awesome-chat/internal/infrastructure/ws/chathub/transport/votePoll"
 	"awesome-chat/internal/presentation/httpGin/delivery/handlers/ws"
 	"awesome-chat/internal/presentation/httpGin/middleware"
+	"awesome-chat/internal/presentation/workers"
+	"awesome-chat/internal/presentation/workers/redis/handlers/event"
 	"context"
 	"golang.org/x/sync/errgroup"
 	"time"
@@ -31,11 +57,14 @@ func setupComponents(components ...ports.Component) []ports.Component {
 	return components
 }
 
-func NewApp(_ context.Context) *App {
+func NewApp(ctx context.Context) *App {
 	cfg := wsServer.NewConfig()
 
 	log := logger.NewLogger()
 
+	pool := postgres.NewPool(ctx, &cfg.Storage)
+	txManager := executor.NewTransactionManager(pool)
+
 	redisConn := redis.NewConnection(&cfg.Redis)
 	redisStreamPub := stream.NewPublisherImpl(redisConn, streamNames.SentMessage.String())
 
@@ -43,15 +72,98 @@ func NewApp(_ context.Context) *App {
 	wsClientStore := chathub.NewInMemoryClientStoreImpl()
 	wsClientManager := chathub.NewClientManagerV2(log, wsClientStore)
 
+	chatEventPub := pubsub.NewPublisher(&cfg.EventPublisher)
+	chatEventPublisher := pubsub.NewChatEventPublisher(chatEventPub)
+	chatValidatorStore := chatStore.NewValidatorStore(txManager)
+	chatPermissions := chatPermission.NewChecker(chatStore.NewRoleStore(txManager))
+	draftRedisCache := draftCache.NewCache(redisConn)
+	idNodeLease := snowflake.MustLeaseNode(ctx, redisConn, cfg.IDs)
+	messageIDGenerator := snowflake.MustNewGenerator(idNodeLease.Config())
+
 	messageBroadcastWithPubUC := broadcast.NewMessageBroadcastWithPubImpl(
 		log,
+		chatPermissions,
 		redisStreamPub,
+		messageIDGenerator,
 		wsClientManager,
+		draftClear.NewDraftClearUseCase(log, draftRedisCache, draftStore.NewStore(txManager), chatEventPublisher),
+		checkMentions.NewMessageCheckMentionsUseCase(log, chatStore.NewMentionStore(txManager)),
 	)
 	wsSendMsgOpHandler := sendMessage.New(messageBroadcastWithPubUC)
-	wsOpHandler := transport.NewOperationHandler(log, wsSendMsgOpHandler)
+
+	messageForwardUC := messageForward.NewMessageForwardUseCase(
+		log,
+		chatValidatorStore,
+		chatPermissions,
+		messageStore.NewForwardStore(txManager),
+		redisStreamPub,
+		messageIDGenerator,
+		chatEventPublisher,
+	)
+	wsForwardMsgOpHandler := forwardMessage.New(messageForwardUC)
+
+	draftSaveUC := draftSave.NewDraftSaveUseCase(
+		log,
+		chatValidatorStore,
+		draftRedisCache,
+		chatEventPublisher,
+	)
+	wsSaveDraftOpHandler := saveDraft.New(draftSaveUC)
+
+	messageVotePollUC := votePoll.NewMessageVotePollUseCase(
+		log,
+		txManager,
+		chatValidatorStore,
+		messageStore.NewPollStore(txManager, messageIDGenerator),
+		chatEventPublisher,
+	)
+	wsVotePollOpHandler := wsVotePoll.New(messageVotePollUC)
+
+	messageAckReceiptsUC := ackReceipts.NewMessageAckReceiptsUseCase(
+		log,
+		txManager,
+		messageStore.NewReceiptStore(txManager),
+		chatEventPublisher,
+	)
+	wsAckReceiptsOpHandler := wsAckReceipts.New(messageAckReceiptsUC)
+
+	chatPinCache := channelCache.NewPinCache(
+		chatStore.NewPinStore(txManager),
+		channelCache.NewChatTypeCache(
+			chatStore.NewDirectStore(txManager),
+			redisStorage.NewStorage(redisConn, redisStorage.ChatType),
+		),
+		redisStorage.NewStorage(redisConn, redisStorage.ChannelPins),
+	)
+	messageDeleteUC := deleteMessage.NewMessageDeleteUseCase(
+		log,
+		txManager,
+		chatPermissions,
+		messageStore.NewDeleteStore(txManager),
+		chatEventPublisher,
+		chatPinCache,
+	)
+	wsDeleteMessageOpHandler := wsDeleteMessage.New(messageDeleteUC)
+
+	wsOpHandler := transport.NewOperationHandler(
+		log,
+		wsSendMsgOpHandler,
+		wsForwardMsgOpHandler,
+		wsSaveDraftOpHandler,
+		wsVotePollOpHandler,
+		wsAckReceiptsOpHandler,
+		wsDeleteMessageOpHandler,
+	)
 	wsClientManager.MustSetOperationHandler(wsOpHandler)
 
+	chatEventBroadcastUC := eventBroadcast.NewChatEventBroadcastUseCase(log, wsClientManager, wsClientManager)
+	chatEventHandler := event.NewChatEventBroadcastHandler(
+		log,
+		pubsub.NewSubscriber(&cfg.EventSubscriber),
+		chatEventBroadcastUC,
+	)
+	eventWorker := workers.NewWorker(log, chatEventHandler)
+
 	healthHttpHandler := ws.NewHealthHandler()
 
 	authMid := middleware.NewAuthAsClient()
@@ -68,8 +180,12 @@ func NewApp(_ context.Context) *App {
 	)
 
 	components := setupComponents(
+		pool,
 		redisConn,
+		idNodeLease,
+		chatEventPub,
 		wsClientManager,
+		eventWorker,
 		server,
 	)
 
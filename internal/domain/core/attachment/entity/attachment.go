@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"awesome-chat/internal/domain/core/attachment/vo"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Attachment struct {
+	ID         uuid.UUID   `json:"id"`
+	MessageID  int         `json:"message_id,omitempty"`
+	UploaderID uuid.UUID   `json:"uploader_id"`
+	ChatID     uuid.UUID   `json:"chat_id"`
+	Kind       vo.Kind     `json:"kind"`
+	ObjectKey  string      `json:"object_key"`
+	FileName   string      `json:"file_name"`
+	MimeType   string      `json:"mime_type"`
+	Size       int64       `json:"size"`
+	Checksum   vo.Checksum `json:"checksum"`
+	Status     vo.Status   `json:"status"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UploadedAt time.Time   `json:"uploaded_at,omitempty"`
+
+	// image only, filled in by the preview job
+	PreviewStatus vo.PreviewStatus `json:"preview_status,omitempty"`
+	Width         int              `json:"width,omitempty"`
+	Height        int              `json:"height,omitempty"`
+	Blurhash      string           `json:"blurhash,omitempty"`
+	Previews      []vo.Preview     `json:"previews,omitempty"`
+}
+
+// ObjectKeyFor groups objects by chat so a chat can be cleaned up by prefix.
+func ObjectKeyFor(chatID, attachmentID uuid.UUID) string {
+	return chatID.String() + "/" + attachmentID.String()
+}
+
+// PreviewObjectKeyFor keeps thumbnails next to the original object.
+func PreviewObjectKeyFor(objectKey string, size vo.PreviewSize, ext string) string {
+	return objectKey + "_" + size.String() + ext
+}
@@ -0,0 +1,24 @@
+package errors
+
+import "errors"
+
+var (
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentNotUploaded  = errors.New("attachment is not uploaded yet")
+	ErrAlreadyCompleted       = errors.New("attachment upload already completed")
+	ErrUnsupportedContentType = errors.New("unsupported content type")
+	ErrFileTooLarge           = errors.New("file is too large")
+	ErrEmptyFile              = errors.New("file is empty")
+	ErrInvalidChecksum        = errors.New("invalid checksum, sha256 hex expected")
+	ErrChecksumMismatch       = errors.New("uploaded object checksum mismatch")
+	ErrSizeMismatch           = errors.New("uploaded object size mismatch")
+	ErrContentTypeMismatch    = errors.New("uploaded object content type mismatch")
+)
+
+var (
+	ErrNoPendingPreviews  = errors.New("no attachments waiting for previews")
+	ErrUnsupportedImage   = errors.New("image format is not supported for previews")
+	ErrImageTooLarge      = errors.New("image dimensions are too large for previews")
+	ErrPreviewNotFound    = errors.New("preview not found")
+	ErrInvalidPreviewSize = errors.New("invalid preview size")
+)
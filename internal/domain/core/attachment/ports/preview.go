@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"awesome-chat/internal/domain/core/attachment/vo"
+	"io"
+)
+
+type Thumbnail struct {
+	Size     vo.PreviewSize
+	MimeType string
+	Ext      string
+	Width    int
+	Height   int
+	Data     []byte
+}
+
+type GeneratedPreviews struct {
+	Width      int
+	Height     int
+	Blurhash   string
+	Thumbnails []Thumbnail
+}
+
+type PreviewGenerator interface {
+	Generate(r io.Reader, sizes ...vo.PreviewSize) (GeneratedPreviews, error)
+}
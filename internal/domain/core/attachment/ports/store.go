@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"awesome-chat/internal/domain/core/attachment/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type CreateStore interface {
+	Create(ctx context.Context, attachment entity.Attachment) error
+}
+
+type GetStore interface {
+	Get(ctx context.Context, id uuid.UUID) (entity.Attachment, error)
+	GetByMessageIDs(ctx context.Context, messageIDs []int) (map[int][]entity.Attachment, error)
+}
+
+type CompleteStore interface {
+	// Complete creates the message the attachment belongs to and marks it uploaded.
+	Complete(ctx context.Context, attachment entity.Attachment) (messageID int, err error)
+}
+
+type PreviewStore interface {
+	// ClaimPending leases one image due for previews and counts the attempt, the claim is committed at once
+	// so no transaction stays open while the image is processed. A lease left by a dead worker runs out.
+	ClaimPending(ctx context.Context) (entity.Attachment, error)
+	// SavePreviews stores generated previews and touches the message the attachment belongs to.
+	SavePreviews(ctx context.Context, attachment entity.Attachment) error
+	// MarkFailed backs the image off before its next attempt, or gives it up once it has used maxAttempts.
+	MarkFailed(ctx context.Context, id uuid.UUID, maxAttempts int) error
+}
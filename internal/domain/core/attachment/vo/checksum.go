@@ -0,0 +1,25 @@
+package vo
+
+import (
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"encoding/hex"
+	"strings"
+)
+
+// Checksum is a hex encoded sha256 of the object content.
+type Checksum string
+
+func NewChecksum(s string) (Checksum, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if len(s) != hex.EncodedLen(32) {
+		return "", attachmentErrors.ErrInvalidChecksum
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return "", attachmentErrors.ErrInvalidChecksum
+	}
+	return Checksum(s), nil
+}
+
+func (c Checksum) String() string {
+	return string(c)
+}
@@ -0,0 +1,96 @@
+package vo
+
+import (
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// Kind matches messages.message_type of the message an attachment belongs to.
+type Kind string
+
+const (
+	KindImage Kind = "image"
+	KindVideo Kind = "video"
+	KindAudio Kind = "audio"
+	KindFile  Kind = "file"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+const mb = 1 << 20
+
+type rule struct {
+	kind    Kind
+	maxSize int64
+	types   map[string]struct{}
+}
+
+func newRule(kind Kind, maxSize int64, types ...string) rule {
+	r := rule{kind: kind, maxSize: maxSize, types: make(map[string]struct{}, len(types))}
+	for _, t := range types {
+		r.types[t] = struct{}{}
+	}
+	return r
+}
+
+var rules = []rule{
+	newRule(KindImage, 20*mb,
+		"image/jpeg", "image/png", "image/gif", "image/webp",
+	),
+	newRule(KindVideo, 500*mb,
+		"video/mp4", "video/webm", "video/quicktime",
+	),
+	newRule(KindAudio, 50*mb,
+		"audio/mpeg", "audio/ogg", "audio/wav", "audio/webm", "audio/mp4",
+	),
+	newRule(KindFile, 100*mb,
+		"application/pdf",
+		"application/zip",
+		"application/x-7z-compressed",
+		"application/json",
+		"application/msword",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.ms-excel",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.ms-powerpoint",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"application/octet-stream",
+		"text/plain",
+		"text/csv",
+	),
+}
+
+// NormalizeMimeType strips parameters and lower-cases the media type.
+func NormalizeMimeType(mimeType string) string {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(mimeType))
+	}
+	return mediaType
+}
+
+// Classify validates mime type and size against per-kind rules and returns the kind.
+func Classify(mimeType string, size int64) (Kind, error) {
+	if size <= 0 {
+		return "", attachmentErrors.ErrEmptyFile
+	}
+
+	mediaType := NormalizeMimeType(mimeType)
+	for _, r := range rules {
+		if _, ok := r.types[mediaType]; !ok {
+			continue
+		}
+		if size > r.maxSize {
+			return "", fmt.Errorf("%w: %s limit is %d bytes",
+				attachmentErrors.ErrFileTooLarge, r.kind, r.maxSize,
+			)
+		}
+		return r.kind, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", attachmentErrors.ErrUnsupportedContentType, mediaType)
+}
@@ -0,0 +1,54 @@
+package vo
+
+type PreviewStatus string
+
+const (
+	PreviewStatusPending PreviewStatus = "pending"
+	PreviewStatusReady   PreviewStatus = "ready"
+	PreviewStatusFailed  PreviewStatus = "failed"
+)
+
+func (s PreviewStatus) String() string {
+	return string(s)
+}
+
+type PreviewSize string
+
+const (
+	PreviewSizeSmall  PreviewSize = "small"
+	PreviewSizeMedium PreviewSize = "medium"
+	PreviewSizeLarge  PreviewSize = "large"
+)
+
+// PreviewSizes are generated for every image, smallest first.
+var PreviewSizes = []PreviewSize{PreviewSizeSmall, PreviewSizeMedium, PreviewSizeLarge}
+
+func (s PreviewSize) String() string {
+	return string(s)
+}
+
+// MaxSide is the bound of the longest side of the thumbnail in pixels.
+func (s PreviewSize) MaxSide() int {
+	switch s {
+	case PreviewSizeSmall:
+		return 160
+	case PreviewSizeMedium:
+		return 480
+	case PreviewSizeLarge:
+		return 1280
+	default:
+		return 0
+	}
+}
+
+func (s PreviewSize) IsValid() bool {
+	return s.MaxSide() > 0
+}
+
+type Preview struct {
+	Size      PreviewSize `json:"size"`
+	ObjectKey string      `json:"object_key"`
+	MimeType  string      `json:"mime_type"`
+	Width     int         `json:"width"`
+	Height    int         `json:"height"`
+}
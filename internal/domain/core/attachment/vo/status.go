@@ -0,0 +1,8 @@
+package vo
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusUploaded Status = "uploaded"
+)
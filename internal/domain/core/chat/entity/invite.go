@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"time"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/vo"
+
+	"github.com/google/uuid"
+)
+
+type Invite struct {
+	ID               uuid.UUID
+	ChatID           uuid.UUID
+	Token            string
+	CreatedBy        uuid.UUID
+	ExpiresAt        *time.Time
+	MaxUses          int
+	Uses             int
+	RequiresApproval bool
+	RevokedAt        *time.Time
+	CreatedAt        time.Time
+}
+
+// Check tells whether the invite can still be redeemed, a revoked invite reads as not found.
+func (i Invite) Check(now time.Time) error {
+	switch {
+	case i.RevokedAt != nil:
+		return chatErrors.ErrInviteNotFound
+	case i.ExpiresAt != nil && !now.Before(*i.ExpiresAt):
+		return chatErrors.ErrInviteExpired
+	case i.MaxUses > 0 && i.Uses >= i.MaxUses:
+		return chatErrors.ErrInviteExhausted
+	}
+	return nil
+}
+
+type JoinRequest struct {
+	ChatID    uuid.UUID
+	UserID    uuid.UUID
+	Username  string
+	InviteID  *uuid.UUID
+	Status    vo.JoinRequestStatus
+	CreatedAt time.Time
+}
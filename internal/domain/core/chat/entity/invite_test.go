@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+func TestInvite_Check(t *testing.T) {
+	now := time.Date(2025, 9, 14, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	tests := []struct {
+		name     string
+		invite   Invite
+		expected error
+	}{
+		{"Open", Invite{}, nil},
+		{"Before expiry", Invite{ExpiresAt: &future}, nil},
+		{"Expired", Invite{ExpiresAt: &past}, chatErrors.ErrInviteExpired},
+		{"Expires now", Invite{ExpiresAt: &now}, chatErrors.ErrInviteExpired},
+		{"Uses left", Invite{MaxUses: 2, Uses: 1}, nil},
+		{"Used up", Invite{MaxUses: 2, Uses: 2}, chatErrors.ErrInviteExhausted},
+		{"Unlimited", Invite{Uses: 500}, nil},
+		{"Revoked", Invite{RevokedAt: &past, ExpiresAt: &future}, chatErrors.ErrInviteNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.invite.Check(now); !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+
+	"awesome-chat/internal/domain/core/chat/vo"
+
+	"github.com/google/uuid"
+)
+
+type ChatMetadata struct {
+	ChatID      uuid.UUID
+	Type        vo.ChatType
+	Name        string
+	Description string
+	Avatar      vo.Avatar
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxPins bounds the pinned banner, clients cycle through pins newest first.
+const MaxPins = 50
+
+type Pin struct {
+	ChatID   uuid.UUID      `json:"chat_id"`
+	Message  MessagePreview `json:"message"`
+	PinnedBy uuid.UUID      `json:"pinned_by"`
+	PinnedAt time.Time      `json:"pinned_at"`
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// ChatPreferences are the settings of one member for one chat.
+type ChatPreferences struct {
+	MutedUntil *time.Time
+	Archived   bool
+	Pinned     bool
+	// SortOrder places the chat before the ones ordered by activity, zero when unset.
+	SortOrder int
+}
+
+func (p ChatPreferences) IsMuted(now time.Time) bool {
+	return p.MutedUntil != nil && now.Before(*p.MutedUntil)
+}
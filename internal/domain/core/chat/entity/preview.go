@@ -1,18 +1,37 @@
 package entity
 
 import (
+	"awesome-chat/internal/domain/core/chat/vo"
 	"github.com/google/uuid"
 	"time"
 )
 
 type (
 	ChatPreview struct {
-		ChatID       uuid.UUID      `json:"chat_id"`
-		Name         string         `json:"name"`
-		LastMessage  MessagePreview `json:"last_message,omitempty"`
-		UnreadCount  int            `json:"unread_count,omitempty"`
-		AvatarURL    string         `json:"avatar_url,omitempty"`
-		Participants []Participant  `json:"participants"`
+		ChatID           uuid.UUID      `json:"chat_id"`
+		Type             vo.ChatType    `json:"type"`
+		Name             string         `json:"name"`
+		Description      string         `json:"description,omitempty"`
+		LastMessage      MessagePreview `json:"last_message,omitempty"`
+		UnreadCount      int            `json:"unread_count,omitempty"`
+		UnreadMentions   int            `json:"unread_mentions,omitempty"`
+		Avatar           vo.Avatar      `json:"avatar"`
+		Participants     []Participant  `json:"participants"`
+		MemberCount      int            `json:"member_count"`
+		PinnedMessageIDs []int          `json:"pinned_message_ids"`
+		MessageTTL       time.Duration  `json:"message_ttl,omitempty"`
+		Draft            *DraftPreview  `json:"draft,omitempty"`
+		// ActiveAt is the time of the last message, or the creation of a chat nobody wrote in yet.
+		ActiveAt time.Time `json:"active_at"`
+		// Role and Preferences are the ones of the user who requested the previews.
+		Role        vo.Role         `json:"role"`
+		Preferences ChatPreferences `json:"preferences"`
+	}
+	// ChatPreviewPage is one page of the ordered previews, Next is zero on the last one.
+	ChatPreviewPage struct {
+		Previews []ChatPreview    `json:"previews"`
+		Total    int              `json:"total"`
+		Next     vo.PreviewCursor `json:"next"`
 	}
 	MessagePreview struct {
 		ID        int       `json:"id"`
@@ -20,10 +39,26 @@ type (
 		Text      string    `json:"text"`
 		Timestamp time.Time `json:"timestamp"`
 	}
+	// DraftPreview is the unsent text of the user who requested the previews.
+	DraftPreview struct {
+		Text      string    `json:"text"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
 	Participant struct {
 		UserID    uuid.UUID `json:"user_id"`
 		Username  string    `json:"username"`
 		AvatarURL string    `json:"avatar_url,omitempty"`
 		IsOnline  bool      `json:"is_online,omitempty"`
+		Role      vo.Role   `json:"role"`
 	}
 )
+
+// Cursor is the position of the chat in the preview order.
+func (p ChatPreview) Cursor() vo.PreviewCursor {
+	return vo.PreviewCursor{
+		Pinned:    p.Preferences.Pinned,
+		SortOrder: p.Preferences.SortOrder,
+		ActiveAt:  p.ActiveAt,
+		ChatID:    p.ChatID,
+	}
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"awesome-chat/internal/domain/core/chat/vo"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SystemMessageType is the message_type of system messages, clients render them apart from user text.
+const SystemMessageType = "system"
+
+// SystemMessage is stored in the timeline next to the membership change it reports.
+// Content is the plain text fallback for clients which do not render Payload.
+type SystemMessage struct {
+	ID        int
+	ChatID    uuid.UUID
+	Payload   vo.SystemPayload
+	Content   string
+	CreatedAt time.Time
+}
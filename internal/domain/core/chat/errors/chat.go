@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrChatNotFound         = errors.New("chat not found")
+	ErrInvalidMentionPolicy = errors.New("unknown mention policy")
+)
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrChatShortName         = errors.New("chat name is too short")
 	ErrChatInvalidMembersLen = errors.New("chat requires at least one member")
+	ErrInvalidChatType       = errors.New("unknown chat type")
+	ErrChatCreatorRequired   = errors.New("chat requires a creator")
 )
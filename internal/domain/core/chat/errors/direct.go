@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrDirectWithSelf    = errors.New("direct chat needs another user")
+	ErrDirectChatMembers = errors.New("direct chat can not gain members")
+)
@@ -0,0 +1,12 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInviteNotFound      = errors.New("invite not found")
+	ErrInviteExpired       = errors.New("invite expired")
+	ErrInviteExhausted     = errors.New("invite has no uses left")
+	ErrInvalidInvite       = errors.New("invalid invite settings")
+	ErrJoinRequestNotFound = errors.New("join request not found")
+	ErrJoinRequestPending  = errors.New("join request already pending")
+)
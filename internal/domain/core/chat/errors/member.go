@@ -0,0 +1,9 @@
+package errors
+
+import "errors"
+
+var (
+	ErrNotChatMember = errors.New("user is not a chat member")
+	ErrAlreadyMember = errors.New("user is already a chat member")
+	ErrRemoveSelf    = errors.New("members leave the chat instead of removing themselves")
+)
@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrChatLongName       = errors.New("chat name is too long")
+	ErrDescriptionTooLong = errors.New("chat description is too long")
+	ErrNothingToUpdate    = errors.New("nothing to update")
+	ErrDirectChatSettings = errors.New("direct chat settings can not be changed")
+	ErrAvatarTooLarge     = errors.New("avatar image is too large")
+)
@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrMessageNotInChat = errors.New("message does not belong to the chat")
+	ErrAlreadyPinned    = errors.New("message is already pinned")
+	ErrNotPinned        = errors.New("message is not pinned")
+	ErrTooManyPins      = errors.New("chat has reached the pinned messages limit")
+	ErrPermissionDenied = errors.New("user has no permission for this action")
+)
@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInvalidMute      = errors.New("mute must end in the future")
+	ErrInvalidSortOrder = errors.New("sort order must not be negative")
+)
@@ -0,0 +1,5 @@
+package errors
+
+import "errors"
+
+var ErrInvalidPreviewCursor = errors.New("invalid preview cursor")
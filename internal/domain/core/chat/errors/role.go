@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInvalidRole       = errors.New("unknown chat role")
+	ErrRoleNotAssignable = errors.New("role can not be assigned by this user")
+)
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"awesome-chat/internal/domain/core/chat/vo"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// PermissionChecker answers ErrNotChatMember for outsiders and ErrPermissionDenied when the role falls short.
+type PermissionChecker interface {
+	Require(ctx context.Context, chatID, userID uuid.UUID, permission vo.Permission) (vo.Role, error)
+	RequireOver(ctx context.Context, chatID, actorID, targetID uuid.UUID, permission vo.Permission) (vo.Role, error)
+	RequireAssign(ctx context.Context, chatID, actorID, targetID uuid.UUID, role vo.Role) error
+	RequireDelete(ctx context.Context, chatID, userID, authorID uuid.UUID) error
+	SendGuard
+}
+
+// SendGuard is asked by every path that creates a message on behalf of a user, whatever the transport.
+type SendGuard interface {
+	RequireSend(ctx context.Context, chatID, userID uuid.UUID) error
+}
@@ -5,21 +5,18 @@ import (
 	"awesome-chat/internal/domain/core/chat/vo"
 	userVO "awesome-chat/internal/domain/core/user/vo"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type CreateWithMembersStore interface {
-	CreateChat(ctx context.Context, chatID vo.ChatID, chatName string) error
-	AddMembers(ctx context.Context, chatID vo.ChatID, memberIDs userVO.UserIDs) error
-}
-
-type GetAllMessagesStore interface {
-	Execute(ctx context.Context, id uuid.UUID) ([]entity.MessagePreview, error)
+	CreateChat(ctx context.Context, chatID vo.ChatID, chatName string, chatType vo.ChatType) error
+	AddMembers(ctx context.Context, chatID vo.ChatID, memberIDs userVO.UserIDs, role vo.Role) error
 }
 
 type AddMemberStore interface {
-	AddMember(ctx context.Context, chatID uuid.UUID, userID uuid.UUID) error
+	AddMember(ctx context.Context, chatID uuid.UUID, userID uuid.UUID, role vo.Role) error
 }
 
 type ValidateStore interface {
@@ -27,18 +24,124 @@ type ValidateStore interface {
 	IsMember(ctx context.Context, chatID uuid.UUID, userID uuid.UUID) (bool, error)
 }
 type GetUserChatPreviewStore interface {
+	// SetupChatPreviews loads the page of previews after its cursor, pinned chats come first by sort order,
+	// then every chat by last activity. Archived chats are left out unless includeArchived is set.
 	SetupChatPreviews(
 		ctx context.Context,
 		userID uuid.UUID,
+		includeArchived bool,
+		page vo.PreviewPage,
 	) (
-		previews []entity.ChatPreview,
-		err error,
+		entity.ChatPreviewPage,
+		error,
 	)
-	BatchParticipantsForChats(
+	// PreviewParticipants loads at most limit members of every chat besides the viewer,
+	// for a direct chat that is the peer. Channels are left out by the callers.
+	PreviewParticipants(
 		ctx context.Context,
+		viewerID uuid.UUID,
 		chatIDs []uuid.UUID,
+		limit int,
 	) (
 		map[uuid.UUID][]entity.Participant,
 		error,
 	)
 }
+
+// PreviewCache drops cached preview lists, the next load of those users goes to the store.
+type PreviewCache interface {
+	Invalidate(ctx context.Context, userIDs []uuid.UUID) error
+}
+
+// PreviewAudienceStore lists the users whose previews show any of the chats. Channels are left out,
+// the lists of their subscribers expire instead of being dropped on every post.
+type PreviewAudienceStore interface {
+	PreviewAudience(ctx context.Context, chatIDs []uuid.UUID) ([]uuid.UUID, error)
+}
+
+// RoleStore reads and changes membership roles, both fail with ErrNotChatMember for outsiders.
+type RoleStore interface {
+	Role(ctx context.Context, chatID uuid.UUID, userID uuid.UUID) (vo.Role, error)
+	SetRole(ctx context.Context, chatID uuid.UUID, userID uuid.UUID, role vo.Role) error
+}
+
+// TTLStore sets how long new messages of the chat live, zero turns expiry off.
+type TTLStore interface {
+	SetMessageTTL(ctx context.Context, chatID uuid.UUID, ttl time.Duration) error
+}
+
+type MentionStore interface {
+	MentionPolicy(ctx context.Context, chatID uuid.UUID) (vo.MentionPolicy, error)
+	SetMentionPolicy(ctx context.Context, chatID uuid.UUID, policy vo.MentionPolicy) error
+	// MissingMembers returns the usernames which belong to no member of the chat.
+	MissingMembers(ctx context.Context, chatID uuid.UUID, usernames []string) ([]string, error)
+}
+
+type PinStore interface {
+	// Pin has to run in a transaction, it fails with ErrTooManyPins once the chat holds MaxPins.
+	Pin(ctx context.Context, chatID uuid.UUID, messageID int, userID uuid.UUID) (entity.Pin, error)
+	Unpin(ctx context.Context, chatID uuid.UUID, messageID int) error
+	List(ctx context.Context, chatID uuid.UUID) ([]entity.Pin, error)
+}
+
+// DirectStore keeps the one direct chat of a pair of users.
+type DirectStore interface {
+	// FindDirect fails with ErrChatNotFound while the pair has no chat.
+	FindDirect(ctx context.Context, pair vo.DirectPair) (uuid.UUID, error)
+	// CreateDirect stores the chat inside the transaction of ctx, it reports false when
+	// the pair already got a chat, the caller then rolls back and reads that one.
+	CreateDirect(ctx context.Context, chatID uuid.UUID, pair vo.DirectPair) (bool, error)
+}
+
+type ChatTypeStore interface {
+	ChatType(ctx context.Context, chatID uuid.UUID) (vo.ChatType, error)
+}
+
+// MembershipStore removes members, every method runs in the transaction of ctx.
+type MembershipStore interface {
+	// RemoveMember locks the chat so concurrent leaves see each other, it returns the role the user had.
+	RemoveMember(ctx context.Context, chatID uuid.UUID, userID uuid.UUID) (vo.Role, error)
+	// PromoteSuccessor makes the highest ranked, longest standing member the owner, uuid.Nil when nobody is left.
+	PromoteSuccessor(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error)
+	// DeleteIfEmpty drops the chat once its last member is gone.
+	DeleteIfEmpty(ctx context.Context, chatID uuid.UUID) (bool, error)
+}
+
+type SystemMessageStore interface {
+	// SaveSystem has to run in the transaction of the change, the payload actor is stored as the sender.
+	SaveSystem(ctx context.Context, chatID uuid.UUID, payload vo.SystemPayload, content string) (entity.SystemMessage, error)
+}
+
+type InviteStore interface {
+	CreateInvite(ctx context.Context, invite entity.Invite) error
+	// ListInvites returns the invites of the chat which are not revoked, expired or used up ones included.
+	ListInvites(ctx context.Context, chatID uuid.UUID) ([]entity.Invite, error)
+	RevokeInvite(ctx context.Context, chatID uuid.UUID, inviteID uuid.UUID) error
+	// InviteForUpdate locks the invite in the transaction of ctx so concurrent joins can not exceed max uses.
+	InviteForUpdate(ctx context.Context, token string) (entity.Invite, error)
+	UseInvite(ctx context.Context, inviteID uuid.UUID) error
+}
+
+// JoinRequestStore keeps the queue of users waiting for an admin, writes run in the transaction of ctx.
+type JoinRequestStore interface {
+	// CreateJoinRequest files the request again when an earlier one was decided, it fails
+	// with ErrJoinRequestPending while one is still open.
+	CreateJoinRequest(ctx context.Context, request entity.JoinRequest) error
+	ListJoinRequests(ctx context.Context, chatID uuid.UUID) ([]entity.JoinRequest, error)
+	// DecideJoinRequest closes a pending request, ErrJoinRequestNotFound when there is none.
+	DecideJoinRequest(ctx context.Context, chatID, userID, deciderID uuid.UUID, status vo.JoinRequestStatus) error
+}
+
+// MetadataStore keeps the name, description and avatar of a chat.
+type MetadataStore interface {
+	Metadata(ctx context.Context, chatID uuid.UUID) (entity.ChatMetadata, error)
+	UpdateInfo(ctx context.Context, chatID uuid.UUID, update vo.ChatInfoUpdate) (entity.ChatMetadata, error)
+	// SetAvatar replaces the avatar inside the transaction of ctx and returns the previous one,
+	// whose objects the caller removes after commit. A zero avatar removes it.
+	SetAvatar(ctx context.Context, chatID uuid.UUID, avatar vo.Avatar) (vo.Avatar, error)
+}
+
+// PreferencesStore changes the settings of one member, ErrNotChatMember for outsiders.
+type PreferencesStore interface {
+	UpdatePreferences(ctx context.Context, chatID, userID uuid.UUID, update vo.PreferencesUpdate) (entity.ChatPreferences, error)
+}
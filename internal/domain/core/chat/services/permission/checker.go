@@ -0,0 +1,119 @@
+package permission
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var _ ports.PermissionChecker = (*Checker)(nil)
+
+// Checker is the one place deciding what a member may do in a chat, use cases and handlers ask it instead of the store.
+type Checker struct {
+	roles ports.RoleStore
+}
+
+func NewChecker(roles ports.RoleStore) *Checker {
+	return &Checker{roles: roles}
+}
+
+// Require returns the role of the user when it grants the permission.
+func (c *Checker) Require(
+	ctx context.Context,
+	chatID, userID uuid.UUID,
+	permission vo.Permission,
+) (vo.Role, error) {
+	const op = "permission.Checker.Require"
+
+	role, err := c.roles.Role(ctx, chatID, userID)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if !role.Can(permission) {
+		return "", fmt.Errorf("%s: %s can not %s: %w", op, role, permission, chatErrors.ErrPermissionDenied)
+	}
+
+	return role, nil
+}
+
+// RequireSend checks the user may post into the chat, read-only members and channel subscribers may not.
+func (c *Checker) RequireSend(ctx context.Context, chatID, userID uuid.UUID) error {
+	if _, err := c.Require(ctx, chatID, userID, vo.PermissionSendMessages); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RequireDelete checks the user may delete a message of author, members delete their own,
+// the messages of others take the delete permission.
+func (c *Checker) RequireDelete(ctx context.Context, chatID, userID, authorID uuid.UUID) error {
+	const op = "permission.Checker.RequireDelete"
+
+	if userID != authorID {
+		if _, err := c.Require(ctx, chatID, userID, vo.PermissionDeleteMessages); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if _, err := c.roles.Role(ctx, chatID, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// RequireOver is Require for actions on another member, the actor has to outrank the target.
+// It returns the role of the target.
+func (c *Checker) RequireOver(
+	ctx context.Context,
+	chatID, actorID, targetID uuid.UUID,
+	permission vo.Permission,
+) (vo.Role, error) {
+	const op = "permission.Checker.RequireOver"
+
+	actorRole, err := c.Require(ctx, chatID, actorID, permission)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	targetRole, err := c.roles.Role(ctx, chatID, targetID)
+	if err != nil {
+		return "", fmt.Errorf("%s: target: %w", op, err)
+	}
+	if !actorRole.Outranks(targetRole) {
+		return "", fmt.Errorf("%s: %s can not act on %s: %w", op, actorRole, targetRole, chatErrors.ErrPermissionDenied)
+	}
+
+	return targetRole, nil
+}
+
+// RequireAssign checks the actor may give the target the role, only roles below the actor's own can be handed out.
+func (c *Checker) RequireAssign(
+	ctx context.Context,
+	chatID, actorID, targetID uuid.UUID,
+	role vo.Role,
+) error {
+	const op = "permission.Checker.RequireAssign"
+
+	if role == vo.RoleOwner {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrRoleNotAssignable)
+	}
+
+	actorRole, err := c.roles.Role(ctx, chatID, actorID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !actorRole.Outranks(role) {
+		return fmt.Errorf("%s: %s can not assign %s: %w", op, actorRole, role, chatErrors.ErrRoleNotAssignable)
+	}
+
+	if _, err = c.RequireOver(ctx, chatID, actorID, targetID, vo.PermissionManageRoles); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/vo"
+)
+
+type roleStore map[uuid.UUID]vo.Role
+
+func (s roleStore) Role(_ context.Context, _ uuid.UUID, userID uuid.UUID) (vo.Role, error) {
+	role, ok := s[userID]
+	if !ok {
+		return "", chatErrors.ErrNotChatMember
+	}
+	return role, nil
+}
+
+func (s roleStore) SetRole(_ context.Context, _ uuid.UUID, userID uuid.UUID, role vo.Role) error {
+	s[userID] = role
+	return nil
+}
+
+var (
+	chatID   = uuid.New()
+	owner    = uuid.New()
+	admin    = uuid.New()
+	admin2   = uuid.New()
+	member   = uuid.New()
+	readOnly = uuid.New()
+	outsider = uuid.New()
+)
+
+func newChecker() *Checker {
+	return NewChecker(roleStore{
+		owner:    vo.RoleOwner,
+		admin:    vo.RoleAdmin,
+		admin2:   vo.RoleAdmin,
+		member:   vo.RoleMember,
+		readOnly: vo.RoleReadOnly,
+	})
+}
+
+func TestChecker_Require(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     uuid.UUID
+		permission vo.Permission
+		expected   error
+	}{
+		{"Owner manages roles", owner, vo.PermissionManageRoles, nil},
+		{"Admin renames", admin, vo.PermissionRenameChat, nil},
+		{"Admin deletes messages", admin, vo.PermissionDeleteMessages, nil},
+		{"Member posts", member, vo.PermissionSendMessages, nil},
+		{"Member can not add members", member, vo.PermissionAddMembers, chatErrors.ErrPermissionDenied},
+		{"Member can not pin", member, vo.PermissionPinMessages, chatErrors.ErrPermissionDenied},
+		{"Read-only can not post", readOnly, vo.PermissionSendMessages, chatErrors.ErrPermissionDenied},
+		{"Outsider", outsider, vo.PermissionSendMessages, chatErrors.ErrNotChatMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newChecker().Require(context.Background(), chatID, tt.userID, tt.permission)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestChecker_RequireOver(t *testing.T) {
+	tests := []struct {
+		name     string
+		actorID  uuid.UUID
+		targetID uuid.UUID
+		expected error
+	}{
+		{"Owner removes admin", owner, admin, nil},
+		{"Admin removes member", admin, member, nil},
+		{"Admin removes read-only", admin, readOnly, nil},
+		{"Admin can not remove admin", admin, admin2, chatErrors.ErrPermissionDenied},
+		{"Admin can not remove owner", admin, owner, chatErrors.ErrPermissionDenied},
+		{"Owner can not act on self", owner, owner, chatErrors.ErrPermissionDenied},
+		{"Member can not remove", member, readOnly, chatErrors.ErrPermissionDenied},
+		{"Target not in chat", admin, outsider, chatErrors.ErrNotChatMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newChecker().RequireOver(context.Background(), chatID, tt.actorID, tt.targetID, vo.PermissionRemoveMembers)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestChecker_RequireAssign(t *testing.T) {
+	tests := []struct {
+		name     string
+		actorID  uuid.UUID
+		targetID uuid.UUID
+		role     vo.Role
+		expected error
+	}{
+		{"Owner promotes member to admin", owner, member, vo.RoleAdmin, nil},
+		{"Owner demotes admin", owner, admin, vo.RoleReadOnly, nil},
+		{"Admin mutes member", admin, member, vo.RoleReadOnly, nil},
+		{"Admin unmutes read-only", admin, readOnly, vo.RoleMember, nil},
+		{"Admin can not promote to admin", admin, member, vo.RoleAdmin, chatErrors.ErrRoleNotAssignable},
+		{"Admin can not demote admin", admin, admin2, vo.RoleMember, chatErrors.ErrPermissionDenied},
+		{"Ownership is not assignable", owner, admin, vo.RoleOwner, chatErrors.ErrRoleNotAssignable},
+		{"Member can not assign", member, readOnly, vo.RoleReadOnly, chatErrors.ErrPermissionDenied},
+		{"Actor not in chat", outsider, member, vo.RoleReadOnly, chatErrors.ErrNotChatMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newChecker().RequireAssign(context.Background(), chatID, tt.actorID, tt.targetID, tt.role)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestChecker_RequireDelete(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   uuid.UUID
+		authorID uuid.UUID
+		expected error
+	}{
+		{"Member deletes own", member, member, nil},
+		{"Read-only deletes own", readOnly, readOnly, nil},
+		{"Admin deletes of member", admin, member, nil},
+		{"Owner deletes of admin", owner, admin, nil},
+		{"Admin deletes of a former member", admin, outsider, nil},
+		{"Member can not delete of others", member, admin, chatErrors.ErrPermissionDenied},
+		{"Read-only can not delete of others", readOnly, member, chatErrors.ErrPermissionDenied},
+		{"Former member can not delete own", outsider, outsider, chatErrors.ErrNotChatMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newChecker().RequireDelete(context.Background(), chatID, tt.userID, tt.authorID)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		raw      string
+		expected error
+	}{
+		{"admin", nil},
+		{"member", nil},
+		{"read_only", nil},
+		{"owner", chatErrors.ErrRoleNotAssignable},
+		{"superuser", chatErrors.ErrInvalidRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			role, err := vo.ParseRole(tt.raw)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if err == nil && role.String() != tt.raw {
+				t.Fatalf("expected %q, got %q", tt.raw, role)
+			}
+		})
+	}
+}
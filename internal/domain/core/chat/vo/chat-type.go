@@ -0,0 +1,77 @@
+package vo
+
+import (
+	"bytes"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"github.com/google/uuid"
+)
+
+type ChatType string
+
+const (
+	ChatTypeGroup  ChatType = "group"
+	ChatTypeDirect ChatType = "direct"
+	// ChatTypeChannel is read by its subscribers, only admins post.
+	ChatTypeChannel ChatType = "channel"
+)
+
+func (t ChatType) String() string {
+	return string(t)
+}
+
+// ParseChatType reads the type of a new chat, empty is a group. Direct chats are opened by pair instead.
+func ParseChatType(raw string) (ChatType, error) {
+	switch t := ChatType(raw); t {
+	case "":
+		return ChatTypeGroup, nil
+	case ChatTypeGroup, ChatTypeChannel:
+		return t, nil
+	default:
+		return "", chatErrors.ErrInvalidChatType
+	}
+}
+
+// JoinRole is given to everyone joining the chat after its creator, channel subscribers can only read.
+func (t ChatType) JoinRole() Role {
+	if t == ChatTypeChannel {
+		return RoleReadOnly
+	}
+	return RoleMember
+}
+
+// Allows tells if a member may hold the role, channels have admins and subscribers but no posting members.
+func (t ChatType) Allows(role Role) bool {
+	return t != ChatTypeChannel || role != RoleMember
+}
+
+// AnnouncesMembers tells if users joining or leaving on their own get a system message,
+// channels skip them so the posts are not buried under subscriber churn.
+func (t ChatType) AnnouncesMembers() bool {
+	return t != ChatTypeChannel
+}
+
+// DirectPair is the ordered pair of users of a direct chat, both users open the same pair.
+type DirectPair struct {
+	Low  uuid.UUID
+	High uuid.UUID
+}
+
+func NewDirectPair(a, b uuid.UUID) (DirectPair, error) {
+	switch cmp := bytes.Compare(a[:], b[:]); {
+	case cmp < 0:
+		return DirectPair{Low: a, High: b}, nil
+	case cmp > 0:
+		return DirectPair{Low: b, High: a}, nil
+	default:
+		return DirectPair{}, chatErrors.ErrDirectWithSelf
+	}
+}
+
+// Peer is the other user of the pair.
+func (p DirectPair) Peer(userID uuid.UUID) uuid.UUID {
+	if userID == p.Low {
+		return p.High
+	}
+	return p.Low
+}
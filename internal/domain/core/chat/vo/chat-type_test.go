@@ -0,0 +1,72 @@
+package vo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+func TestNewDirectPair(t *testing.T) {
+	a := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	b := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	ab, err := NewDirectPair(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ba, err := NewDirectPair(b, a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ab != ba || ab.Low != a || ab.High != b {
+		t.Fatalf("expected the same ordered pair, got %v and %v", ab, ba)
+	}
+	if ab.Peer(a) != b || ab.Peer(b) != a {
+		t.Fatalf("unexpected peers for %v", ab)
+	}
+
+	if _, err = NewDirectPair(a, a); !errors.Is(err, chatErrors.ErrDirectWithSelf) {
+		t.Fatalf("expected %v, got %v", chatErrors.ErrDirectWithSelf, err)
+	}
+}
+
+func TestParseChatType(t *testing.T) {
+	tests := []struct {
+		raw      string
+		want     ChatType
+		expected error
+	}{
+		{"", ChatTypeGroup, nil},
+		{"group", ChatTypeGroup, nil},
+		{"channel", ChatTypeChannel, nil},
+		{"direct", "", chatErrors.ErrInvalidChatType},
+		{"forum", "", chatErrors.ErrInvalidChatType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			chatType, err := ParseChatType(tt.raw)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if chatType != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, chatType)
+			}
+		})
+	}
+}
+
+func TestChatType_Channel(t *testing.T) {
+	if ChatTypeChannel.JoinRole() != RoleReadOnly || ChatTypeGroup.JoinRole() != RoleMember {
+		t.Fatal("expected subscribers to join read-only and group members to post")
+	}
+	if ChatTypeChannel.Allows(RoleMember) || !ChatTypeChannel.Allows(RoleAdmin) || !ChatTypeGroup.Allows(RoleMember) {
+		t.Fatal("expected channels to hold admins and subscribers only")
+	}
+	if ChatTypeChannel.AnnouncesMembers() || !ChatTypeGroup.AnnouncesMembers() {
+		t.Fatal("expected only channels to skip member announcements")
+	}
+}
@@ -0,0 +1,63 @@
+package vo
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+const (
+	MaxInviteTTL  = 30 * 24 * time.Hour
+	MaxInviteUses = 10000
+	// inviteTokenBytes gives a 32 character token, too long to guess and short enough for a link.
+	inviteTokenBytes = 24
+)
+
+// InviteSettings are the limits of an invite link, a nil ExpiresAt and zero MaxUses mean no limit.
+type InviteSettings struct {
+	ExpiresAt        *time.Time
+	MaxUses          int
+	RequiresApproval bool
+}
+
+// NewInviteSettings checks the requested lifetime and use count, ttl zero keeps the link open until revoked.
+func NewInviteSettings(ttl time.Duration, maxUses int, requiresApproval bool, now time.Time) (InviteSettings, error) {
+	if ttl < 0 || ttl > MaxInviteTTL {
+		return InviteSettings{}, fmt.Errorf("ttl out of range: %w", chatErrors.ErrInvalidInvite)
+	}
+	if maxUses < 0 || maxUses > MaxInviteUses {
+		return InviteSettings{}, fmt.Errorf("max uses out of range: %w", chatErrors.ErrInvalidInvite)
+	}
+
+	settings := InviteSettings{MaxUses: maxUses, RequiresApproval: requiresApproval}
+	if ttl > 0 {
+		expiresAt := now.Add(ttl).UTC()
+		settings.ExpiresAt = &expiresAt
+	}
+
+	return settings, nil
+}
+
+// NewInviteToken returns a random url safe token, it carries no data and is only looked up.
+func NewInviteToken() (string, error) {
+	buf := make([]byte, inviteTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("invite token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending  JoinRequestStatus = "pending"
+	JoinRequestApproved JoinRequestStatus = "approved"
+	JoinRequestRejected JoinRequestStatus = "rejected"
+)
+
+func (s JoinRequestStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,68 @@
+package vo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+func TestNewInviteSettings(t *testing.T) {
+	now := time.Date(2025, 9, 14, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		ttl       time.Duration
+		maxUses   int
+		expected  error
+		expiresAt *time.Time
+	}{
+		{"No limits", 0, 0, nil, nil},
+		{"One day", 24 * time.Hour, 10, nil, ptr(now.Add(24 * time.Hour))},
+		{"Longest", MaxInviteTTL, MaxInviteUses, nil, ptr(now.Add(MaxInviteTTL))},
+		{"Negative ttl", -time.Second, 0, chatErrors.ErrInvalidInvite, nil},
+		{"Too long", MaxInviteTTL + time.Second, 0, chatErrors.ErrInvalidInvite, nil},
+		{"Negative uses", 0, -1, chatErrors.ErrInvalidInvite, nil},
+		{"Too many uses", 0, MaxInviteUses + 1, chatErrors.ErrInvalidInvite, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := NewInviteSettings(tt.ttl, tt.maxUses, false, now)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if err != nil {
+				return
+			}
+			switch {
+			case tt.expiresAt == nil && settings.ExpiresAt != nil:
+				t.Fatalf("expected no expiry, got %v", *settings.ExpiresAt)
+			case tt.expiresAt != nil && (settings.ExpiresAt == nil || !settings.ExpiresAt.Equal(*tt.expiresAt)):
+				t.Fatalf("expected expiry %v, got %v", *tt.expiresAt, settings.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestNewInviteToken(t *testing.T) {
+	seen := make(map[string]struct{})
+	for range 100 {
+		token, err := NewInviteToken()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(token) != 32 {
+			t.Fatalf("expected 32 characters, got %d", len(token))
+		}
+		if _, ok := seen[token]; ok {
+			t.Fatalf("duplicate token %q", token)
+		}
+		seen[token] = struct{}{}
+	}
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,24 @@
+package vo
+
+import chatErrors "awesome-chat/internal/domain/core/chat/errors"
+
+// MentionPolicy decides what happens to a message mentioning users outside the chat.
+type MentionPolicy string
+
+const (
+	MentionPolicyIgnore MentionPolicy = "ignore"
+	MentionPolicyReject MentionPolicy = "reject"
+)
+
+func (p MentionPolicy) String() string {
+	return string(p)
+}
+
+func ParseMentionPolicy(raw string) (MentionPolicy, error) {
+	switch p := MentionPolicy(raw); p {
+	case MentionPolicyIgnore, MentionPolicyReject:
+		return p, nil
+	default:
+		return "", chatErrors.ErrInvalidMentionPolicy
+	}
+}
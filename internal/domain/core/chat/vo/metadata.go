@@ -0,0 +1,65 @@
+package vo
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+const (
+	MaxChatNameLength    = 128
+	MaxDescriptionLength = 1024
+	// MaxAvatarBytes stays under the 4 MB request body limit of the http server.
+	MaxAvatarBytes = 3 << 20
+)
+
+// ChatInfoUpdate carries the text settings a member may change, nil fields stay as they are.
+type ChatInfoUpdate struct {
+	Name        *string
+	Description *string
+}
+
+func (u ChatInfoUpdate) IsEmpty() bool {
+	return u.Name == nil && u.Description == nil
+}
+
+// NewChatInfoUpdate trims both fields, an empty description clears it while a name is required.
+func NewChatInfoUpdate(name, description *string) (ChatInfoUpdate, error) {
+	var update ChatInfoUpdate
+
+	if name != nil {
+		trimmed := strings.TrimSpace(*name)
+		switch {
+		case trimmed == "":
+			return ChatInfoUpdate{}, chatErrors.ErrChatShortName
+		case utf8.RuneCountInString(trimmed) > MaxChatNameLength:
+			return ChatInfoUpdate{}, chatErrors.ErrChatLongName
+		}
+		update.Name = &trimmed
+	}
+
+	if description != nil {
+		trimmed := strings.TrimSpace(*description)
+		if utf8.RuneCountInString(trimmed) > MaxDescriptionLength {
+			return ChatInfoUpdate{}, chatErrors.ErrDescriptionTooLong
+		}
+		update.Description = &trimmed
+	}
+
+	if update.IsEmpty() {
+		return ChatInfoUpdate{}, chatErrors.ErrNothingToUpdate
+	}
+
+	return update, nil
+}
+
+// Avatar holds the object keys of the chat picture, the zero value means the chat has none.
+type Avatar struct {
+	Key      string
+	ThumbKey string
+}
+
+func (a Avatar) IsZero() bool {
+	return a.Key == ""
+}
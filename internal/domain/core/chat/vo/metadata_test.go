@@ -0,0 +1,49 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+func TestNewChatInfoUpdate(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name        string
+		chatName    *string
+		description *string
+		expected    error
+		wantName    string
+		wantDesc    string
+	}{
+		{"Rename", str("  Team  "), nil, nil, "Team", ""},
+		{"Describe", nil, str(" about us "), nil, "", "about us"},
+		{"Clear description", nil, str("   "), nil, "", ""},
+		{"Longest name", str(strings.Repeat("ä", MaxChatNameLength)), nil, nil, strings.Repeat("ä", MaxChatNameLength), ""},
+		{"Blank name", str("  "), nil, chatErrors.ErrChatShortName, "", ""},
+		{"Long name", str(strings.Repeat("a", MaxChatNameLength+1)), nil, chatErrors.ErrChatLongName, "", ""},
+		{"Long description", nil, str(strings.Repeat("a", MaxDescriptionLength+1)), chatErrors.ErrDescriptionTooLong, "", ""},
+		{"Nothing", nil, nil, chatErrors.ErrNothingToUpdate, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update, err := NewChatInfoUpdate(tt.chatName, tt.description)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if err != nil {
+				return
+			}
+			if update.Name != nil && *update.Name != tt.wantName {
+				t.Fatalf("expected name %q, got %q", tt.wantName, *update.Name)
+			}
+			if update.Description != nil && *update.Description != tt.wantDesc {
+				t.Fatalf("expected description %q, got %q", tt.wantDesc, *update.Description)
+			}
+		})
+	}
+}
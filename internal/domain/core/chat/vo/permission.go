@@ -0,0 +1,19 @@
+package vo
+
+type Permission string
+
+const (
+	PermissionSendMessages     Permission = "send_messages"
+	PermissionAddMembers       Permission = "add_members"
+	PermissionRemoveMembers    Permission = "remove_members"
+	PermissionRenameChat       Permission = "rename_chat"
+	PermissionPinMessages      Permission = "pin_messages"
+	PermissionDeleteMessages   Permission = "delete_messages" // messages of other members
+	PermissionManageRoles      Permission = "manage_roles"
+	PermissionSetMessageTTL    Permission = "set_message_ttl"
+	PermissionSetMentionPolicy Permission = "set_mention_policy"
+)
+
+func (p Permission) String() string {
+	return string(p)
+}
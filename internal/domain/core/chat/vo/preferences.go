@@ -0,0 +1,47 @@
+package vo
+
+import (
+	"time"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+// MuteForever is stored for chats muted without an end.
+var MuteForever = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// PreferencesUpdate carries the member settings to change, nil fields stay as they are.
+// A zero MutedUntil unmutes and a zero SortOrder hands the chat back to ordering by activity.
+type PreferencesUpdate struct {
+	MutedUntil *time.Time
+	Archived   *bool
+	Pinned     *bool
+	SortOrder  *int
+}
+
+func (u PreferencesUpdate) IsEmpty() bool {
+	return u.MutedUntil == nil && u.Archived == nil && u.Pinned == nil && u.SortOrder == nil
+}
+
+// ParseMutedUntil reads an RFC3339 time, "forever" or "" to unmute.
+func ParseMutedUntil(raw string, now time.Time) (time.Time, error) {
+	switch raw {
+	case "":
+		return time.Time{}, nil
+	case "forever":
+		return MuteForever, nil
+	}
+
+	until, err := time.Parse(time.RFC3339, raw)
+	if err != nil || !until.After(now) {
+		return time.Time{}, chatErrors.ErrInvalidMute
+	}
+
+	return until.UTC(), nil
+}
+
+func ValidateSortOrder(order int) error {
+	if order < 0 {
+		return chatErrors.ErrInvalidSortOrder
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package vo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+func TestParseMutedUntil(t *testing.T) {
+	now := time.Date(2025, 9, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		raw      string
+		expected error
+		want     time.Time
+	}{
+		{"", nil, time.Time{}},
+		{"forever", nil, MuteForever},
+		{"2025-09-18T13:00:00Z", nil, now.Add(time.Hour)},
+		{"2025-09-18T15:00:00+02:00", nil, now.Add(time.Hour)},
+		{"2025-09-18T12:00:00Z", chatErrors.ErrInvalidMute, time.Time{}},
+		{"2025-09-18T11:00:00Z", chatErrors.ErrInvalidMute, time.Time{}},
+		{"tomorrow", chatErrors.ErrInvalidMute, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := ParseMutedUntil(tt.raw, now)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package vo
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+const (
+	DefaultPreviewPageSize = 50
+	MaxPreviewPageSize     = 100
+	// PreviewParticipants caps the members listed with a preview besides the viewer, enough for an avatar stack.
+	PreviewParticipants = 3
+)
+
+// PreviewCursor is the position of a chat in the preview order: pinned chats first by their sort order,
+// then every chat by its last activity, the chat id breaks ties.
+type PreviewCursor struct {
+	Pinned bool
+	// SortOrder is 0 while unset, an unpinned chat keeps the order it had as a pinned one but it is ignored.
+	SortOrder int
+	ActiveAt  time.Time
+	ChatID    uuid.UUID
+}
+
+func (c PreviewCursor) IsZero() bool {
+	return c.ChatID == uuid.Nil
+}
+
+// Encode returns an opaque representation of the cursor safe to pass in urls.
+func (c PreviewCursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strings.Join([]string{
+		strconv.FormatBool(c.Pinned),
+		strconv.Itoa(c.SortOrder),
+		strconv.FormatInt(c.ActiveAt.UnixNano(), 10),
+		c.ChatID.String(),
+	}, ":")
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParsePreviewCursor(s string) (PreviewCursor, error) {
+	if s == "" {
+		return PreviewCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PreviewCursor{}, fmt.Errorf("%w: %w", chatErrors.ErrInvalidPreviewCursor, err)
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 4 {
+		return PreviewCursor{}, chatErrors.ErrInvalidPreviewCursor
+	}
+
+	pinned, err := strconv.ParseBool(parts[0])
+	if err != nil {
+		return PreviewCursor{}, fmt.Errorf("%w: %w", chatErrors.ErrInvalidPreviewCursor, err)
+	}
+	sortOrder, err := strconv.Atoi(parts[1])
+	if err != nil || sortOrder < 0 {
+		return PreviewCursor{}, chatErrors.ErrInvalidPreviewCursor
+	}
+	activeAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return PreviewCursor{}, fmt.Errorf("%w: %w", chatErrors.ErrInvalidPreviewCursor, err)
+	}
+	chatID, err := uuid.Parse(parts[3])
+	if err != nil || chatID == uuid.Nil {
+		return PreviewCursor{}, chatErrors.ErrInvalidPreviewCursor
+	}
+
+	return PreviewCursor{
+		Pinned:    pinned,
+		SortOrder: sortOrder,
+		ActiveAt:  time.Unix(0, activeAt).UTC(),
+		ChatID:    chatID,
+	}, nil
+}
+
+// PreviewPage is the window of the preview list after a cursor. The cursor is a position in the order,
+// a chat moving up meanwhile is missed by the later pages instead of shifting them, clients learn of it by its event.
+type PreviewPage struct {
+	After PreviewCursor
+	Limit int
+}
+
+func NewPreviewPage(cursor string, limit int) (PreviewPage, error) {
+	after, err := ParsePreviewCursor(cursor)
+	if err != nil {
+		return PreviewPage{}, err
+	}
+
+	p := PreviewPage{After: after, Limit: limit}
+	switch {
+	case p.Limit <= 0:
+		p.Limit = DefaultPreviewPageSize
+	case p.Limit > MaxPreviewPageSize:
+		p.Limit = MaxPreviewPageSize
+	}
+
+	return p, nil
+}
@@ -0,0 +1,54 @@
+package vo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+)
+
+func TestNewPreviewPage(t *testing.T) {
+	after := PreviewCursor{
+		Pinned:    true,
+		SortOrder: 3,
+		ActiveAt:  time.Date(2025, 10, 1, 12, 30, 0, 123456000, time.UTC),
+		ChatID:    uuid.MustParse("5f0b5c3e-8a8f-4b1e-9d55-0a3f4c2b7e11"),
+	}
+
+	tests := []struct {
+		name        string
+		cursor      string
+		limit       int
+		expected    PreviewPage
+		expectedErr error
+	}{
+		{"First page", "", 20, PreviewPage{Limit: 20}, nil},
+		{"Default limit", "", 0, PreviewPage{Limit: DefaultPreviewPageSize}, nil},
+		{"Capped limit", "", 1000, PreviewPage{Limit: MaxPreviewPageSize}, nil},
+		{"Next page", after.Encode(), 20, PreviewPage{After: after, Limit: 20}, nil},
+		{"Not base64", "***", 20, PreviewPage{}, chatErrors.ErrInvalidPreviewCursor},
+		{"Not a position", "YWJj", 20, PreviewPage{}, chatErrors.ErrInvalidPreviewCursor},
+		{"No chat", "dHJ1ZTowOjE6", 20, PreviewPage{}, chatErrors.ErrInvalidPreviewCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := NewPreviewPage(tt.cursor, tt.limit)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected %v, got %v", tt.expectedErr, err)
+			}
+			if page != tt.expected {
+				t.Fatalf("expected %+v, got %+v", tt.expected, page)
+			}
+		})
+	}
+}
+
+func TestPreviewCursor_Encode(t *testing.T) {
+	if encoded := (PreviewCursor{}).Encode(); encoded != "" {
+		t.Fatalf("expected no cursor after the last page, got %q", encoded)
+	}
+}
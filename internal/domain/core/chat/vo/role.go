@@ -0,0 +1,81 @@
+package vo
+
+import chatErrors "awesome-chat/internal/domain/core/chat/errors"
+
+// Role is what a member may do in the chat, read-only members of announcement chats can not post.
+type Role string
+
+const (
+	RoleOwner    Role = "owner"
+	RoleAdmin    Role = "admin"
+	RoleMember   Role = "member"
+	RoleReadOnly Role = "read_only"
+)
+
+var rolePermissions = map[Role]map[Permission]struct{}{
+	RoleOwner: {
+		PermissionSendMessages:     {},
+		PermissionAddMembers:       {},
+		PermissionRemoveMembers:    {},
+		PermissionRenameChat:       {},
+		PermissionPinMessages:      {},
+		PermissionDeleteMessages:   {},
+		PermissionManageRoles:      {},
+		PermissionSetMessageTTL:    {},
+		PermissionSetMentionPolicy: {},
+	},
+	RoleAdmin: {
+		PermissionSendMessages:     {},
+		PermissionAddMembers:       {},
+		PermissionRemoveMembers:    {},
+		PermissionRenameChat:       {},
+		PermissionPinMessages:      {},
+		PermissionDeleteMessages:   {},
+		PermissionManageRoles:      {},
+		PermissionSetMessageTTL:    {},
+		PermissionSetMentionPolicy: {},
+	},
+	RoleMember: {
+		PermissionSendMessages: {},
+	},
+	RoleReadOnly: {},
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// ParseRole accepts the roles a member can be given, the owner only changes by transfer.
+func ParseRole(raw string) (Role, error) {
+	switch r := Role(raw); r {
+	case RoleAdmin, RoleMember, RoleReadOnly:
+		return r, nil
+	case RoleOwner:
+		return "", chatErrors.ErrRoleNotAssignable
+	default:
+		return "", chatErrors.ErrInvalidRole
+	}
+}
+
+func (r Role) Can(p Permission) bool {
+	_, ok := rolePermissions[r][p]
+	return ok
+}
+
+func (r Role) rank() int {
+	switch r {
+	case RoleOwner:
+		return 3
+	case RoleAdmin:
+		return 2
+	case RoleMember:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Outranks tells if r may act on a member holding other, nobody acts on an equal.
+func (r Role) Outranks(other Role) bool {
+	return r.rank() > other.rank()
+}
@@ -0,0 +1,53 @@
+package vo
+
+import "github.com/google/uuid"
+
+// SystemKind names the change a system message reports, clients pick the rendering by it.
+type SystemKind string
+
+const (
+	SystemChatCreated        SystemKind = "chat_created"
+	SystemMemberAdded        SystemKind = "member_added"
+	SystemMemberJoined       SystemKind = "member_joined"
+	SystemMemberLeft         SystemKind = "member_left"
+	SystemMemberRemoved      SystemKind = "member_removed"
+	SystemChatRenamed        SystemKind = "chat_renamed"
+	SystemDescriptionChanged SystemKind = "description_changed"
+	SystemAvatarChanged      SystemKind = "avatar_changed"
+	SystemAvatarRemoved      SystemKind = "avatar_removed"
+	SystemMessagePinned      SystemKind = "message_pinned"
+	SystemMessageUnpinned    SystemKind = "message_unpinned"
+)
+
+func (k SystemKind) String() string {
+	return string(k)
+}
+
+// SystemPayload is stored with a system message as is, usernames are left out so renders follow renames.
+// TargetID is the member acted on, MessageID the pinned message and Name the new chat name.
+type SystemPayload struct {
+	Kind      SystemKind `json:"kind"`
+	ActorID   uuid.UUID  `json:"actor_id"`
+	TargetID  *uuid.UUID `json:"target_id,omitempty"`
+	MessageID int        `json:"message_id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+}
+
+func NewSystemPayload(kind SystemKind, actorID uuid.UUID) SystemPayload {
+	return SystemPayload{Kind: kind, ActorID: actorID}
+}
+
+func (p SystemPayload) WithTarget(targetID uuid.UUID) SystemPayload {
+	p.TargetID = &targetID
+	return p
+}
+
+func (p SystemPayload) WithMessage(messageID int) SystemPayload {
+	p.MessageID = messageID
+	return p
+}
+
+func (p SystemPayload) WithName(name string) SystemPayload {
+	p.Name = name
+	return p
+}
@@ -0,0 +1,52 @@
+package vo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSystemPayload_JSON(t *testing.T) {
+	actor := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	target := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	tests := []struct {
+		name     string
+		payload  SystemPayload
+		expected string
+	}{
+		{
+			"Actor only",
+			NewSystemPayload(SystemMemberLeft, actor),
+			`{"kind":"member_left","actor_id":"11111111-1111-1111-1111-111111111111"}`,
+		},
+		{
+			"Target",
+			NewSystemPayload(SystemMemberRemoved, actor).WithTarget(target),
+			`{"kind":"member_removed","actor_id":"11111111-1111-1111-1111-111111111111","target_id":"22222222-2222-2222-2222-222222222222"}`,
+		},
+		{
+			"Pinned message",
+			NewSystemPayload(SystemMessagePinned, actor).WithMessage(42),
+			`{"kind":"message_pinned","actor_id":"11111111-1111-1111-1111-111111111111","message_id":42}`,
+		},
+		{
+			"Rename",
+			NewSystemPayload(SystemChatRenamed, actor).WithName("Team"),
+			`{"kind":"chat_renamed","actor_id":"11111111-1111-1111-1111-111111111111","name":"Team"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
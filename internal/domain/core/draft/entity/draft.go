@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Draft is the unsent text of a user in a chat. An empty content is a cleared draft,
+// it is kept with its version so that an older write cannot bring the text back.
+type Draft struct {
+	UserID    uuid.UUID
+	ChatID    uuid.UUID
+	Content   string
+	Version   int64
+	UpdatedAt time.Time
+}
+
+func (d Draft) IsEmpty() bool {
+	return d.Content == ""
+}
@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrDraftNotFound = errors.New("draft not found")
+	ErrDraftTooLong  = errors.New("draft is too long")
+)
@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"awesome-chat/internal/domain/core/draft/entity"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cache is the hot copy of drafts. Every write gets a version above the current one,
+// so the last writer wins no matter which device it came from.
+type Cache interface {
+	Save(ctx context.Context, userID, chatID uuid.UUID, content string) (entity.Draft, error)
+	// Get returns ErrDraftNotFound when the draft is not cached, a cleared draft is returned as is.
+	Get(ctx context.Context, userID, chatID uuid.UUID) (entity.Draft, error)
+	// GetMany returns the cached drafts of the chats, cleared ones included, chats without one are left out.
+	GetMany(ctx context.Context, userID uuid.UUID, chatIDs []uuid.UUID) (map[uuid.UUID]entity.Draft, error)
+	// Clear empties the draft unless it was edited after sentAt, ok is false when nothing changed.
+	// It returns ErrDraftNotFound when the draft is not cached, the store may still have it.
+	Clear(ctx context.Context, userID, chatID uuid.UUID, sentAt time.Time) (draft entity.Draft, ok bool, err error)
+}
+
+// FlushQueue hands out drafts which were saved to the cache but not yet to the store.
+type FlushQueue interface {
+	Dirty(ctx context.Context, dirtySince time.Time, limit int) ([]entity.Draft, error)
+	// Ack marks drafts as flushed, a draft saved again since Dirty stays in the queue.
+	Ack(ctx context.Context, drafts []entity.Draft) error
+}
+
+type Store interface {
+	Get(ctx context.Context, userID, chatID uuid.UUID) (entity.Draft, error)
+	// Upsert keeps the newest version of every draft and deletes cleared ones.
+	Upsert(ctx context.Context, drafts []entity.Draft) error
+	// ClearBefore deletes the draft unless it was edited after sentAt, ok is false when nothing was deleted.
+	ClearBefore(ctx context.Context, userID, chatID uuid.UUID, sentAt time.Time) (version int64, ok bool, err error)
+}
@@ -0,0 +1,10 @@
+package usecases
+
+import (
+	"awesome-chat/internal/application/draft/dto"
+	"context"
+)
+
+type DraftSave interface {
+	Execute(ctx context.Context, req dto.SaveDraftRequest) (dto.Draft, error)
+}
@@ -0,0 +1,30 @@
+package vo
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	draftErrors "awesome-chat/internal/domain/core/draft/errors"
+)
+
+const (
+	MaxContentLength = 4096
+
+	// FlushDelay is how long a draft stays dirty in the cache before it is written to postgres,
+	// every save within the window is coalesced into one write.
+	FlushDelay = 2 * time.Second
+	// CacheTTL keeps drafts of inactive chats out of redis, postgres still has them.
+	CacheTTL = 24 * time.Hour
+)
+
+// NormalizeContent turns a whitespace-only draft into a cleared one.
+func NormalizeContent(raw string) (string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return "", nil
+	}
+	if utf8.RuneCountInString(raw) > MaxContentLength {
+		return "", draftErrors.ErrDraftTooLong
+	}
+	return raw, nil
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Chat is the header of an archive.
+type Chat struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	Description string    `json:"description,omitempty"`
+	// ExportedUntil is the request time, later messages are not in the archive.
+	ExportedUntil time.Time `json:"exported_until"`
+}
+
+type Participant struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// Message is a message as archived, files are referenced by object key and never copied.
+type Message struct {
+	ID          int             `json:"id"`
+	Type        string          `json:"type"`
+	SenderID    uuid.UUID       `json:"sender_id"`
+	SenderName  string          `json:"sender_name"`
+	Content     string          `json:"content"`
+	CreatedAt   time.Time       `json:"created_at"`
+	ReplyToID   *int            `json:"reply_to_id,omitempty"`
+	Voice       *VoiceRef       `json:"voice,omitempty"`
+	Attachments []AttachmentRef `json:"attachments,omitempty"`
+}
+
+type AttachmentRef struct {
+	ID        uuid.UUID `json:"id"`
+	Kind      string    `json:"kind"`
+	FileName  string    `json:"file_name"`
+	MimeType  string    `json:"mime_type"`
+	SizeBytes int64     `json:"size_bytes"`
+	ObjectKey string    `json:"object_key"`
+}
+
+type VoiceRef struct {
+	ObjectKey string `json:"object_key"`
+	Duration  int    `json:"duration"`
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"awesome-chat/internal/domain/core/export/vo"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Export struct {
+	ID           uuid.UUID
+	ChatID       uuid.UUID
+	UserID       uuid.UUID
+	Status       vo.Status
+	RequestedAt  time.Time
+	Attempts     int
+	ObjectKey    string
+	SizeBytes    int64
+	MessageCount int
+	Error        string
+	FinishedAt   *time.Time
+}
+
+// ObjectKeyFor keeps exports of a chat under one prefix of the exports bucket.
+func ObjectKeyFor(chatID, exportID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s.zip", chatID, exportID)
+}
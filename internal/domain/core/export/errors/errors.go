@@ -0,0 +1,9 @@
+package errors
+
+import "errors"
+
+var (
+	ErrExportNotFound   = errors.New("export not found")
+	ErrExportInProgress = errors.New("an export of this chat is already in progress")
+	ErrNoPendingExports = errors.New("no exports waiting to run")
+)
@@ -0,0 +1,53 @@
+package ports
+
+import (
+	"awesome-chat/internal/domain/core/export/entity"
+	"awesome-chat/internal/domain/core/export/vo"
+	msgVo "awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Store interface {
+	// Create queues an export, a second one of the same chat and user fails while the first is unfinished.
+	Create(ctx context.Context, export entity.Export) error
+	Get(ctx context.Context, id uuid.UUID) (entity.Export, error)
+}
+
+type JobStore interface {
+	// ClaimPending hands out the oldest queued export that is due and counts the attempt, a running
+	// export is handed out again once its claim is older than the claim timeout.
+	ClaimPending(ctx context.Context) (entity.Export, error)
+	MarkReady(ctx context.Context, export entity.Export) error
+	// MarkFailed puts the export back in the queue after a growing delay, or gives it up once it has used maxAttempts.
+	MarkFailed(ctx context.Context, id uuid.UUID, maxAttempts int, reason string) (vo.Status, error)
+}
+
+// SourceStore reads what goes into an archive page by page, oldest first.
+type SourceStore interface {
+	Chat(ctx context.Context, chatID uuid.UUID) (entity.Chat, error)
+	ParticipantsAfter(ctx context.Context, chatID, afterUserID uuid.UUID, limit int) ([]entity.Participant, error)
+	// MessagesAfter skips expired messages and the ones sent after until.
+	MessagesAfter(
+		ctx context.Context,
+		chatID uuid.UUID,
+		until time.Time,
+		after msgVo.Cursor,
+		limit int,
+	) ([]entity.Message, error)
+}
+
+// Source feeds an archive. The walks may be repeated and never hold more than a page.
+type Source interface {
+	Chat() entity.Chat
+	EachParticipant(ctx context.Context, fn func(entity.Participant) error) error
+	EachMessage(ctx context.Context, fn func(entity.Message) error) error
+}
+
+type Archiver interface {
+	// Write streams the archive of src into w and reports how many messages it holds.
+	Write(ctx context.Context, w io.Writer, src Source) (messages int, err error)
+}
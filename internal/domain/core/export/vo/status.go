@@ -0,0 +1,19 @@
+package vo
+
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusRunning Status = "running"
+	StatusReady   Status = "ready"
+	StatusFailed  Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// IsFinished reports whether the export will not change anymore.
+func (s Status) IsFinished() bool {
+	return s == StatusReady || s == StatusFailed
+}
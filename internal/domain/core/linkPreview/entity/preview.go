@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"awesome-chat/internal/domain/core/linkPreview/vo"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LinkPreview struct {
+	MessageID int       `json:"message_id"`
+	ChatID    uuid.UUID `json:"chat_id"`
+	Status    vo.Status `json:"status"`
+	FetchedAt time.Time `json:"fetched_at,omitempty"`
+	vo.Metadata
+}
+
+// Job is a saved message with a link that has not been unfurled yet.
+type Job struct {
+	MessageID int
+	ChatID    uuid.UUID
+	Content   string
+}
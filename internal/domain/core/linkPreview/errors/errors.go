@@ -0,0 +1,11 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInvalidURL         = errors.New("invalid url, absolute http(s) url expected")
+	ErrBlockedAddress     = errors.New("url resolves to a blocked address")
+	ErrUnexpectedStatus   = errors.New("unexpected response status")
+	ErrUnsupportedContent = errors.New("unsupported content type, html expected")
+	ErrNoMetadata         = errors.New("page has no preview metadata")
+)
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"awesome-chat/internal/domain/core/linkPreview/entity"
+	"awesome-chat/internal/domain/core/linkPreview/vo"
+	"context"
+)
+
+type Unfurler interface {
+	Unfurl(ctx context.Context, rawURL string) (vo.Metadata, error)
+}
+
+type ClaimStore interface {
+	// ClaimPending picks recently saved messages with links, claimed messages are not handed out
+	// again unless they stay unfinished for too long.
+	ClaimPending(ctx context.Context, limit int) ([]entity.Job, error)
+}
+
+type SaveStore interface {
+	Save(ctx context.Context, preview entity.LinkPreview) error
+}
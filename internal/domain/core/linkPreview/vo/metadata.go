@@ -0,0 +1,14 @@
+package vo
+
+// Metadata is what a page tells about itself through OpenGraph, Twitter card and plain html tags.
+type Metadata struct {
+	URL         string `json:"url"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
+func (m Metadata) IsEmpty() bool {
+	return m.Title == "" && m.Description == ""
+}
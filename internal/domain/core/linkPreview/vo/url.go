@@ -0,0 +1,25 @@
+package vo
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var urlPattern = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"'` + "`" + `]+`)
+
+// ExtractURL returns the first http(s) link of the message content, only one preview is shown per message.
+func ExtractURL(content string) (string, bool) {
+	for _, candidate := range urlPattern.FindAllString(content, -1) {
+		// punctuation right after a link belongs to the sentence, not to the link
+		candidate = strings.TrimRight(candidate, ".,!?;:)]}'\"")
+
+		u, err := url.Parse(candidate)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		return u.String(), true
+	}
+
+	return "", false
+}
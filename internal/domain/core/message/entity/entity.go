@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"encoding/json"
+
+	linkPreviewVo "awesome-chat/internal/domain/core/linkPreview/vo"
+	"awesome-chat/internal/domain/core/message/vo"
 	"github.com/google/uuid"
 	"time"
 )
@@ -21,8 +25,193 @@ type OldMessage struct {
 }
 
 type MessageForPreview struct {
+	ID            int                     `json:"id"`
+	Type          string                  `json:"type"`
+	SenderID      uuid.UUID               `json:"sender_id"`
+	Text          string                  `json:"text"`
+	Format        vo.Format               `json:"format"`
+	Entities      []vo.Entity             `json:"entities,omitempty"`
+	Timestamp     time.Time               `json:"timestamp"`
+	LinkPreview   *linkPreviewVo.Metadata `json:"link_preview,omitempty"`
+	ForwardedFrom *vo.ForwardOrigin       `json:"forwarded_from,omitempty"`
+	// System is the stored payload of a system message, passed to clients as is.
+	System json.RawMessage `json:"system,omitempty"`
+}
+
+type SearchHit struct {
 	ID        int       `json:"id"`
+	ChatID    uuid.UUID `json:"chat_id"`
 	SenderID  uuid.UUID `json:"sender_id"`
 	Text      string    `json:"text"`
+	Snippet   string    `json:"snippet"`
+	Rank      float32   `json:"rank"`
 	Timestamp time.Time `json:"timestamp"`
 }
+
+type ScheduledMessage struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	ChatID    uuid.UUID          `json:"chat_id"`
+	Content   string             `json:"content"`
+	SendAt    time.Time          `json:"send_at"`
+	Status    vo.ScheduledStatus `json:"status"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// ExpiredMessage lists what has to go together with the message row.
+type ExpiredMessage struct {
+	ID             int       `json:"id"`
+	ChatID         uuid.UUID `json:"chat_id"`
+	AttachmentKeys []string  `json:"attachment_keys"`
+	VoiceKeys      []string  `json:"voice_keys"`
+}
+
+// ForwardSource is a message about to be copied into other chats.
+type ForwardSource struct {
+	ID        int              `json:"id"`
+	Content   string           `json:"content"`
+	Format    vo.Format        `json:"format"`
+	Entities  []vo.Entity      `json:"entities,omitempty"`
+	ExpiresAt *time.Time       `json:"expires_at,omitempty"`
+	Origin    vo.ForwardOrigin `json:"origin"`
+}
+
+// Mention is a message in the mentions feed of a user.
+type Mention struct {
+	MessageID int        `json:"message_id"`
+	ChatID    uuid.UUID  `json:"chat_id"`
+	SenderID  uuid.UUID  `json:"sender_id"`
+	Content   string     `json:"content"`
+	IsAll     bool       `json:"is_all"`
+	Timestamp time.Time  `json:"timestamp"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+}
+
+// MentionNotice is a mention the mentioned user has not been notified about yet.
+type MentionNotice struct {
+	Mention
+	UserID uuid.UUID `json:"user_id"`
+	// Muted is set while the mentioned user has the chat muted.
+	Muted bool `json:"muted"`
+}
+
+// Poll is a poll message with its tally as seen by Viewer.
+type Poll struct {
+	MessageID      int          `json:"message_id"`
+	ChatID         uuid.UUID    `json:"chat_id"`
+	AuthorID       uuid.UUID    `json:"author_id"`
+	Question       string       `json:"question"`
+	Options        []PollOption `json:"options"`
+	MultipleChoice bool         `json:"multiple_choice"`
+	Anonymous      bool         `json:"anonymous"`
+	ClosesAt       *time.Time   `json:"closes_at,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	TotalVoters    int          `json:"total_voters"`
+	// MyVote holds the option positions the viewer voted for.
+	MyVote []int `json:"my_vote"`
+}
+
+type PollOption struct {
+	Position int    `json:"position"`
+	Text     string `json:"text"`
+	Votes    int    `json:"votes"`
+	// Voters stays empty for anonymous polls.
+	Voters []uuid.UUID `json:"voters,omitempty"`
+}
+
+func (p Poll) IsClosed(now time.Time) bool {
+	return p.ClosesAt != nil && !now.Before(*p.ClosesAt)
+}
+
+// MessagePage lists messages newest first, the flags tell whether paging further returns anything.
+type MessagePage struct {
+	Messages []MessageForPreview `json:"messages"`
+	HasOlder bool                `json:"has_older"`
+	HasNewer bool                `json:"has_newer"`
+}
+
+// ReceiptAdvance is a member moving their receipt cursors in a chat.
+type ReceiptAdvance struct {
+	Prev    vo.ReceiptCursors `json:"prev"`
+	Current vo.ReceiptCursors `json:"current"`
+	// Members counts the whole chat, the member included.
+	Members int `json:"members"`
+}
+
+func (a ReceiptAdvance) Moved() bool {
+	return !sameTime(a.Prev.DeliveredUntil, a.Current.DeliveredUntil) || !sameTime(a.Prev.ReadUntil, a.Current.ReadUntil)
+}
+
+// Since is the send time after which messages may have changed their state.
+// A cursor set for the first time covers the whole history, reported as the zero time.
+func (a ReceiptAdvance) Since() time.Time {
+	var since *time.Time
+	for _, c := range [][2]*time.Time{
+		{a.Prev.DeliveredUntil, a.Current.DeliveredUntil},
+		{a.Prev.ReadUntil, a.Current.ReadUntil},
+	} {
+		if sameTime(c[0], c[1]) {
+			continue
+		}
+		if c[0] == nil {
+			return time.Time{}
+		}
+		if since == nil || c[0].Before(*since) {
+			since = c[0]
+		}
+	}
+	if since == nil {
+		return time.Time{}
+	}
+	return *since
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// MessageReceipts is what the sender sees of one message.
+type MessageReceipts struct {
+	MessageID int       `json:"message_id"`
+	ChatID    uuid.UUID `json:"chat_id"`
+	SenderID  uuid.UUID `json:"sender_id"`
+	Timestamp time.Time `json:"timestamp"`
+	// Members counts the whole chat, the sender included.
+	Members   int `json:"members"`
+	Delivered int `json:"delivered"`
+	Read      int `json:"read"`
+}
+
+// RecipientCursors are the receipt cursors of one member of a chat.
+type RecipientCursors struct {
+	UserID  uuid.UUID         `json:"user_id"`
+	Cursors vo.ReceiptCursors `json:"cursors"`
+}
+
+// ReceiptQueueEntry is a chat whose counts must be redone for messages sent after Since.
+type ReceiptQueueEntry struct {
+	ChatID uuid.UUID `json:"chat_id"`
+	Since  time.Time `json:"since"`
+}
+
+// ReceiptCount is the stored aggregate of one message in a chat above the receipt threshold.
+type ReceiptCount struct {
+	MessageID int       `json:"message_id"`
+	SenderID  uuid.UUID `json:"sender_id"`
+	Timestamp time.Time `json:"timestamp"`
+	Delivered int       `json:"delivered"`
+	Read      int       `json:"read"`
+}
+
+// ReceiptRecount is one pass over the messages of a queued chat.
+type ReceiptRecount struct {
+	Members int            `json:"members"`
+	Changed []ReceiptCount `json:"changed"`
+	// Scanned and Last tell how far the pass got, a full pass leaves the rest for the next one.
+	Scanned int       `json:"scanned"`
+	Last    time.Time `json:"last"`
+}
@@ -0,0 +1,9 @@
+package errors
+
+import "errors"
+
+var (
+	ErrInvalidFormat   = errors.New("message format must be plain or markdown")
+	ErrTooManyEntities = errors.New("message has too many formatting entities")
+	ErrEmptyFormatted  = errors.New("message has no text left after formatting")
+)
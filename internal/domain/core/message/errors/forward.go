@@ -0,0 +1,10 @@
+package errors
+
+import "errors"
+
+var (
+	ErrNothingToForward     = errors.New("no messages to forward")
+	ErrTooManyForwards      = errors.New("too many messages to forward")
+	ErrTooManyForwardTarget = errors.New("too many target chats")
+	ErrForwardSourceMissing = errors.New("some messages to forward were not found in the source chat")
+)
@@ -0,0 +1,7 @@
+package errors
+
+import "errors"
+
+var (
+	ErrMentionNotMember = errors.New("mentioned users are not chat members")
+)
@@ -0,0 +1,8 @@
+package errors
+
+import "errors"
+
+var (
+	ErrConflictingPageCursor = errors.New("only one of before, after and around can be set")
+	ErrMessageNotFound       = errors.New("message not found")
+)
@@ -0,0 +1,15 @@
+package errors
+
+import "errors"
+
+var (
+	ErrPollQuestionEmpty = errors.New("poll question is empty")
+	ErrPollQuestionLong  = errors.New("poll question is too long")
+	ErrPollOptionsCount  = errors.New("poll needs between 2 and 10 options")
+	ErrPollOptionInvalid = errors.New("poll options must be non-empty, short and unique")
+	ErrPollCloseTime     = errors.New("poll close time must be in the future")
+	ErrPollNotFound      = errors.New("poll not found")
+	ErrPollClosed        = errors.New("poll is closed")
+	ErrPollInvalidChoice = errors.New("poll choice does not exist")
+	ErrPollSingleChoice  = errors.New("poll allows a single choice only")
+)
@@ -0,0 +1,9 @@
+package errors
+
+import "errors"
+
+var (
+	ErrEmptyReceipt         = errors.New("receipt needs delivered_until or read_until")
+	ErrInvalidReceiptCursor = errors.New("receipt cursor must be an RFC3339 time")
+	ErrNotMessageSender     = errors.New("only the sender can see message receipts")
+)
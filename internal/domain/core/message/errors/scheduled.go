@@ -0,0 +1,13 @@
+package errors
+
+import "errors"
+
+var (
+	ErrEmptyContent              = errors.New("message content is empty")
+	ErrInvalidScheduleTime       = errors.New("scheduled time must be RFC3339")
+	ErrScheduleInPast            = errors.New("scheduled time must be in the future")
+	ErrScheduleTooFar            = errors.New("scheduled time is too far in the future")
+	ErrScheduledNotFound         = errors.New("scheduled message not found")
+	ErrScheduledAlreadyProcessed = errors.New("scheduled message was already sent or cancelled")
+	ErrNothingToUpdate           = errors.New("nothing to update")
+)
@@ -0,0 +1,9 @@
+package errors
+
+import "errors"
+
+var (
+	ErrEmptySearchQuery = errors.New("search query is empty")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrInvalidDateRange = errors.New("invalid date range")
+)
@@ -0,0 +1,5 @@
+package errors
+
+import "errors"
+
+var ErrInvalidTTL = errors.New("message ttl is out of range")
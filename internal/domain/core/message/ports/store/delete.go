@@ -0,0 +1,14 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type DeleteStore interface {
+	// Author locks a live message of the chat until the surrounding transaction ends and returns its sender.
+	Author(ctx context.Context, chatID uuid.UUID, messageID int) (uuid.UUID, error)
+	// Delete hides the message right away, the expiry sweep removes it along with its objects.
+	Delete(ctx context.Context, chatID uuid.UUID, messageID int) error
+}
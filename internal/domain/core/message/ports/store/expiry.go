@@ -0,0 +1,12 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"context"
+)
+
+type ExpiryStore interface {
+	// ClaimExpired locks expired messages until the surrounding transaction ends, other sweepers skip them.
+	ClaimExpired(ctx context.Context, limit int) ([]entity.ExpiredMessage, error)
+	Delete(ctx context.Context, ids []int) error
+}
@@ -0,0 +1,13 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ForwardStore interface {
+	// GetSources returns the live messages of the chat among ids, ordered as they were sent.
+	GetSources(ctx context.Context, chatID uuid.UUID, ids []int) ([]entity.ForwardSource, error)
+}
@@ -0,0 +1,22 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type MentionStore interface {
+	// Feed lists the mentions of the user in the chats they are a member of, newest first.
+	Feed(ctx context.Context, filter vo.MentionFilter) ([]entity.Mention, error)
+	// MarkRead marks the mentions of the user in the chat as read and reports how many changed.
+	MarkRead(ctx context.Context, userID, chatID uuid.UUID) (int, error)
+}
+
+type MentionNotifyStore interface {
+	// ClaimUnnotified locks mentions until the surrounding transaction ends, other notifiers skip them.
+	ClaimUnnotified(ctx context.Context, limit int) ([]entity.MentionNotice, error)
+	MarkNotified(ctx context.Context, notices []entity.MentionNotice) error
+}
@@ -0,0 +1,12 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+)
+
+// PageStore is the single read path of a chat history.
+type PageStore interface {
+	Page(ctx context.Context, q vo.PageQuery) (entity.MessagePage, error)
+}
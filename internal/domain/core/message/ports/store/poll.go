@@ -0,0 +1,21 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type PollStore interface {
+	// Create inserts the poll message and its options, it needs a transaction.
+	Create(ctx context.Context, userID, chatID uuid.UUID, draft vo.PollDraft) (entity.Poll, error)
+	// Get returns the poll with its tally, MyVote is filled for the viewer.
+	Get(ctx context.Context, messageID int, viewer uuid.UUID) (entity.Poll, error)
+	GetMany(ctx context.Context, messageIDs []int, viewer uuid.UUID) (map[int]entity.Poll, error)
+	// LockForVote locks the poll until the surrounding transaction ends, so tallies are broadcast in vote order.
+	LockForVote(ctx context.Context, messageID int) (entity.Poll, error)
+	// ReplaceVote sets the choices of the user, no choices retract the vote.
+	ReplaceVote(ctx context.Context, messageID int, userID uuid.UUID, choices []int) error
+}
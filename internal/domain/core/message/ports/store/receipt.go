@@ -0,0 +1,28 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReceiptStore interface {
+	// Advance moves the cursors of the member forward only, a non member gets ErrNotChatMember.
+	Advance(ctx context.Context, userID, chatID uuid.UUID, cursors vo.ReceiptCursors) (entity.ReceiptAdvance, error)
+	// Enqueue asks for the counts of the chat to be redone for messages sent after since.
+	Enqueue(ctx context.Context, chatID uuid.UUID, since time.Time) error
+	Get(ctx context.Context, messageID int) (entity.MessageReceipts, error)
+	// Recipients lists the cursors of every chat member except the sender.
+	Recipients(ctx context.Context, chatID, senderID uuid.UUID) ([]entity.RecipientCursors, error)
+}
+
+type ReceiptCountStore interface {
+	// ClaimQueued takes chats off the queue, a rolled back transaction puts them back.
+	ClaimQueued(ctx context.Context, limit int) ([]entity.ReceiptQueueEntry, error)
+	// Recount redoes the counts of up to limit messages sent after since, oldest first.
+	Recount(ctx context.Context, chatID uuid.UUID, since time.Time, limit int) (entity.ReceiptRecount, error)
+	Enqueue(ctx context.Context, chatID uuid.UUID, since time.Time) error
+}
@@ -6,5 +6,7 @@ import (
 )
 
 type SaveFromStreamStore interface {
-	SaveBatch(ctx context.Context, messages []vo.StreamMessage) error
+	// SaveBatch stores the messages, a redelivered one is stored once. Collided lists the ids held by
+	// a different message already, those messages are not stored.
+	SaveBatch(ctx context.Context, messages []vo.StreamMessage) (collided []int, err error)
 }
@@ -0,0 +1,25 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ScheduledStore interface {
+	Create(ctx context.Context, message entity.ScheduledMessage) error
+	ListPending(ctx context.Context, userID uuid.UUID, chatID *uuid.UUID) ([]entity.ScheduledMessage, error)
+	// Update and Cancel touch only pending messages of the given user.
+	Update(ctx context.Context, id, userID uuid.UUID, update vo.ScheduledUpdate) (entity.ScheduledMessage, error)
+	Cancel(ctx context.Context, id, userID uuid.UUID) error
+}
+
+type ScheduledDispatchStore interface {
+	// ClaimDue locks due messages until the surrounding transaction ends, other workers skip them.
+	ClaimDue(ctx context.Context, limit int) ([]entity.ScheduledMessage, error)
+	MarkSent(ctx context.Context, ids []uuid.UUID) error
+	// CancelOrphaned drops due messages whose author is no longer a member of the chat or may no longer post.
+	CancelOrphaned(ctx context.Context) (int64, error)
+}
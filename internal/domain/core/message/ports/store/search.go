@@ -0,0 +1,11 @@
+package store
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"context"
+)
+
+type Search interface {
+	Execute(ctx context.Context, filter vo.SearchFilter) ([]entity.SearchHit, error)
+}
@@ -0,0 +1,10 @@
+package usecases
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	"context"
+)
+
+type MessageForward interface {
+	Execute(ctx context.Context, req dto.ForwardRequest) (dto.ForwardResponse, error)
+}
@@ -0,0 +1,10 @@
+package usecases
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	"context"
+)
+
+type PollVote interface {
+	Execute(ctx context.Context, req dto.VotePollRequest) (dto.Poll, error)
+}
@@ -6,5 +6,5 @@ import (
 )
 
 type MessageBroadcastWithPub interface {
-	Execute(ctx context.Context, req dto.BroadcastWithPubRequest) error
+	Execute(ctx context.Context, req dto.BroadcastWithPubRequest) (dto.SentMessage, error)
 }
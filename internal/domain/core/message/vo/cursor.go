@@ -0,0 +1,60 @@
+package vo
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+// Cursor points at a message by (created_at, id) for keyset pagination.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int
+}
+
+func (c Cursor) IsZero() bool {
+	return c.ID == 0 && c.CreatedAt.IsZero()
+}
+
+// Encode returns an opaque representation of the cursor safe to pass in urls.
+func (c Cursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + strconv.Itoa(c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", msgErrors.ErrInvalidCursor, err)
+	}
+
+	tsStr, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, msgErrors.ErrInvalidCursor
+	}
+
+	ts, err := strconv.ParseInt(tsStr, 10, 64)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", msgErrors.ErrInvalidCursor, err)
+	}
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: %w", msgErrors.ErrInvalidCursor, err)
+	}
+
+	return Cursor{
+		CreatedAt: time.Unix(0, ts).UTC(),
+		ID:        id,
+	}, nil
+}
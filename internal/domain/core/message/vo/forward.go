@@ -0,0 +1,18 @@
+package vo
+
+const (
+	MaxForwardMessages = 100
+	MaxForwardTargets  = 10
+)
+
+// ForwardOrigin attributes a forwarded message. MessageID is the message the copy was made from,
+// UserID and ChatID always name the original author, also for forwards of forwards.
+type ForwardOrigin struct {
+	MessageID int    `json:"message_id,omitempty"`
+	UserID    string `json:"user_id"`
+	ChatID    string `json:"chat_id"`
+}
+
+func (o ForwardOrigin) IsZero() bool {
+	return o.UserID == "" && o.ChatID == ""
+}
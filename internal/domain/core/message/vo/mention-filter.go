@@ -0,0 +1,11 @@
+package vo
+
+import "github.com/google/uuid"
+
+type MentionFilter struct {
+	UserID     uuid.UUID  // the mentioned user
+	ChatID     *uuid.UUID // optional
+	UnreadOnly bool
+	Limit      int
+	Cursor     Cursor
+}
@@ -0,0 +1,85 @@
+package vo
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	MentionAll = "all"
+	// MaxMentions bounds the usernames taken from one message, the rest is plain text.
+	MaxMentions = 50
+	maxUsername = 64
+)
+
+// Mentions are the lower-cased usernames a message mentions, All is set by @all.
+type Mentions struct {
+	Usernames []string
+	All       bool
+}
+
+func (m Mentions) IsZero() bool {
+	return !m.All && len(m.Usernames) == 0
+}
+
+// ParseMentions finds @username and @all in the content. An @ glued to a word, like in an email address,
+// does not start a mention, and trailing dots or dashes belong to the sentence, not to the name.
+func ParseMentions(content string) Mentions {
+	var (
+		m    Mentions
+		seen = make(map[string]struct{})
+	)
+
+	scanMentions(content, func(_, _ int, name string) {
+		if name == MentionAll {
+			m.All = true
+			return
+		}
+		if _, ok := seen[name]; ok || len(m.Usernames) == MaxMentions {
+			return
+		}
+		seen[name] = struct{}{}
+		m.Usernames = append(m.Usernames, name)
+	})
+
+	return m
+}
+
+// scanMentions calls found with the byte range of every mention, the @ included, and the lower-cased name.
+func scanMentions(content string, found func(start, end int, name string)) {
+	var prev rune
+
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if r != '@' || isUsernameRune(prev) {
+			prev = r
+			i += size
+			continue
+		}
+
+		end := i + size
+		for end < len(content) {
+			next, nextSize := utf8.DecodeRuneInString(content[end:])
+			if !isUsernameRune(next) {
+				break
+			}
+			end += nextSize
+		}
+
+		name := strings.TrimRight(content[i+size:end], ".-")
+		start := i
+		prev = '@'
+		i = end
+
+		if name == "" || utf8.RuneCountInString(name) > maxUsername {
+			continue
+		}
+
+		found(start, start+size+len(name), strings.ToLower(name))
+	}
+}
+
+func isUsernameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
@@ -0,0 +1,81 @@
+package vo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected Mentions
+	}{
+		{
+			name:     "No mentions",
+			content:  "hello there",
+			expected: Mentions{},
+		},
+		{
+			name:     "Single mention",
+			content:  "@bob hi",
+			expected: Mentions{Usernames: []string{"bob"}},
+		},
+		{
+			name:     "Case is folded and duplicates dropped",
+			content:  "@Bob and @bob and @BOB",
+			expected: Mentions{Usernames: []string{"bob"}},
+		},
+		{
+			name:     "Mention all",
+			content:  "meeting now @All!",
+			expected: Mentions{All: true},
+		},
+		{
+			name:     "All together with users",
+			content:  "@all, especially @alice_1 and @john.doe.",
+			expected: Mentions{Usernames: []string{"alice_1", "john.doe"}, All: true},
+		},
+		{
+			name:     "Email is not a mention",
+			content:  "write to bob@example.com",
+			expected: Mentions{},
+		},
+		{
+			name:     "Punctuation before the mention",
+			content:  "(@carol) thanks,@dave",
+			expected: Mentions{Usernames: []string{"carol", "dave"}},
+		},
+		{
+			name:     "Lone at signs",
+			content:  "@ @@ @-",
+			expected: Mentions{},
+		},
+		{
+			name:     "Unicode username",
+			content:  "привет @Дима",
+			expected: Mentions{Usernames: []string{"дима"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ParseMentions(tt.content)
+			if !reflect.DeepEqual(res, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestParseMentionsLimit(t *testing.T) {
+	content := ""
+	for i := 0; i < MaxMentions+10; i++ {
+		content += " @user" + string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+
+	res := ParseMentions(content)
+	if len(res.Usernames) != MaxMentions {
+		t.Errorf("Expected %d usernames, got %d", MaxMentions, len(res.Usernames))
+	}
+}
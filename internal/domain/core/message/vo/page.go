@@ -0,0 +1,77 @@
+package vo
+
+import (
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+
+	"github.com/google/uuid"
+)
+
+type PageDirection string
+
+const (
+	// PageBefore goes to older messages, it is also the first page starting at the newest message.
+	PageBefore PageDirection = "before"
+	PageAfter  PageDirection = "after"
+	// PageAround centers the page on a message, to jump to it with some context.
+	PageAround PageDirection = "around"
+)
+
+const (
+	DefaultPageSize = 50
+	MaxPageSize     = 100
+)
+
+// PageQuery selects one page of a chat history, pages always list the newest message first.
+type PageQuery struct {
+	ChatID    uuid.UUID
+	Direction PageDirection
+	// Cursor is zero on the first page and for PageAround.
+	Cursor   Cursor
+	AroundID int
+	Limit    int
+}
+
+// NewPageQuery accepts at most one of the before and after cursors and the around message id.
+func NewPageQuery(chatID uuid.UUID, before, after string, aroundID, limit int) (PageQuery, error) {
+	q := PageQuery{
+		ChatID:    chatID,
+		Direction: PageBefore,
+		Limit:     limit,
+	}
+
+	set := 0
+	for _, present := range []bool{before != "", after != "", aroundID != 0} {
+		if present {
+			set++
+		}
+	}
+	if set > 1 {
+		return PageQuery{}, msgErrors.ErrConflictingPageCursor
+	}
+
+	var err error
+	switch {
+	case before != "":
+		q.Cursor, err = ParseCursor(before)
+	case after != "":
+		q.Direction = PageAfter
+		q.Cursor, err = ParseCursor(after)
+	case aroundID < 0:
+		err = msgErrors.ErrMessageNotFound
+	case aroundID > 0:
+		q.Direction = PageAround
+		q.AroundID = aroundID
+	}
+	if err != nil {
+		return PageQuery{}, err
+	}
+
+	switch {
+	case q.Limit <= 0:
+		q.Limit = DefaultPageSize
+	case q.Limit > MaxPageSize:
+		q.Limit = MaxPageSize
+	}
+
+	return q, nil
+}
@@ -0,0 +1,71 @@
+package vo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+
+	"github.com/google/uuid"
+)
+
+func TestNewPageQuery(t *testing.T) {
+	chatID := uuid.New()
+	cursor := Cursor{CreatedAt: time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC), ID: 42}
+
+	tests := []struct {
+		name        string
+		before      string
+		after       string
+		aroundID    int
+		limit       int
+		expected    PageQuery
+		expectedErr error
+	}{
+		{
+			name:     "First page",
+			expected: PageQuery{ChatID: chatID, Direction: PageBefore, Limit: DefaultPageSize},
+		},
+		{
+			name:     "Older messages",
+			before:   cursor.Encode(),
+			limit:    20,
+			expected: PageQuery{ChatID: chatID, Direction: PageBefore, Cursor: cursor, Limit: 20},
+		},
+		{
+			name:     "Newer messages with a capped limit",
+			after:    cursor.Encode(),
+			limit:    1000,
+			expected: PageQuery{ChatID: chatID, Direction: PageAfter, Cursor: cursor, Limit: MaxPageSize},
+		},
+		{
+			name:     "Around a message",
+			aroundID: 7,
+			expected: PageQuery{ChatID: chatID, Direction: PageAround, AroundID: 7, Limit: DefaultPageSize},
+		},
+		{
+			name:        "Both directions",
+			before:      cursor.Encode(),
+			aroundID:    7,
+			expectedErr: msgErrors.ErrConflictingPageCursor,
+		},
+		{
+			name:        "Broken cursor",
+			after:       "not a cursor",
+			expectedErr: msgErrors.ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewPageQuery(chatID, tt.before, tt.after, tt.aroundID, tt.limit)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr == nil && q != tt.expected {
+				t.Errorf("query = %+v, want %+v", q, tt.expected)
+			}
+		})
+	}
+}
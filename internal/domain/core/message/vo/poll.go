@@ -0,0 +1,95 @@
+package vo
+
+import (
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+const (
+	MessageTypePoll = "poll"
+
+	MinPollOptions    = 2
+	MaxPollOptions    = 10
+	MaxPollQuestion   = 300
+	MaxPollOption     = 100
+	MinPollOpenPeriod = time.Minute
+)
+
+// PollDraft is a validated poll about to be sent.
+type PollDraft struct {
+	Question       string
+	Options        []string
+	MultipleChoice bool
+	Anonymous      bool
+	ClosesAt       *time.Time
+}
+
+// NewPollDraft trims the question and options and checks them, closesAt is RFC3339 and optional.
+func NewPollDraft(
+	question string,
+	options []string,
+	multipleChoice, anonymous bool,
+	closesAt string,
+	now time.Time,
+) (PollDraft, error) {
+	draft := PollDraft{
+		Question:       strings.TrimSpace(question),
+		MultipleChoice: multipleChoice,
+		Anonymous:      anonymous,
+	}
+
+	switch {
+	case draft.Question == "":
+		return PollDraft{}, msgErrors.ErrPollQuestionEmpty
+	case utf8.RuneCountInString(draft.Question) > MaxPollQuestion:
+		return PollDraft{}, msgErrors.ErrPollQuestionLong
+	case len(options) < MinPollOptions || len(options) > MaxPollOptions:
+		return PollDraft{}, msgErrors.ErrPollOptionsCount
+	}
+
+	seen := make(map[string]struct{}, len(options))
+	draft.Options = make([]string, 0, len(options))
+	for _, raw := range options {
+		option := strings.TrimSpace(raw)
+		key := strings.ToLower(option)
+		if _, dup := seen[key]; dup || option == "" || utf8.RuneCountInString(option) > MaxPollOption {
+			return PollDraft{}, msgErrors.ErrPollOptionInvalid
+		}
+		seen[key] = struct{}{}
+		draft.Options = append(draft.Options, option)
+	}
+
+	if closesAt != "" {
+		t, err := time.Parse(time.RFC3339, closesAt)
+		if err != nil || t.Before(now.Add(MinPollOpenPeriod)) {
+			return PollDraft{}, msgErrors.ErrPollCloseTime
+		}
+		t = t.UTC()
+		draft.ClosesAt = &t
+	}
+
+	return draft, nil
+}
+
+// NormalizeChoices sorts and deduplicates option positions and checks them against the poll.
+// No choices retract the vote.
+func NormalizeChoices(choices []int, optionsCount int, multipleChoice bool) ([]int, error) {
+	normalized := slices.Clone(choices)
+	slices.Sort(normalized)
+	normalized = slices.Compact(normalized)
+
+	for _, c := range normalized {
+		if c < 0 || c >= optionsCount {
+			return nil, msgErrors.ErrPollInvalidChoice
+		}
+	}
+	if !multipleChoice && len(normalized) > 1 {
+		return nil, msgErrors.ErrPollSingleChoice
+	}
+
+	return normalized, nil
+}
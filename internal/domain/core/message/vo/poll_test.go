@@ -0,0 +1,153 @@
+package vo
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+func TestNewPollDraft(t *testing.T) {
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		question string
+		options  []string
+		closesAt string
+		expected error
+	}{
+		{
+			name:     "Valid poll",
+			question: " Lunch? ",
+			options:  []string{"pizza", " sushi "},
+		},
+		{
+			name:     "Valid close time",
+			question: "Lunch?",
+			options:  []string{"pizza", "sushi"},
+			closesAt: "2025-09-01T13:00:00Z",
+		},
+		{
+			name:     "Empty question",
+			question: "  ",
+			options:  []string{"a", "b"},
+			expected: msgErrors.ErrPollQuestionEmpty,
+		},
+		{
+			name:     "Question too long",
+			question: strings.Repeat("q", MaxPollQuestion+1),
+			options:  []string{"a", "b"},
+			expected: msgErrors.ErrPollQuestionLong,
+		},
+		{
+			name:     "Single option",
+			question: "Lunch?",
+			options:  []string{"pizza"},
+			expected: msgErrors.ErrPollOptionsCount,
+		},
+		{
+			name:     "Duplicate options",
+			question: "Lunch?",
+			options:  []string{"Pizza", "pizza "},
+			expected: msgErrors.ErrPollOptionInvalid,
+		},
+		{
+			name:     "Empty option",
+			question: "Lunch?",
+			options:  []string{"pizza", ""},
+			expected: msgErrors.ErrPollOptionInvalid,
+		},
+		{
+			name:     "Close time in the past",
+			question: "Lunch?",
+			options:  []string{"pizza", "sushi"},
+			closesAt: "2025-09-01T11:00:00Z",
+			expected: msgErrors.ErrPollCloseTime,
+		},
+		{
+			name:     "Malformed close time",
+			question: "Lunch?",
+			options:  []string{"pizza", "sushi"},
+			closesAt: "tomorrow",
+			expected: msgErrors.ErrPollCloseTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draft, err := NewPollDraft(tt.question, tt.options, false, false, tt.closesAt, now)
+			if !errors.Is(err, tt.expected) {
+				t.Fatalf("Expected error %v, got %v", tt.expected, err)
+			}
+			if err != nil {
+				return
+			}
+			if draft.Question != strings.TrimSpace(tt.question) {
+				t.Errorf("Expected trimmed question, got %q", draft.Question)
+			}
+			for _, option := range draft.Options {
+				if option != strings.TrimSpace(option) {
+					t.Errorf("Expected trimmed option, got %q", option)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeChoices(t *testing.T) {
+	tests := []struct {
+		name     string
+		choices  []int
+		multiple bool
+		expected []int
+		err      error
+	}{
+		{
+			name:     "Retract",
+			choices:  nil,
+			expected: []int{},
+		},
+		{
+			name:     "Single",
+			choices:  []int{1},
+			expected: []int{1},
+		},
+		{
+			name:     "Multiple sorted and deduplicated",
+			choices:  []int{2, 0, 2},
+			multiple: true,
+			expected: []int{0, 2},
+		},
+		{
+			name:    "Several on single choice",
+			choices: []int{0, 1},
+			err:     msgErrors.ErrPollSingleChoice,
+		},
+		{
+			name:    "Out of range",
+			choices: []int{3},
+			err:     msgErrors.ErrPollInvalidChoice,
+		},
+		{
+			name:    "Negative",
+			choices: []int{-1},
+			err:     msgErrors.ErrPollInvalidChoice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := NormalizeChoices(tt.choices, 3, tt.multiple)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Expected error %v, got %v", tt.err, err)
+			}
+			if err == nil && len(res)+len(tt.expected) > 0 && !reflect.DeepEqual(res, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, res)
+			}
+		})
+	}
+}
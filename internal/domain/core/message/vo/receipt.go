@@ -0,0 +1,96 @@
+package vo
+
+import (
+	"time"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+// DeliveryStatus is the state of a message as its sender sees it.
+type DeliveryStatus string
+
+const (
+	DeliverySent      DeliveryStatus = "sent"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryRead      DeliveryStatus = "read"
+)
+
+// ReceiptCountsThreshold is the chat size above which receipts are kept as counts per message.
+// Smaller chats share the cursors of every member, in larger ones that would flood the chat.
+const ReceiptCountsThreshold = 100
+
+func CountsReceipts(members int) bool {
+	return members > ReceiptCountsThreshold
+}
+
+// ReceiptCursors mark how far a member got in a chat, every message sent up to a cursor
+// is delivered to or read by them. A nil cursor was never set.
+type ReceiptCursors struct {
+	DeliveredUntil *time.Time
+	ReadUntil      *time.Time
+}
+
+// ParseReceiptCursors reads the cursors a client acks. Read implies delivered,
+// and cursors from the future are cut down to now.
+func ParseReceiptCursors(delivered, read string, now time.Time) (ReceiptCursors, error) {
+	if delivered == "" && read == "" {
+		return ReceiptCursors{}, msgErrors.ErrEmptyReceipt
+	}
+
+	parse := func(raw string) (*time.Time, error) {
+		if raw == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339Nano, raw)
+		if err != nil {
+			return nil, msgErrors.ErrInvalidReceiptCursor
+		}
+		t = t.UTC()
+		if t.After(now) {
+			t = now
+		}
+		return &t, nil
+	}
+
+	var (
+		c   ReceiptCursors
+		err error
+	)
+	if c.DeliveredUntil, err = parse(delivered); err != nil {
+		return ReceiptCursors{}, err
+	}
+	if c.ReadUntil, err = parse(read); err != nil {
+		return ReceiptCursors{}, err
+	}
+	if c.ReadUntil != nil && (c.DeliveredUntil == nil || c.DeliveredUntil.Before(*c.ReadUntil)) {
+		c.DeliveredUntil = c.ReadUntil
+	}
+
+	return c, nil
+}
+
+// StatusOf tells the state of a message sent at sentAt for the member.
+func (c ReceiptCursors) StatusOf(sentAt time.Time) DeliveryStatus {
+	switch {
+	case c.ReadUntil != nil && !c.ReadUntil.Before(sentAt):
+		return DeliveryRead
+	case c.DeliveredUntil != nil && !c.DeliveredUntil.Before(sentAt):
+		return DeliveryDelivered
+	default:
+		return DeliverySent
+	}
+}
+
+// AggregateStatus is the state of a message in a group, it is delivered or read once every recipient got there.
+func AggregateStatus(recipients, delivered, read int) DeliveryStatus {
+	switch {
+	case recipients == 0:
+		return DeliverySent
+	case read >= recipients:
+		return DeliveryRead
+	case delivered >= recipients:
+		return DeliveryDelivered
+	default:
+		return DeliverySent
+	}
+}
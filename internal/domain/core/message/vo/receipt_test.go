@@ -0,0 +1,115 @@
+package vo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+func TestParseReceiptCursors(t *testing.T) {
+	now := time.Date(2025, 9, 5, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+	later := now.Add(-time.Minute)
+
+	tests := []struct {
+		name          string
+		delivered     string
+		read          string
+		wantDelivered *time.Time
+		wantRead      *time.Time
+		expectedErr   error
+	}{
+		{
+			name:          "Delivered only",
+			delivered:     earlier.Format(time.RFC3339Nano),
+			wantDelivered: &earlier,
+		},
+		{
+			name:          "Read implies delivered",
+			read:          later.Format(time.RFC3339Nano),
+			wantDelivered: &later,
+			wantRead:      &later,
+		},
+		{
+			name:          "Read past delivered moves delivered",
+			delivered:     earlier.Format(time.RFC3339Nano),
+			read:          later.Format(time.RFC3339Nano),
+			wantDelivered: &later,
+			wantRead:      &later,
+		},
+		{
+			name:          "Future cursor is cut to now",
+			delivered:     now.Add(time.Hour).Format(time.RFC3339Nano),
+			wantDelivered: &now,
+		},
+		{
+			name:        "Nothing to ack",
+			expectedErr: msgErrors.ErrEmptyReceipt,
+		},
+		{
+			name:        "Broken cursor",
+			read:        "yesterday",
+			expectedErr: msgErrors.ErrInvalidReceiptCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseReceiptCursors(tt.delivered, tt.read, now)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("error = %v, want %v", err, tt.expectedErr)
+			}
+			if !sameCursor(c.DeliveredUntil, tt.wantDelivered) {
+				t.Errorf("delivered_until = %v, want %v", c.DeliveredUntil, tt.wantDelivered)
+			}
+			if !sameCursor(c.ReadUntil, tt.wantRead) {
+				t.Errorf("read_until = %v, want %v", c.ReadUntil, tt.wantRead)
+			}
+		})
+	}
+}
+
+func TestReceiptStatus(t *testing.T) {
+	sentAt := time.Date(2025, 9, 5, 12, 0, 0, 0, time.UTC)
+	before, after := sentAt.Add(-time.Second), sentAt.Add(time.Second)
+
+	cursors := []struct {
+		cursors  ReceiptCursors
+		expected DeliveryStatus
+	}{
+		{ReceiptCursors{}, DeliverySent},
+		{ReceiptCursors{DeliveredUntil: &before}, DeliverySent},
+		{ReceiptCursors{DeliveredUntil: &sentAt}, DeliveryDelivered},
+		{ReceiptCursors{DeliveredUntil: &after, ReadUntil: &before}, DeliveryDelivered},
+		{ReceiptCursors{DeliveredUntil: &after, ReadUntil: &after}, DeliveryRead},
+	}
+	for _, tt := range cursors {
+		if got := tt.cursors.StatusOf(sentAt); got != tt.expected {
+			t.Errorf("StatusOf(%+v) = %s, want %s", tt.cursors, got, tt.expected)
+		}
+	}
+
+	counts := []struct {
+		recipients, delivered, read int
+		expected                    DeliveryStatus
+	}{
+		{0, 0, 0, DeliverySent},
+		{3, 2, 0, DeliverySent},
+		{3, 3, 2, DeliveryDelivered},
+		{3, 3, 3, DeliveryRead},
+	}
+	for _, tt := range counts {
+		if got := AggregateStatus(tt.recipients, tt.delivered, tt.read); got != tt.expected {
+			t.Errorf("AggregateStatus(%d, %d, %d) = %s, want %s", tt.recipients, tt.delivered, tt.read, got, tt.expected)
+		}
+	}
+}
+
+func sameCursor(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,426 @@
+package vo
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+// Format tells how the client wrote the content, the stored text is always plain.
+type Format string
+
+const (
+	FormatPlain    Format = "plain"
+	FormatMarkdown Format = "markdown"
+)
+
+func ParseFormat(raw string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(raw))) {
+	case "", FormatPlain:
+		return FormatPlain, nil
+	case FormatMarkdown:
+		return FormatMarkdown, nil
+	default:
+		return "", msgErrors.ErrInvalidFormat
+	}
+}
+
+type EntityType string
+
+const (
+	EntityBold          EntityType = "bold"
+	EntityItalic        EntityType = "italic"
+	EntityStrikethrough EntityType = "strikethrough"
+	EntityCode          EntityType = "code"
+	EntityPre           EntityType = "pre"
+	EntityLink          EntityType = "link"
+	EntityMention       EntityType = "mention"
+)
+
+const (
+	MaxEntities     = 200
+	maxCodeLanguage = 20
+)
+
+// Entity marks a part of the text, Offset and Length count unicode code points.
+type Entity struct {
+	Type     EntityType `json:"type"`
+	Offset   int        `json:"offset"`
+	Length   int        `json:"length"`
+	URL      string     `json:"url,omitempty"`
+	Language string     `json:"language,omitempty"`
+	Username string     `json:"username,omitempty"`
+}
+
+// RichText is the text clients render with its entities, markup never reaches them.
+type RichText struct {
+	Format   Format
+	Text     string
+	Entities []Entity
+}
+
+// ParseRichText normalizes the content and extracts its entities.
+// Markdown is reduced to a safe subset: **bold**, *italic* or _italic_, ~~strikethrough~~, `code`,
+// fenced ``` blocks with an optional language and [text](url) links with http, https or mailto urls.
+// Anything else, raw HTML included, stays literal text. Links with other schemes keep their text only.
+// Bare urls and mentions are entities in both formats, except inside code and links.
+func ParseRichText(content string, format Format) (RichText, error) {
+	content = normalizeText(content)
+
+	rt := RichText{Format: format, Text: content}
+	if format == FormatMarkdown {
+		p := markdownParser{src: []rune(content)}
+		p.parse(0, len(p.src))
+		if strings.TrimSpace(string(p.out)) == "" {
+			return RichText{}, msgErrors.ErrEmptyFormatted
+		}
+		rt.Text = string(p.out)
+		rt.Entities = p.entities
+	}
+
+	rt.Entities = append(rt.Entities, inlineEntities([]rune(rt.Text), rt.Entities)...)
+	if len(rt.Entities) > MaxEntities {
+		return RichText{}, msgErrors.ErrTooManyEntities
+	}
+
+	slices.SortStableFunc(rt.Entities, func(a, b Entity) int {
+		if a.Offset != b.Offset {
+			return a.Offset - b.Offset
+		}
+		return b.Length - a.Length
+	})
+
+	return rt, nil
+}
+
+// normalizeText unifies line breaks and drops control and bidi override characters.
+func normalizeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case unicode.IsControl(r),
+			r >= '\u202A' && r <= '\u202E',
+			r >= '\u2066' && r <= '\u2069':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+type markdownParser struct {
+	src      []rune
+	out      []rune
+	entities []Entity
+}
+
+func (p *markdownParser) parse(from, to int) {
+	for i := from; i < to; {
+		r := p.src[i]
+
+		if r == '\\' && i+1 < to && strings.ContainsRune("\\`*_~[]()", p.src[i+1]) {
+			p.out = append(p.out, p.src[i+1])
+			i += 2
+			continue
+		}
+
+		next, ok := 0, false
+		switch r {
+		case '`':
+			if p.hasPrefix(i, to, "```") && (i == 0 || p.src[i-1] == '\n') {
+				next, ok = p.pre(i, to)
+			}
+			if !ok {
+				next, ok = p.code(i, to)
+			}
+		case '[':
+			next, ok = p.link(i, to)
+		case '*', '_', '~':
+			next, ok = p.emphasis(i, to)
+		}
+		if ok {
+			i = next
+			continue
+		}
+
+		p.out = append(p.out, r)
+		i++
+	}
+}
+
+func (p *markdownParser) hasPrefix(i, to int, prefix string) bool {
+	for _, r := range prefix {
+		if i >= to || p.src[i] != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func (p *markdownParser) add(t EntityType, start int) *Entity {
+	if len(p.out) == start {
+		return nil
+	}
+	p.entities = append(p.entities, Entity{Type: t, Offset: start, Length: len(p.out) - start})
+	return &p.entities[len(p.entities)-1]
+}
+
+// pre copies a fenced block verbatim, the fences must start a line.
+func (p *markdownParser) pre(i, to int) (int, bool) {
+	nl := slices.Index(p.src[i:to], '\n')
+	if nl < 0 {
+		return 0, false
+	}
+	nl += i
+	language := strings.TrimSpace(string(p.src[i+3 : nl]))
+
+	for k := nl + 1; k < to; k++ {
+		if p.src[k-1] != '\n' || !p.hasPrefix(k, to, "```") {
+			continue
+		}
+		if end := k + 3; end < to && p.src[end] != '\n' {
+			continue
+		}
+
+		bodyEnd := max(k-1, nl+1)
+		start := len(p.out)
+		p.out = append(p.out, p.src[nl+1:bodyEnd]...)
+		if e := p.add(EntityPre, start); e != nil && isCodeLanguage(language) {
+			e.Language = strings.ToLower(language)
+		}
+		return k + 3, true
+	}
+
+	return 0, false
+}
+
+func (p *markdownParser) code(i, to int) (int, bool) {
+	j := slices.Index(p.src[i+1:to], '`')
+	if j <= 0 {
+		return 0, false
+	}
+	j += i + 1
+
+	start := len(p.out)
+	p.out = append(p.out, p.src[i+1:j]...)
+	p.add(EntityCode, start)
+	return j + 1, true
+}
+
+func (p *markdownParser) link(i, to int) (int, bool) {
+	j := slices.IndexFunc(p.src[i+1:to], func(r rune) bool { return r == ']' || r == '[' || r == '\n' })
+	if j <= 0 {
+		return 0, false
+	}
+	j += i + 1
+	if p.src[j] != ']' || j+1 >= to || p.src[j+1] != '(' {
+		return 0, false
+	}
+
+	k := slices.IndexFunc(p.src[j+2:to], func(r rune) bool { return r == ')' || unicode.IsSpace(r) })
+	if k <= 0 {
+		return 0, false
+	}
+	k += j + 2
+	if p.src[k] != ')' {
+		return 0, false
+	}
+
+	start := len(p.out)
+	p.parse(i+1, j)
+	if link, ok := safeURL(string(p.src[j+2 : k])); ok {
+		if e := p.add(EntityLink, start); e != nil {
+			e.URL = link
+		}
+	}
+	return k + 1, true
+}
+
+// emphasis handles bold, italic and strikethrough. The markers must hug the text,
+// and _ inside a word like snake_case is not a marker.
+func (p *markdownParser) emphasis(i, to int) (int, bool) {
+	r := p.src[i]
+	n := 1
+	if i+1 < to && p.src[i+1] == r {
+		n = 2
+	}
+
+	var t EntityType
+	switch {
+	case r == '~' && n == 2:
+		t = EntityStrikethrough
+	case r == '~':
+		return 0, false
+	case n == 2:
+		t = EntityBold
+	default:
+		t = EntityItalic
+	}
+
+	if i+n >= to || unicode.IsSpace(p.src[i+n]) {
+		return 0, false
+	}
+	if r == '_' && i > 0 && isWordRune(p.src[i-1]) {
+		return 0, false
+	}
+
+	for j := i + n + 1; j+n <= to; {
+		switch c := p.src[j]; {
+		case c == '\\':
+			j += 2
+			continue
+		case c == '`':
+			// a code span inside the emphasis can hold the marker
+			if k := slices.Index(p.src[j+1:to], '`'); k > 0 {
+				j += k + 2
+				continue
+			}
+		case c == r:
+			run := 1
+			for j+run < to && p.src[j+run] == r {
+				run++
+			}
+			// in a run of three the last markers close, the first ones belong to nested emphasis
+			if run == 3 {
+				j, run = j+3-n, n
+			}
+			closes := run == n && !unicode.IsSpace(p.src[j-1]) &&
+				(r != '_' || j+n >= to || !isWordRune(p.src[j+n]))
+			if !closes {
+				j += run
+				continue
+			}
+
+			start := len(p.out)
+			p.parse(i+n, j)
+			p.add(t, start)
+			return j + n, true
+		}
+		j++
+	}
+
+	return 0, false
+}
+
+// inlineEntities finds bare urls and mentions outside of code and links.
+func inlineEntities(text []rune, entities []Entity) []Entity {
+	opaque := make([]bool, len(text))
+	for _, e := range entities {
+		if e.Type == EntityCode || e.Type == EntityPre || e.Type == EntityLink {
+			for k := e.Offset; k < e.Offset+e.Length; k++ {
+				opaque[k] = true
+			}
+		}
+	}
+
+	var found []Entity
+	for i := 0; i < len(text); i++ {
+		if opaque[i] || (i > 0 && isWordRune(text[i-1])) || !hasURLScheme(text[i:]) {
+			continue
+		}
+
+		end := i
+		for end < len(text) && !unicode.IsSpace(text[end]) && !opaque[end] {
+			end++
+		}
+		end = trimURLTail(text[i:end]) + i
+
+		if link, ok := safeURL(string(text[i:end])); ok {
+			found = append(found, Entity{Type: EntityLink, Offset: i, Length: end - i, URL: link})
+			for k := i; k < end; k++ {
+				opaque[k] = true
+			}
+		}
+		i = end
+	}
+
+	s := string(text)
+	scanMentions(s, func(start, end int, name string) {
+		offset := utf8.RuneCountInString(s[:start])
+		if opaque[offset] {
+			return
+		}
+		found = append(found, Entity{
+			Type:     EntityMention,
+			Offset:   offset,
+			Length:   utf8.RuneCountInString(s[start:end]),
+			Username: name,
+		})
+	})
+
+	return found
+}
+
+func hasURLScheme(text []rune) bool {
+	for _, scheme := range []string{"http://", "https://"} {
+		if len(text) >= len(scheme) && strings.EqualFold(string(text[:len(scheme)]), scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimURLTail leaves sentence punctuation and an unbalanced closing bracket out of a bare url.
+func trimURLTail(link []rune) int {
+	end := len(link)
+	for end > 0 {
+		switch link[end-1] {
+		case '.', ',', ';', ':', '!', '?', '\'', '"':
+			end--
+			continue
+		case ')':
+			if strings.Count(string(link[:end]), "(") < strings.Count(string(link[:end]), ")") {
+				end--
+				continue
+			}
+		}
+		break
+	}
+	return end
+}
+
+// safeURL accepts absolute http and https urls and mailto addresses.
+func safeURL(raw string) (string, bool) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", false
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		if u.Host == "" {
+			return "", false
+		}
+	case "mailto":
+		if u.Opaque == "" {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	return u.String(), true
+}
+
+func isCodeLanguage(s string) bool {
+	if s == "" || len(s) > maxCodeLanguage {
+		return false
+	}
+	for _, r := range s {
+		if !(r < utf8.RuneSelf && (isWordRune(r) || strings.ContainsRune("+#-.", r))) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,179 @@
+package vo
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+func TestParseRichText(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		format       Format
+		expectedText string
+		expected     []Entity
+		expectedErr  error
+	}{
+		{
+			name:         "Plain keeps markup",
+			content:      "**not bold** <b>x</b>",
+			format:       FormatPlain,
+			expectedText: "**not bold** <b>x</b>",
+		},
+		{
+			name:         "Plain finds urls and mentions",
+			content:      "see https://example.com/a, @Bob",
+			format:       FormatPlain,
+			expectedText: "see https://example.com/a, @Bob",
+			expected: []Entity{
+				{Type: EntityLink, Offset: 4, Length: 21, URL: "https://example.com/a"},
+				{Type: EntityMention, Offset: 27, Length: 4, Username: "bob"},
+			},
+		},
+		{
+			name:         "Bold italic strikethrough",
+			content:      "**b** *i* _i_ ~~s~~",
+			format:       FormatMarkdown,
+			expectedText: "b i i s",
+			expected: []Entity{
+				{Type: EntityBold, Offset: 0, Length: 1},
+				{Type: EntityItalic, Offset: 2, Length: 1},
+				{Type: EntityItalic, Offset: 4, Length: 1},
+				{Type: EntityStrikethrough, Offset: 6, Length: 1},
+			},
+		},
+		{
+			name:         "Nested emphasis",
+			content:      "**bold *both***",
+			format:       FormatMarkdown,
+			expectedText: "bold both",
+			expected: []Entity{
+				{Type: EntityBold, Offset: 0, Length: 9},
+				{Type: EntityItalic, Offset: 5, Length: 4},
+			},
+		},
+		{
+			name:         "Snake case is not italic",
+			content:      "call some_func_name now",
+			format:       FormatMarkdown,
+			expectedText: "call some_func_name now",
+		},
+		{
+			name:         "Unclosed and spaced markers stay literal",
+			content:      "2 * 3 * 4 and ~x",
+			format:       FormatMarkdown,
+			expectedText: "2 * 3 * 4 and ~x",
+		},
+		{
+			name:         "Escapes",
+			content:      `\*not italic\*`,
+			format:       FormatMarkdown,
+			expectedText: "*not italic*",
+		},
+		{
+			name:         "Code span is verbatim and hides mentions",
+			content:      "run `rm *.go @bob` now",
+			format:       FormatMarkdown,
+			expectedText: "run rm *.go @bob now",
+			expected: []Entity{
+				{Type: EntityCode, Offset: 4, Length: 12},
+			},
+		},
+		{
+			name:         "Fenced block with language",
+			content:      "look:\n```Go\nfmt.Println(\"**hi**\")\n```\ndone",
+			format:       FormatMarkdown,
+			expectedText: "look:\nfmt.Println(\"**hi**\")\ndone",
+			expected: []Entity{
+				{Type: EntityPre, Offset: 6, Length: 21, Language: "go"},
+			},
+		},
+		{
+			name:         "Link",
+			content:      "[the *docs*](HTTPS://example.com/x?a=1)",
+			format:       FormatMarkdown,
+			expectedText: "the docs",
+			expected: []Entity{
+				{Type: EntityLink, Offset: 0, Length: 8, URL: "https://example.com/x?a=1"},
+				{Type: EntityItalic, Offset: 4, Length: 4},
+			},
+		},
+		{
+			name:         "Unsafe link keeps the text only",
+			content:      "[click](javascript:alert) me",
+			format:       FormatMarkdown,
+			expectedText: "click me",
+		},
+		{
+			name:         "HTML stays literal",
+			content:      "<script>alert(1)</script>",
+			format:       FormatMarkdown,
+			expectedText: "<script>alert(1)</script>",
+		},
+		{
+			name:         "Bare url drops trailing punctuation",
+			content:      "(see https://example.com/wiki/Go_(lang)).",
+			format:       FormatMarkdown,
+			expectedText: "(see https://example.com/wiki/Go_(lang)).",
+			expected: []Entity{
+				{Type: EntityLink, Offset: 5, Length: 34, URL: "https://example.com/wiki/Go_(lang)"},
+			},
+		},
+		{
+			name:         "Unicode offsets count code points",
+			content:      "привет **мир** @Дима",
+			format:       FormatMarkdown,
+			expectedText: "привет мир @Дима",
+			expected: []Entity{
+				{Type: EntityBold, Offset: 7, Length: 3},
+				{Type: EntityMention, Offset: 11, Length: 5, Username: "дима"},
+			},
+		},
+		{
+			name:         "Control and bidi characters are dropped",
+			content:      "a\r\nb\x00c\u202ed",
+			format:       FormatPlain,
+			expectedText: "a\nbcd",
+		},
+		{
+			name:        "Nothing left after markup",
+			content:     "``` \n```",
+			format:      FormatMarkdown,
+			expectedErr: msgErrors.ErrEmptyFormatted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt, err := ParseRichText(tt.content, tt.format)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("error = %v, want %v", err, tt.expectedErr)
+			}
+			if tt.expectedErr != nil {
+				return
+			}
+			if rt.Text != tt.expectedText {
+				t.Errorf("text = %q, want %q", rt.Text, tt.expectedText)
+			}
+			if len(rt.Entities) != 0 || len(tt.expected) != 0 {
+				if !reflect.DeepEqual(rt.Entities, tt.expected) {
+					t.Errorf("entities = %+v, want %+v", rt.Entities, tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for raw, expected := range map[string]Format{"": FormatPlain, "Markdown": FormatMarkdown, " plain ": FormatPlain} {
+		if f, err := ParseFormat(raw); err != nil || f != expected {
+			t.Errorf("ParseFormat(%q) = %q, %v", raw, f, err)
+		}
+	}
+	if _, err := ParseFormat("html"); !errors.Is(err, msgErrors.ErrInvalidFormat) {
+		t.Errorf("ParseFormat(html) error = %v", err)
+	}
+}
@@ -7,6 +7,7 @@ type SaveVoiceData struct {
 	UserID    uuid.UUID
 	ChatID    uuid.UUID
 	AudioURL  string
+	ObjectKey string
 	Duration  int
 	Waveform  []byte
 }
@@ -0,0 +1,57 @@
+package vo
+
+import (
+	"strings"
+	"time"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+type ScheduledStatus string
+
+const (
+	ScheduledStatusPending   ScheduledStatus = "pending"
+	ScheduledStatusSent      ScheduledStatus = "sent"
+	ScheduledStatusCancelled ScheduledStatus = "cancelled"
+)
+
+func (s ScheduledStatus) String() string {
+	return string(s)
+}
+
+const (
+	// MinScheduleDelay keeps "send now" out of the scheduler, the worker polls once a second.
+	MinScheduleDelay = 5 * time.Second
+	MaxScheduleAhead = 365 * 24 * time.Hour
+)
+
+// ScheduledUpdate carries the fields a user may change while the message is still pending.
+type ScheduledUpdate struct {
+	Content *string
+	SendAt  *time.Time
+}
+
+// ParseSendAt reads an RFC3339 time and checks it against the scheduling window.
+func ParseSendAt(raw string, now time.Time) (time.Time, error) {
+	sendAt, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, msgErrors.ErrInvalidScheduleTime
+	}
+	sendAt = sendAt.UTC()
+
+	switch {
+	case sendAt.Before(now.Add(MinScheduleDelay)):
+		return time.Time{}, msgErrors.ErrScheduleInPast
+	case sendAt.After(now.Add(MaxScheduleAhead)):
+		return time.Time{}, msgErrors.ErrScheduleTooFar
+	}
+
+	return sendAt, nil
+}
+
+func NormalizeContent(raw string) (string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return "", msgErrors.ErrEmptyContent
+	}
+	return raw, nil
+}
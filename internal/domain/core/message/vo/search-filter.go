@@ -0,0 +1,18 @@
+package vo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SearchFilter struct {
+	UserID   uuid.UUID  // caller, results are limited to chats the caller is a member of
+	Query    string     // websearch syntax: words, "phrases", -exclusions, OR
+	ChatID   *uuid.UUID // optional
+	SenderID *uuid.UUID // optional
+	From     *time.Time // inclusive, optional
+	To       *time.Time // exclusive, optional
+	Limit    int
+	Cursor   Cursor
+}
@@ -1,7 +1,10 @@
 package vo
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
 	"time"
 )
 
@@ -9,31 +12,127 @@ const SentMessageEvent = "sent-message-event"
 
 type (
 	StreamMessage struct {
-		AckID     string    `json:"ack_id"`
+		AckID string `json:"ack_id"`
+		// ID is assigned when the message is published, zero leaves it to the database sequence.
+		ID        int       `json:"id,omitempty"`
 		Event     string    `json:"event"`
 		UserID    string    `json:"user_id"`
 		ChatID    string    `json:"chat_id"`
 		Content   string    `json:"content"`
 		Timestamp time.Time `json:"timestamp"`
+		// ExpiresAt is zero unless the sender set a ttl, the chat ttl is applied by the database.
+		ExpiresAt time.Time `json:"expires_at,omitempty"`
+		// ForwardedFrom is zero for messages that were not forwarded.
+		ForwardedFrom ForwardOrigin `json:"forwarded_from"`
+		// Format is empty for messages that did not go through ParseRichText, they are stored as plain.
+		Format   Format   `json:"format,omitempty"`
+		Entities []Entity `json:"entities,omitempty"`
 	}
 )
 
 const (
+	idMapKey      = "id"
 	eventMapKey   = "event"
 	userIDMapKey  = "user_id"
 	chatIDMapKey  = "chat_id"
 	contentMapKey = "content"
 	timestampKey  = "timestamp"
+	expiresAtKey  = "expires_at"
+	formatKey     = "format"
+	entitiesKey   = "entities"
+
+	forwardedFromMessageKey = "forwarded_from_message_id"
+	forwardedFromUserKey    = "forwarded_from_user_id"
+	forwardedFromChatKey    = "forwarded_from_chat_id"
 )
 
 func (m StreamMessage) ToMap() map[string]any {
-	return map[string]any{
+	data := map[string]any{
 		"event":     m.Event,
 		"user_id":   m.UserID,
 		"chat_id":   m.ChatID,
 		"content":   m.Content,
 		"timestamp": m.Timestamp.UTC().Format(time.RFC3339Nano),
 	}
+	if m.ID != 0 {
+		data[idMapKey] = strconv.Itoa(m.ID)
+	}
+	if !m.ExpiresAt.IsZero() {
+		data[expiresAtKey] = m.ExpiresAt.UTC().Format(time.RFC3339Nano)
+	}
+	if m.Format != "" {
+		data[formatKey] = string(m.Format)
+	}
+	if len(m.Entities) > 0 {
+		// entities are validated before publishing, they always encode
+		entities, _ := json.Marshal(m.Entities)
+		data[entitiesKey] = string(entities)
+	}
+	if !m.ForwardedFrom.IsZero() {
+		data[forwardedFromMessageKey] = strconv.Itoa(m.ForwardedFrom.MessageID)
+		data[forwardedFromUserKey] = m.ForwardedFrom.UserID
+		data[forwardedFromChatKey] = m.ForwardedFrom.ChatID
+	}
+	return data
+}
+
+// FormatOrPlain is what the store writes.
+func (m StreamMessage) FormatOrPlain() Format {
+	if m.Format == "" {
+		return FormatPlain
+	}
+	return m.Format
+}
+
+// Mentions of a parsed message come from its entities, so a mention inside code is not one.
+func (m StreamMessage) Mentions() Mentions {
+	if m.Format == "" {
+		return ParseMentions(m.Content)
+	}
+
+	var (
+		mentions Mentions
+		seen     = make(map[string]struct{})
+	)
+	for _, e := range m.Entities {
+		if e.Type != EntityMention {
+			continue
+		}
+		if e.Username == MentionAll {
+			mentions.All = true
+			continue
+		}
+		if _, ok := seen[e.Username]; ok || len(mentions.Usernames) == MaxMentions {
+			continue
+		}
+		seen[e.Username] = struct{}{}
+		mentions.Usernames = append(mentions.Usernames, e.Username)
+	}
+	return mentions
+}
+
+// ExpiresAtOrNil is what the store writes, NULL lets the chat ttl decide.
+func (m StreamMessage) ExpiresAtOrNil() *time.Time {
+	if m.ExpiresAt.IsZero() {
+		return nil
+	}
+	return &m.ExpiresAt
+}
+
+// StreamChatIDs lists the chats a batch of messages went to, each once and sorted,
+// so concurrent batches lock the chats' last message rows in the same order.
+func StreamChatIDs(messages []StreamMessage) []string {
+	seen := make(map[string]struct{}, len(messages))
+	chatIDs := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if _, ok := seen[m.ChatID]; ok {
+			continue
+		}
+		seen[m.ChatID] = struct{}{}
+		chatIDs = append(chatIDs, m.ChatID)
+	}
+	slices.Sort(chatIDs)
+	return chatIDs
 }
 
 func ParseStreamMessage(ackID string, data map[string]any) (StreamMessage, error) {
@@ -41,6 +140,14 @@ func ParseStreamMessage(ackID string, data map[string]any) (StreamMessage, error
 
 	result.AckID = ackID
 
+	if idStr, ok := data[idMapKey].(string); ok {
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			return StreamMessage{}, fmt.Errorf("invalid id: %w", err)
+		}
+		result.ID = id
+	}
+
 	if chatID, ok := data[chatIDMapKey].(string); ok {
 		result.ChatID = chatID
 	} else {
@@ -75,5 +182,35 @@ func ParseStreamMessage(ackID string, data map[string]any) (StreamMessage, error
 		return StreamMessage{}, fmt.Errorf("invalid or missing timestamp")
 	}
 
+	if expStr, ok := data[expiresAtKey].(string); ok {
+		exp, err := time.Parse(time.RFC3339Nano, expStr)
+		if err != nil {
+			return StreamMessage{}, fmt.Errorf("invalid expires_at format: %w", err)
+		}
+		result.ExpiresAt = exp
+	}
+
+	if format, ok := data[formatKey].(string); ok {
+		result.Format = Format(format)
+	}
+
+	if entities, ok := data[entitiesKey].(string); ok {
+		if err := json.Unmarshal([]byte(entities), &result.Entities); err != nil {
+			return StreamMessage{}, fmt.Errorf("invalid entities: %w", err)
+		}
+	}
+
+	if userID, ok := data[forwardedFromUserKey].(string); ok {
+		result.ForwardedFrom.UserID = userID
+		result.ForwardedFrom.ChatID, _ = data[forwardedFromChatKey].(string)
+		if idStr, ok := data[forwardedFromMessageKey].(string); ok {
+			id, err := strconv.Atoi(idStr)
+			if err != nil {
+				return StreamMessage{}, fmt.Errorf("invalid forwarded_from_message_id: %w", err)
+			}
+			result.ForwardedFrom.MessageID = id
+		}
+	}
+
 	return result, nil
 }
@@ -0,0 +1,25 @@
+package vo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStreamChatIDs(t *testing.T) {
+	messages := []StreamMessage{
+		{ChatID: "c0000000-0000-0000-0000-000000000000"},
+		{ChatID: "a0000000-0000-0000-0000-000000000000"},
+		{ChatID: "c0000000-0000-0000-0000-000000000000"},
+		{ChatID: "b0000000-0000-0000-0000-000000000000"},
+	}
+
+	expected := []string{
+		"a0000000-0000-0000-0000-000000000000",
+		"b0000000-0000-0000-0000-000000000000",
+		"c0000000-0000-0000-0000-000000000000",
+	}
+
+	if got := StreamChatIDs(messages); !slices.Equal(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
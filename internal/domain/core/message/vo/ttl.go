@@ -0,0 +1,26 @@
+package vo
+
+import (
+	"time"
+
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+)
+
+const (
+	MinMessageTTL = 5 * time.Second
+	MaxMessageTTL = 365 * 24 * time.Hour
+)
+
+// ParseTTL checks a ttl given in seconds. Zero means the message does not expire on its own.
+func ParseTTL(seconds int) (time.Duration, error) {
+	if seconds == 0 {
+		return 0, nil
+	}
+
+	ttl := time.Duration(seconds) * time.Second
+	if ttl < MinMessageTTL || ttl > MaxMessageTTL {
+		return 0, msgErrors.ErrInvalidTTL
+	}
+
+	return ttl, nil
+}
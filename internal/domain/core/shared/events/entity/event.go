@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"awesome-chat/internal/domain/core/shared/events/vo"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatEvent is fanned out to every ws-server node and delivered to all clients of the chat.
+type ChatEvent struct {
+	Type       vo.Type         `json:"type"`
+	ChatID     uuid.UUID       `json:"chat_id"`
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	// RecipientID limits delivery to the clients of one chat member.
+	RecipientID *uuid.UUID `json:"recipient_id,omitempty"`
+	// RevokedID loses the chat subscription on every node once the event is delivered,
+	// together with RecipientID only that member hears of it.
+	RevokedID *uuid.UUID `json:"revoked_id,omitempty"`
+	// GrantedID subscribes the live connections of the member to the chat on every node before the event is delivered.
+	GrantedID *uuid.UUID `json:"granted_id,omitempty"`
+}
+
+func NewChatEvent(eventType vo.Type, chatID uuid.UUID, payload any) (ChatEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return ChatEvent{}, err
+	}
+
+	return ChatEvent{
+		Type:       eventType,
+		ChatID:     chatID,
+		Payload:    data,
+		OccurredAt: time.Now().UTC(),
+	}, nil
+}
+
+// NewUserChatEvent builds an event only the given member of the chat receives, e.g. on their other devices.
+func NewUserChatEvent(eventType vo.Type, chatID, userID uuid.UUID, payload any) (ChatEvent, error) {
+	event, err := NewChatEvent(eventType, chatID, payload)
+	if err != nil {
+		return ChatEvent{}, err
+	}
+	event.RecipientID = &userID
+
+	return event, nil
+}
+
+// NewRevokingChatEvent builds an event the whole chat receives, after which the user no longer gets anything of the chat.
+func NewRevokingChatEvent(eventType vo.Type, chatID, userID uuid.UUID, payload any) (ChatEvent, error) {
+	event, err := NewChatEvent(eventType, chatID, payload)
+	if err != nil {
+		return ChatEvent{}, err
+	}
+	event.RevokedID = &userID
+
+	return event, nil
+}
+
+// NewGrantingChatEvent builds an event the whole chat receives, the user who joined included.
+func NewGrantingChatEvent(eventType vo.Type, chatID, userID uuid.UUID, payload any) (ChatEvent, error) {
+	event, err := NewChatEvent(eventType, chatID, payload)
+	if err != nil {
+		return ChatEvent{}, err
+	}
+	event.GrantedID = &userID
+
+	return event, nil
+}
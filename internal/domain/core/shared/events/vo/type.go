@@ -0,0 +1,44 @@
+package vo
+
+// Type is sent to clients as the operation_type of the ws frame.
+type Type string
+
+const (
+	AttachmentReady   Type = "attachment_ready"
+	LinkPreviewReady  Type = "link_preview_ready"
+	MessagePinned     Type = "message_pinned"
+	MessageUnpinned   Type = "message_unpinned"
+	MessageTTLChanged Type = "message_ttl_changed"
+	MessagesExpired   Type = "messages_expired"
+	MessageDeleted    Type = "message_deleted"
+	// MessageSent carries a message that did not come through a ws connection, e.g. a scheduled one.
+	MessageSent Type = "message_sent"
+	// DraftUpdated is sent to the author only.
+	DraftUpdated Type = "draft_updated"
+	// Mentioned is sent to the mentioned user only, in a muted chat it carries muted and @all mentions are not sent.
+	Mentioned   Type = "mentioned"
+	PollCreated Type = "poll_created"
+	// PollUpdated carries the new tally, my_vote is left out since the event goes to the whole chat.
+	PollUpdated Type = "poll_updated"
+	// ReceiptUpdated carries the receipt cursors of a member, it is only sent in chats up to the receipt threshold.
+	ReceiptUpdated Type = "receipt_updated"
+	// ReceiptCountsUpdated is sent to the sender only, with the counts of their messages in a large chat.
+	ReceiptCountsUpdated Type = "receipt_counts_updated"
+	MemberRoleChanged    Type = "member_role_changed"
+	// MemberRemoved also covers leaving, the removed user gets it before losing the chat on every node.
+	MemberRemoved Type = "member_removed"
+	MemberJoined  Type = "member_joined"
+	// JoinRequested and JoinRequestDecided keep the approval queue of admins in sync.
+	JoinRequested      Type = "join_requested"
+	JoinRequestDecided Type = "join_request_decided"
+	// ChatUpdated carries the whole metadata of the chat after a rename, description or avatar change.
+	ChatUpdated Type = "chat_updated"
+	// ChatPreferencesUpdated is sent to the member only, so their other devices follow mute, archive and pins.
+	ChatPreferencesUpdated Type = "chat_preferences_updated"
+	// ExportFinished is sent to the requester only, with a download url when the export is ready.
+	ExportFinished Type = "export_finished"
+)
+
+func (t Type) String() string {
+	return string(t)
+}
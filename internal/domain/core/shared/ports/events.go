@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"awesome-chat/internal/domain/core/shared/events/entity"
+	"context"
+)
+
+type ChatEventPublisher interface {
+	PublishChatEvent(ctx context.Context, event entity.ChatEvent) error
+}
+
+type ChatEventBroadcaster interface {
+	BroadcastChatEvent(ctx context.Context, event entity.ChatEvent) error
+}
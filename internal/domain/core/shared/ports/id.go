@@ -0,0 +1,6 @@
+package ports
+
+// IDGenerator hands out ids that are unique across processes and sort by creation time.
+type IDGenerator interface {
+	NextID() int
+}
@@ -0,0 +1,16 @@
+package s3
+
+import (
+	"context"
+	"io"
+)
+
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
+type ObjectReader interface {
+	Stat(ctx context.Context, objID string) (ObjectInfo, error)
+	Open(ctx context.Context, objID string) (io.ReadCloser, error)
+}
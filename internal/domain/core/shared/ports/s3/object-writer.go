@@ -0,0 +1,15 @@
+package s3
+
+import (
+	"context"
+	"io"
+)
+
+type ObjectWriter interface {
+	Put(ctx context.Context, objID string, data []byte, contentType string) error
+}
+
+// StreamWriter uploads an object of unknown size in parts, so it is never held in memory as a whole.
+type StreamWriter interface {
+	PutStream(ctx context.Context, objID string, r io.Reader, contentType string) (size int64, err error)
+}
@@ -6,3 +6,7 @@ type Storage interface {
 	Add(ctx context.Context, objID string, data []byte) (err error)
 	Delete(ctx context.Context, objID string) (err error)
 }
+
+type BucketEnsurer interface {
+	EnsureBucket(ctx context.Context) error
+}
@@ -0,0 +1,7 @@
+package s3
+
+import "context"
+
+type UploadURLService interface {
+	GenerateUploadURL(ctx context.Context, objID string) (string, error)
+}
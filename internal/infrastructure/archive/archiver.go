@@ -0,0 +1,105 @@
+package archive
+
+import (
+	"archive/zip"
+	"awesome-chat/internal/domain/core/export/entity"
+	"awesome-chat/internal/domain/core/export/ports"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const (
+	chatEntry         = "chat.json"
+	participantsEntry = "participants.json"
+	messagesEntry     = "messages.json"
+	transcriptEntry   = "transcript.html"
+)
+
+var _ ports.Archiver = (*Archiver)(nil)
+
+// Archiver writes a ZIP with the chat as JSON and a transcript for people to read.
+// Entries are written one after another straight into the output, so memory does not grow with the chat.
+type Archiver struct{}
+
+func NewArchiver() *Archiver {
+	return &Archiver{}
+}
+
+func (a *Archiver) Write(ctx context.Context, w io.Writer, src ports.Source) (int, error) {
+	zw := zip.NewWriter(w)
+
+	if err := writeObject(zw, chatEntry, src.Chat()); err != nil {
+		return 0, err
+	}
+
+	if _, err := writeArray(zw, participantsEntry, func(fn func(entity.Participant) error) error {
+		return src.EachParticipant(ctx, fn)
+	}); err != nil {
+		return 0, err
+	}
+
+	messages, err := writeArray(zw, messagesEntry, func(fn func(entity.Message) error) error {
+		return src.EachMessage(ctx, fn)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err = writeTranscript(ctx, zw, src); err != nil {
+		return 0, err
+	}
+
+	if err = zw.Close(); err != nil {
+		return 0, fmt.Errorf("failed to finish archive: %w", err)
+	}
+
+	return messages, nil
+}
+
+func writeObject(zw *zip.Writer, name string, v any) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", name, err)
+	}
+
+	if err = json.NewEncoder(f).Encode(v); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// writeArray encodes a JSON array item by item as walk hands them out and reports how many there were.
+func writeArray[T any](zw *zip.Writer, name string, walk func(fn func(T) error) error) (int, error) {
+	f, err := zw.Create(name)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create %s: %w", name, err)
+	}
+
+	if _, err = io.WriteString(f, "["); err != nil {
+		return 0, fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	enc := json.NewEncoder(f)
+	count := 0
+	err = walk(func(item T) error {
+		if count > 0 {
+			if _, writeErr := io.WriteString(f, ","); writeErr != nil {
+				return writeErr
+			}
+		}
+		count++
+		return enc.Encode(item)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	if _, err = io.WriteString(f, "]\n"); err != nil {
+		return 0, fmt.Errorf("failed to write %s: %w", name, err)
+	}
+
+	return count, nil
+}
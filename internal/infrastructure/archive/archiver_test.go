@@ -0,0 +1,168 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"awesome-chat/internal/domain/core/export/entity"
+
+	"github.com/google/uuid"
+)
+
+type fakeSource struct {
+	chat         entity.Chat
+	participants []entity.Participant
+	messages     []entity.Message
+}
+
+func (s fakeSource) Chat() entity.Chat {
+	return s.chat
+}
+
+func (s fakeSource) EachParticipant(_ context.Context, fn func(entity.Participant) error) error {
+	for _, p := range s.participants {
+		if err := fn(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s fakeSource) EachMessage(_ context.Context, fn func(entity.Message) error) error {
+	for _, m := range s.messages {
+		if err := fn(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readEntries(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("archive is not a zip: %v", err)
+	}
+
+	entries := make(map[string]string, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		body, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		entries[f.Name] = string(body)
+	}
+	return entries
+}
+
+func TestArchiverWrite(t *testing.T) {
+	sender := uuid.New()
+	replyTo := 1
+	src := fakeSource{
+		chat: entity.Chat{ID: uuid.New(), Name: "Team <chat>", Type: "group", ExportedUntil: time.Now()},
+		participants: []entity.Participant{
+			{UserID: sender, Username: "alice", Role: "owner"},
+			{UserID: uuid.New(), Username: "bob", Role: "member"},
+		},
+		messages: []entity.Message{
+			{ID: 1, Type: "text", SenderID: sender, SenderName: "alice", Content: "<script>alert(1)</script>"},
+			{
+				ID: 2, Type: "file", SenderID: sender, SenderName: "alice", ReplyToID: &replyTo,
+				Attachments: []entity.AttachmentRef{{ID: uuid.New(), Kind: "file", FileName: "report.pdf", SizeBytes: 2048, ObjectKey: "k1"}},
+			},
+			{ID: 3, Type: "system", SenderID: sender, SenderName: "alice"},
+		},
+	}
+
+	var buf bytes.Buffer
+	count, err := NewArchiver().Write(context.Background(), &buf, src)
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if count != len(src.messages) {
+		t.Errorf("count = %d, want %d", count, len(src.messages))
+	}
+
+	entries := readEntries(t, buf.Bytes())
+	for _, name := range []string{chatEntry, participantsEntry, messagesEntry, transcriptEntry} {
+		if _, ok := entries[name]; !ok {
+			t.Fatalf("missing entry %s", name)
+		}
+	}
+
+	var messages []entity.Message
+	if err = json.Unmarshal([]byte(entries[messagesEntry]), &messages); err != nil {
+		t.Fatalf("messages.json: %v", err)
+	}
+	if len(messages) != 3 || messages[1].Attachments[0].ObjectKey != "k1" {
+		t.Errorf("unexpected messages: %+v", messages)
+	}
+
+	var participants []entity.Participant
+	if err = json.Unmarshal([]byte(entries[participantsEntry]), &participants); err != nil {
+		t.Fatalf("participants.json: %v", err)
+	}
+	if len(participants) != 2 {
+		t.Errorf("participants = %d, want 2", len(participants))
+	}
+
+	html := entries[transcriptEntry]
+	if strings.Contains(html, "<script>") || !strings.Contains(html, "&lt;script&gt;") {
+		t.Error("message content is not escaped")
+	}
+	if !strings.Contains(html, "Team &lt;chat&gt;") {
+		t.Error("chat name is not escaped")
+	}
+	for _, want := range []string{"report.pdf, file, 2.0 KB", `href="#m1"`, "system message"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("transcript misses %q", want)
+		}
+	}
+}
+
+func TestArchiverWriteEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	count, err := NewArchiver().Write(context.Background(), &buf, fakeSource{chat: entity.Chat{Name: "empty"}})
+	if err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("count = %d, want 0", count)
+	}
+
+	entries := readEntries(t, buf.Bytes())
+	if got := strings.TrimSpace(entries[messagesEntry]); got != "[]" {
+		t.Errorf("messages.json = %q, want []", got)
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KB"},
+		{5 << 20, "5.0 MB"},
+		{3 << 30, "3.0 GB"},
+	}
+
+	for _, tt := range tests {
+		if got := formatSize(tt.n); got != tt.want {
+			t.Errorf("formatSize(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
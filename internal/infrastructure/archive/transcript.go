@@ -0,0 +1,106 @@
+package archive
+
+import (
+	"archive/zip"
+	"awesome-chat/internal/domain/core/export/entity"
+	"awesome-chat/internal/domain/core/export/ports"
+	"context"
+	"fmt"
+	"html/template"
+	"time"
+)
+
+const timeLayout = "2006-01-02 15:04"
+
+// the transcript is rendered piece by piece, header and footer once and the message template per message
+var transcript = template.Must(template.New("transcript").Funcs(template.FuncMap{
+	"time": formatTime,
+	"size": formatSize,
+}).Parse(`
+{{- define "header" -}}
+<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+<style>
+body { font-family: sans-serif; max-width: 48em; margin: 2em auto; color: #222; }
+.meta { color: #777; font-size: 0.9em; }
+.message { margin: 0.8em 0; }
+.content { white-space: pre-wrap; }
+.system { color: #777; font-style: italic; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+{{- if .Description}}
+<p>{{.Description}}</p>
+{{- end}}
+<p class="meta">Exported messages up to {{time .ExportedUntil}} UTC</p>
+{{end -}}
+
+{{- define "message" -}}
+<div class="message" id="m{{.ID}}">
+<div class="meta">{{time .CreatedAt}} <strong>{{.SenderName}}</strong>
+{{- if .ReplyToID}} in reply to <a href="#m{{.ReplyToID}}">a message</a>{{end}}</div>
+{{- if .Content}}
+<div class="content">{{.Content}}</div>
+{{- else if not (or .Voice .Attachments)}}
+<div class="system">{{.Type}} message</div>
+{{- end}}
+{{- if .Voice}}
+<div class="meta">Voice message, {{.Voice.Duration}}s ({{.Voice.ObjectKey}})</div>
+{{- end}}
+{{- range .Attachments}}
+<div class="meta">Attachment: {{.FileName}}, {{.Kind}}, {{size .SizeBytes}} ({{.ObjectKey}})</div>
+{{- end}}
+</div>
+{{end -}}
+
+{{- define "footer" -}}
+</body>
+</html>
+{{end -}}
+`))
+
+func writeTranscript(ctx context.Context, zw *zip.Writer, src ports.Source) error {
+	f, err := zw.Create(transcriptEntry)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", transcriptEntry, err)
+	}
+
+	if err = transcript.ExecuteTemplate(f, "header", src.Chat()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", transcriptEntry, err)
+	}
+
+	if err = src.EachMessage(ctx, func(msg entity.Message) error {
+		return transcript.ExecuteTemplate(f, "message", msg)
+	}); err != nil {
+		return fmt.Errorf("failed to write %s: %w", transcriptEntry, err)
+	}
+
+	if err = transcript.ExecuteTemplate(f, "footer", nil); err != nil {
+		return fmt.Errorf("failed to write %s: %w", transcriptEntry, err)
+	}
+
+	return nil
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
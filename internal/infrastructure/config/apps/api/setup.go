@@ -4,8 +4,10 @@ import (
 	"awesome-chat/internal/infrastructure/config/http"
 	"awesome-chat/internal/infrastructure/config/http/wsServerApi"
 	"awesome-chat/internal/infrastructure/config/jwt"
+	"awesome-chat/internal/infrastructure/config/minio"
 	"awesome-chat/internal/infrastructure/config/postgres"
 	"awesome-chat/internal/infrastructure/config/redis"
+	"awesome-chat/internal/infrastructure/config/snowflake"
 	"os"
 
 	"github.com/ilyakaznacheev/cleanenv"
@@ -19,6 +21,10 @@ type Config struct {
 	HTTPServer       http.Config        `yaml:"http"`
 	WSServerAPI      wsServerApi.Config `yaml:"ws_server_api"`
 	JWT              jwt.Config         `yaml:"jwt"`
+	S3               minio.Config       `yaml:"s3"`
+	Cache            redis.Config       `yaml:"cache"`
+	EventPublisher   redis.Config       `yaml:"event_publisher"`
+	IDs              snowflake.Config   `yaml:"ids"`
 }
 
 func NewConfig() *Config {
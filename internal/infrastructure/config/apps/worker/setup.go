@@ -1,8 +1,11 @@
 package worker
 
 import (
+	"awesome-chat/internal/infrastructure/config/minio"
 	"awesome-chat/internal/infrastructure/config/postgres"
 	"awesome-chat/internal/infrastructure/config/redis"
+	"awesome-chat/internal/infrastructure/config/snowflake"
+	"awesome-chat/internal/infrastructure/config/unfurl"
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
 )
@@ -10,8 +13,13 @@ import (
 const basicConfigPath = "./configs/worker/prod.yaml"
 
 type Config struct {
-	Storage          postgres.Config `yaml:"storage"`
-	StreamSubscriber redis.Config    `yaml:"stream_subscriber"`
+	Storage          postgres.Config  `yaml:"storage"`
+	StreamSubscriber redis.Config     `yaml:"stream_subscriber"`
+	EventPublisher   redis.Config     `yaml:"event_publisher"`
+	EventSubscriber  redis.Config     `yaml:"event_subscriber"`
+	S3               minio.Config     `yaml:"s3"`
+	Unfurl           unfurl.Config    `yaml:"unfurl"`
+	IDs              snowflake.Config `yaml:"ids"`
 }
 
 func NewConfig() *Config {
@@ -2,7 +2,9 @@ package wsServer
 
 import (
 	"awesome-chat/internal/infrastructure/config/http"
+	"awesome-chat/internal/infrastructure/config/postgres"
 	"awesome-chat/internal/infrastructure/config/redis"
+	"awesome-chat/internal/infrastructure/config/snowflake"
 	"github.com/ilyakaznacheev/cleanenv"
 	"os"
 )
@@ -10,9 +12,13 @@ import (
 const basicConfigPath = "./configs/ws-server/prod.yaml"
 
 type Config struct {
-	AppEnv     string       `yaml:"app_env" env-default:"prod"`
-	HTTPServer http.Config  `yaml:"http"`
-	Redis      redis.Config `yaml:"redis"`
+	AppEnv          string           `yaml:"app_env" env-default:"prod"`
+	HTTPServer      http.Config      `yaml:"http"`
+	Storage         postgres.Config  `yaml:"storage"`
+	Redis           redis.Config     `yaml:"redis"`
+	EventSubscriber redis.Config     `yaml:"event_subscriber"`
+	EventPublisher  redis.Config     `yaml:"event_publisher"`
+	IDs             snowflake.Config `yaml:"ids"`
 }
 
 func NewConfig() *Config {
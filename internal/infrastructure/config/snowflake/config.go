@@ -0,0 +1,7 @@
+package snowflake
+
+// Config names the process in the ids it generates. NodeID is where the process starts looking for a free node,
+// the one it runs on is leased at startup so replicas sharing a config still get their own.
+type Config struct {
+	NodeID int `yaml:"node_id" env:"SNOWFLAKE_NODE_ID" env-default:"0"`
+}
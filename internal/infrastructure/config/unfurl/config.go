@@ -0,0 +1,10 @@
+package unfurl
+
+import "time"
+
+type Config struct {
+	Timeout      time.Duration `yaml:"timeout" env-default:"5s"`
+	MaxBodyBytes int64         `yaml:"max_body_bytes" env-default:"524288"`
+	MaxRedirects int           `yaml:"max_redirects" env-default:"3"`
+	UserAgent    string        `yaml:"user_agent" env-default:"awesome-chat-unfurler/1.0"`
+}
@@ -0,0 +1,121 @@
+package imaging
+
+import (
+	"errors"
+	"image"
+	"math"
+	"strings"
+)
+
+// BlurHash encoding, see https://github.com/woltapp/blurhash/blob/master/Algorithm.md
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+var errInvalidComponents = errors.New("blurhash components must be between 1 and 9")
+
+// encodeBlurhash builds the placeholder from img; xComp and yComp set the level of detail.
+// The image is expected to be small already, the cost is O(w*h*xComp*yComp).
+func encodeBlurhash(img *image.RGBA, xComp, yComp int) (string, error) {
+	if xComp < 1 || xComp > 9 || yComp < 1 || yComp > 9 {
+		return "", errInvalidComponents
+	}
+
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	factors := make([][3]float64, 0, xComp*yComp)
+	for j := 0; j < yComp; j++ {
+		for i := 0; i < xComp; i++ {
+			factors = append(factors, multiplyBasis(img, w, h, i, j))
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(encode83((xComp-1)+(yComp-1)*9, 1))
+
+	dc, ac := factors[0], factors[1:]
+
+	maxValue := 1.0
+	if len(ac) > 0 {
+		actualMax := 0.0
+		for _, f := range ac {
+			actualMax = math.Max(actualMax, math.Max(math.Abs(f[0]), math.Max(math.Abs(f[1]), math.Abs(f[2]))))
+		}
+		quantisedMax := clampInt(int(math.Floor(actualMax*166-0.5)), 0, 82)
+		maxValue = float64(quantisedMax+1) / 166
+		sb.WriteString(encode83(quantisedMax, 1))
+	} else {
+		sb.WriteString(encode83(0, 1))
+	}
+
+	sb.WriteString(encode83(encodeDC(dc), 4))
+	for _, f := range ac {
+		sb.WriteString(encode83(encodeAC(f, maxValue), 2))
+	}
+
+	return sb.String(), nil
+}
+
+func multiplyBasis(img *image.RGBA, w, h, i, j int) [3]float64 {
+	var r, g, b float64
+	for y := 0; y < h; y++ {
+		basisY := math.Cos(math.Pi * float64(j) * float64(y) / float64(h))
+		row := img.Pix[y*img.Stride:]
+		for x := 0; x < w; x++ {
+			basis := math.Cos(math.Pi*float64(i)*float64(x)/float64(w)) * basisY
+			r += basis * sRGBToLinear(row[x*4])
+			g += basis * sRGBToLinear(row[x*4+1])
+			b += basis * sRGBToLinear(row[x*4+2])
+		}
+	}
+
+	normalisation := 2.0
+	if i == 0 && j == 0 {
+		normalisation = 1
+	}
+	scale := normalisation / float64(w*h)
+
+	return [3]float64{r * scale, g * scale, b * scale}
+}
+
+func encodeDC(v [3]float64) int {
+	return linearToSRGB(v[0])<<16 + linearToSRGB(v[1])<<8 + linearToSRGB(v[2])
+}
+
+func encodeAC(v [3]float64, maxValue float64) int {
+	quant := func(c float64) int {
+		return clampInt(int(math.Floor(signPow(c/maxValue, 0.5)*9+9.5)), 0, 18)
+	}
+	return quant(v[0])*19*19 + quant(v[1])*19 + quant(v[2])
+}
+
+func encode83(value, length int) string {
+	out := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		out[i] = base83Chars[value%83]
+		value /= 83
+	}
+	return string(out)
+}
+
+func sRGBToLinear(c uint8) float64 {
+	v := float64(c) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) int {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
+
+func clampInt(v, lo, hi int) int {
+	return max(lo, min(hi, v))
+}
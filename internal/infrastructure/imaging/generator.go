@@ -0,0 +1,106 @@
+package imaging
+
+import (
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"awesome-chat/internal/domain/core/attachment/ports"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	_ "image/gif"
+)
+
+const (
+	// maxInputBytes is a bit above the image attachment limit.
+	maxInputBytes = 25 << 20
+	// maxPixels guards against decompression bombs, a tiny file can declare a huge canvas.
+	maxPixels = 50_000_000
+
+	jpegQuality = 82
+
+	blurhashSide       = 32
+	blurhashXComponent = 4
+	blurhashYComponent = 3
+)
+
+type PreviewGenerator struct{}
+
+func NewPreviewGenerator() *PreviewGenerator {
+	return &PreviewGenerator{}
+}
+
+// Generate decodes a JPEG, PNG or GIF (first frame) and renders a thumbnail per size.
+// Opaque images are encoded as JPEG, images with transparency as PNG.
+func (g *PreviewGenerator) Generate(r io.Reader, sizes ...vo.PreviewSize) (ports.GeneratedPreviews, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxInputBytes+1))
+	if err != nil {
+		return ports.GeneratedPreviews{}, fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(data) > maxInputBytes {
+		return ports.GeneratedPreviews{}, attachmentErrors.ErrImageTooLarge
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return ports.GeneratedPreviews{}, attachmentErrors.ErrUnsupportedImage
+		}
+		return ports.GeneratedPreviews{}, fmt.Errorf("failed to decode image config: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return ports.GeneratedPreviews{}, attachmentErrors.ErrImageTooLarge
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return ports.GeneratedPreviews{}, fmt.Errorf("failed to decode image: %w", err)
+	}
+	src := toRGBA(decoded)
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+
+	result := ports.GeneratedPreviews{
+		Width:      w,
+		Height:     h,
+		Thumbnails: make([]ports.Thumbnail, 0, len(sizes)),
+	}
+
+	opaque := src.Opaque()
+	for _, size := range sizes {
+		tw, th := fit(w, h, size.MaxSide())
+		thumb, encErr := encode(resize(src, tw, th), opaque)
+		if encErr != nil {
+			return ports.GeneratedPreviews{}, fmt.Errorf("failed to encode %s thumbnail: %w", size, encErr)
+		}
+		thumb.Size, thumb.Width, thumb.Height = size, tw, th
+		result.Thumbnails = append(result.Thumbnails, thumb)
+	}
+
+	bw, bh := fit(w, h, blurhashSide)
+	result.Blurhash, err = encodeBlurhash(resize(src, bw, bh), blurhashXComponent, blurhashYComponent)
+	if err != nil {
+		return ports.GeneratedPreviews{}, fmt.Errorf("failed to encode blurhash: %w", err)
+	}
+
+	return result, nil
+}
+
+func encode(img *image.RGBA, opaque bool) (ports.Thumbnail, error) {
+	var buf bytes.Buffer
+
+	if opaque {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return ports.Thumbnail{}, err
+		}
+		return ports.Thumbnail{MimeType: "image/jpeg", Ext: ".jpg", Data: buf.Bytes()}, nil
+	}
+
+	if err := png.Encode(&buf, img); err != nil {
+		return ports.Thumbnail{}, err
+	}
+	return ports.Thumbnail{MimeType: "image/png", Ext: ".png", Data: buf.Bytes()}, nil
+}
@@ -0,0 +1,110 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"awesome-chat/internal/domain/core/attachment/vo"
+)
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name          string
+		w, h, maxSide int
+		wantW, wantH  int
+	}{
+		{"smaller than bound", 100, 50, 160, 100, 50},
+		{"landscape", 1600, 900, 160, 160, 90},
+		{"portrait", 900, 1600, 160, 90, 160},
+		{"square", 2000, 2000, 480, 480, 480},
+		{"very thin", 10000, 1, 160, 160, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := fit(tt.w, tt.h, tt.maxSide)
+			if w != tt.wantW || h != tt.wantH {
+				t.Errorf("fit(%d, %d, %d) = %dx%d, want %dx%d", tt.w, tt.h, tt.maxSide, w, h, tt.wantW, tt.wantH)
+			}
+		})
+	}
+}
+
+func TestEncodeBlurhash(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 6))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	got, err := encodeBlurhash(img, 4, 3)
+	if err != nil {
+		t.Fatalf("encodeBlurhash() error = %v", err)
+	}
+
+	// size flag + max AC + DC + 11 AC components
+	if len(got) != 1+1+4+11*2 {
+		t.Errorf("encodeBlurhash() = %q, want 28 chars", got)
+	}
+	if got[:1] != "L" {
+		t.Errorf("encodeBlurhash() size flag = %q, want %q", got[:1], "L")
+	}
+	if got[2:6] != "TSUA" {
+		t.Errorf("encodeBlurhash() DC = %q, want white %q", got[2:6], "TSUA")
+	}
+
+	if _, err = encodeBlurhash(img, 0, 3); err == nil {
+		t.Error("encodeBlurhash() expected error for invalid components")
+	}
+}
+
+func TestPreviewGenerator_Generate(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 640, 320))
+	for y := 0; y < 320; y++ {
+		for x := 0; x < 640; x++ {
+			src.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewPreviewGenerator().Generate(&buf, vo.PreviewSizes...)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if got.Width != 640 || got.Height != 320 {
+		t.Errorf("Generate() size = %dx%d, want 640x320", got.Width, got.Height)
+	}
+	if len(got.Blurhash) != 28 {
+		t.Errorf("Generate() blurhash = %q, want 28 chars", got.Blurhash)
+	}
+
+	want := map[vo.PreviewSize][2]int{
+		vo.PreviewSizeSmall:  {160, 80},
+		vo.PreviewSizeMedium: {480, 240},
+		vo.PreviewSizeLarge:  {640, 320},
+	}
+	if len(got.Thumbnails) != len(want) {
+		t.Fatalf("Generate() thumbnails = %d, want %d", len(got.Thumbnails), len(want))
+	}
+	for _, thumb := range got.Thumbnails {
+		if dims := want[thumb.Size]; thumb.Width != dims[0] || thumb.Height != dims[1] {
+			t.Errorf("%s thumbnail = %dx%d, want %dx%d", thumb.Size, thumb.Width, thumb.Height, dims[0], dims[1])
+		}
+		if thumb.MimeType != "image/jpeg" {
+			t.Errorf("%s thumbnail mime = %s, want image/jpeg", thumb.Size, thumb.MimeType)
+		}
+		if _, _, err = image.Decode(bytes.NewReader(thumb.Data)); err != nil {
+			t.Errorf("%s thumbnail is not decodable: %v", thumb.Size, err)
+		}
+	}
+
+	if _, err = NewPreviewGenerator().Generate(bytes.NewReader([]byte("not an image"))); err == nil {
+		t.Error("Generate() expected error for garbage input")
+	}
+}
@@ -7,5 +7,8 @@ func (n Name) String() string {
 }
 
 const (
-	Voices Name = "voices"
+	Voices      Name = "voices"
+	Attachments Name = "attachments"
+	Avatars     Name = "avatars"
+	Exports     Name = "exports"
 )
@@ -49,6 +49,21 @@ func (s *Service) GenerateURL(ctx context.Context, objID string) (string, error)
 	return newURL.String(), nil
 }
 
+// GenerateUploadURL returns a pre-signed PUT url, so clients upload objects directly to the bucket.
+func (s *Service) GenerateUploadURL(ctx context.Context, objID string) (string, error) {
+	newURL, err := s.minioClient.PresignedPutObject(
+		ctx,
+		s.bucketName,
+		objID,
+		s.ttl,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate pre-signed upload URL: %w", err)
+	}
+
+	return newURL.String(), nil
+}
+
 // Read returns file data by objID, using cached URL or generating a new one.
 func (s *Service) Read(ctx context.Context, objID string) ([]byte, error) {
 	// 1. Try to get URL from cache
@@ -0,0 +1,127 @@
+package attachment
+
+import (
+	minioPorts "awesome-chat/internal/domain/core/shared/ports/s3"
+	root "awesome-chat/internal/infrastructure/minio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+)
+
+type Storage struct {
+	conn       *root.Connection
+	bucketName string
+	bucketSvc  minioPorts.BucketService
+
+	mu          sync.Mutex
+	bucketReady bool
+}
+
+func NewStorage(
+	conn *root.Connection,
+	bucketName string,
+	bucketSvc minioPorts.BucketService,
+) *Storage {
+	return &Storage{
+		conn:       conn,
+		bucketName: bucketName,
+		bucketSvc:  bucketSvc,
+	}
+}
+
+// EnsureBucket creates the bucket on first use. Unlike sync.Once a failed attempt is retried.
+func (s *Storage) EnsureBucket(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.bucketReady {
+		return nil
+	}
+
+	exists, err := s.bucketSvc.BucketExists(ctx, s.bucketName)
+	if err != nil {
+		return fmt.Errorf("failed to check bucket existence: %w", err)
+	}
+	if !exists {
+		if err = s.bucketSvc.CreateBucket(ctx, s.bucketName); err != nil {
+			return fmt.Errorf("failed to create bucket: %w", err)
+		}
+	}
+
+	s.bucketReady = true
+	return nil
+}
+
+func (s *Storage) Add(ctx context.Context, objID string, data []byte) error {
+	if err := s.EnsureBucket(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.conn.PutObject(
+		ctx,
+		s.bucketName,
+		objID,
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to upload attachment: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Put(ctx context.Context, objID string, data []byte, contentType string) error {
+	if err := s.EnsureBucket(ctx); err != nil {
+		return err
+	}
+
+	if _, err := s.conn.PutObject(
+		ctx,
+		s.bucketName,
+		objID,
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{ContentType: contentType},
+	); err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Storage) Delete(ctx context.Context, objID string) error {
+	if err := s.conn.RemoveObject(
+		ctx,
+		s.bucketName,
+		objID,
+		minio.RemoveObjectOptions{},
+	); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) Stat(ctx context.Context, objID string) (minioPorts.ObjectInfo, error) {
+	info, err := s.conn.StatObject(ctx, s.bucketName, objID, minio.StatObjectOptions{})
+	if err != nil {
+		return minioPorts.ObjectInfo{}, fmt.Errorf("failed to stat object: %w", err)
+	}
+
+	return minioPorts.ObjectInfo{
+		Size:        info.Size,
+		ContentType: info.ContentType,
+	}, nil
+}
+
+func (s *Storage) Open(ctx context.Context, objID string) (io.ReadCloser, error) {
+	obj, err := s.conn.GetObject(ctx, s.bucketName, objID, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	return obj, nil
+}
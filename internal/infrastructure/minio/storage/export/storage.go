@@ -0,0 +1,82 @@
+package export
+
+import (
+	minioPorts "awesome-chat/internal/domain/core/shared/ports/s3"
+	root "awesome-chat/internal/infrastructure/minio"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// partSize bounds the memory of an upload, the client buffers one part at a time.
+const partSize = 16 << 20
+
+var _ minioPorts.StreamWriter = (*Storage)(nil)
+
+type Storage struct {
+	conn       *root.Connection
+	bucketName string
+	bucketSvc  minioPorts.BucketService
+
+	mu          sync.Mutex
+	bucketReady bool
+}
+
+func NewStorage(
+	conn *root.Connection,
+	bucketName string,
+	bucketSvc minioPorts.BucketService,
+) *Storage {
+	return &Storage{
+		conn:       conn,
+		bucketName: bucketName,
+		bucketSvc:  bucketSvc,
+	}
+}
+
+// EnsureBucket creates the bucket on first use. Unlike sync.Once a failed attempt is retried.
+func (s *Storage) EnsureBucket(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.bucketReady {
+		return nil
+	}
+
+	exists, err := s.bucketSvc.BucketExists(ctx, s.bucketName)
+	if err != nil {
+		return fmt.Errorf("failed to check bucket existence: %w", err)
+	}
+	if !exists {
+		if err = s.bucketSvc.CreateBucket(ctx, s.bucketName); err != nil {
+			return fmt.Errorf("failed to create bucket: %w", err)
+		}
+	}
+
+	s.bucketReady = true
+	return nil
+}
+
+// PutStream uploads r as a multipart object, a read error aborts the upload and leaves no object behind.
+func (s *Storage) PutStream(ctx context.Context, objID string, r io.Reader, contentType string) (int64, error) {
+	if err := s.EnsureBucket(ctx); err != nil {
+		return 0, err
+	}
+
+	info, err := s.conn.PutObject(
+		ctx,
+		s.bucketName,
+		objID,
+		r,
+		-1,
+		minio.PutObjectOptions{ContentType: contentType, PartSize: partSize},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to upload object: %w", err)
+	}
+
+	return info.Size, nil
+}
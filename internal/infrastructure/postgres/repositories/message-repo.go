@@ -11,28 +11,58 @@ import (
 )
 
 type MessageRepo struct {
-	e ports.ExecutorManager
+	e   ports.ExecutorManager
+	ids ports.IDGenerator
 }
 
-func NewMessageRepo(e ports.ExecutorManager) *MessageRepo {
-	return &MessageRepo{e: e}
+func NewMessageRepo(e ports.ExecutorManager, ids ports.IDGenerator) *MessageRepo {
+	return &MessageRepo{e: e, ids: ids}
 }
 
+// Save inserts the message and moves the chat's last message in the same transaction,
+// the caller's one when there is one.
 func (r *MessageRepo) Save(ctx context.Context, message entity.OldMessage) error {
-	executor := r.e.GetExecutor(ctx)
+	const op = "repositories.MessageRepo.Save"
+
+	if tx, err := r.e.GetTxExecutor(ctx); err == nil {
+		return r.save(ctx, tx, message)
+	}
+
+	tx, err := r.e.GetPool().Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: begin transaction failed: %w", op, err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err = r.save(ctx, tx, message); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *MessageRepo) save(ctx context.Context, executor ports.Executor, message entity.OldMessage) error {
+	const op = "repositories.MessageRepo.Save"
+
 	query := `
 		INSERT INTO messages (
+			id,
 			user_id,
 			chat_id,
 			content
-		) VALUES ($1, $2, $3)`
+		) VALUES ($1, $2, $3, $4)`
 
 	if _, err := executor.Exec(ctx, query,
+		r.ids.NextID(),
 		message.UserID,
 		message.ChatID,
 		message.Content,
 	); err != nil {
-		return err
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := executor.Exec(ctx, `SELECT refresh_chat_last_message(ARRAY[$1::uuid])`, message.ChatID); err != nil {
+		return fmt.Errorf("%s: failed to refresh last message: %w", op, err)
 	}
 
 	return nil
@@ -45,12 +75,14 @@ func (r *MessageRepo) SaveBatch(ctx context.Context, messages []entity.Message)
 		return nil
 	}
 
+	ids := make([]int64, 0, len(messages))
 	userIDs := make([]uuid.UUID, 0, len(messages))
 	chatIDs := make([]uuid.UUID, 0, len(messages))
 	contents := make([]string, 0, len(messages))
 	timestamps := make([]time.Time, 0, len(messages))
 
 	for _, message := range messages {
+		ids = append(ids, int64(r.ids.NextID()))
 		userIDs = append(userIDs, message.UserID)
 		chatIDs = append(chatIDs, message.ChatID)
 		contents = append(contents, message.Content)
@@ -59,17 +91,19 @@ func (r *MessageRepo) SaveBatch(ctx context.Context, messages []entity.Message)
 
 	query := `
         INSERT INTO messages (
+            id,
             user_id,
             chat_id,
             content,
             timestamp
         ) SELECT 
-            unnest($1::uuid[]),
+            unnest($1::bigint[]),
             unnest($2::uuid[]),
-            unnest($3::text[]),
-            unnest($4::timestamp[])`
+            unnest($3::uuid[]),
+            unnest($4::text[]),
+            unnest($5::timestamp[])`
 
-	_, err := r.e.GetExecutor(ctx).Exec(ctx, query, userIDs, chatIDs, contents, timestamps)
+	_, err := r.e.GetExecutor(ctx).Exec(ctx, query, ids, userIDs, chatIDs, contents, timestamps)
 	return fmt.Errorf("%s: %w", op, err)
 }
 
@@ -89,10 +123,10 @@ func (r *MessageRepo) SaveBatchFast(ctx context.Context, messages []entity.Messa
 	_, err = tx.CopyFrom(
 		ctx,
 		pgx.Identifier{"messages"},
-		[]string{"user_id", "chat_id", "content", "timestamp"},
+		[]string{"id", "user_id", "chat_id", "content", "timestamp"},
 		pgx.CopyFromSlice(len(messages), func(i int) ([]interface{}, error) {
 			msg := messages[i]
-			return []interface{}{msg.UserID, msg.ChatID, msg.Content, msg.Timestamp}, nil
+			return []interface{}{int64(r.ids.NextID()), msg.UserID, msg.ChatID, msg.Content, msg.Timestamp}, nil
 		}),
 	)
 
@@ -0,0 +1,79 @@
+package attachment
+
+import (
+	"awesome-chat/internal/domain/core/attachment/entity"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+)
+
+type CompleteStore struct {
+	executor ports.ExecutorManager
+	ids      ports.IDGenerator
+}
+
+func NewCompleteStore(executor ports.ExecutorManager, ids ports.IDGenerator) *CompleteStore {
+	return &CompleteStore{executor: executor, ids: ids}
+}
+
+func (s *CompleteStore) Complete(ctx context.Context, a entity.Attachment) (int, error) {
+	const op = "attachment.CompleteStore.Complete"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	messageQuery := `
+		INSERT INTO messages (
+			id,
+			user_id,
+			chat_id,
+			message_type,
+			content
+		) VALUES ($1, $2, $3, $4, $5)
+	`
+
+	messageID := s.ids.NextID()
+	if _, err = tx.Exec(ctx, messageQuery,
+		messageID,
+		a.UploaderID,
+		a.ChatID,
+		a.Kind,
+		a.FileName,
+	); err != nil {
+		return 0, fmt.Errorf("%s: failed to insert message: %w", op, err)
+	}
+
+	attachmentQuery := `
+		UPDATE attachments
+		SET message_id = $1,
+			status = $2,
+			uploaded_at = NOW(),
+			preview_status = CASE WHEN kind = $5 THEN $6 END
+		WHERE id = $3 AND status = $4
+	`
+
+	tag, err := tx.Exec(ctx, attachmentQuery,
+		messageID,
+		vo.StatusUploaded,
+		a.ID,
+		vo.StatusPending,
+		vo.KindImage,
+		vo.PreviewStatusPending,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to update attachment: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, fmt.Errorf("%s: %w", op, attachmentErrors.ErrAlreadyCompleted)
+	}
+
+	if _, err = tx.Exec(ctx, `SELECT refresh_chat_last_message(ARRAY[$1::uuid])`, a.ChatID); err != nil {
+		return 0, fmt.Errorf("%s: failed to refresh last message: %w", op, err)
+	}
+
+	return messageID, nil
+}
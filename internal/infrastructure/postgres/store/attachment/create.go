@@ -0,0 +1,52 @@
+package attachment
+
+import (
+	"awesome-chat/internal/domain/core/attachment/entity"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+)
+
+type CreateStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewCreateStore(executor ports.ExecutorManager) *CreateStore {
+	return &CreateStore{executor: executor}
+}
+
+func (s *CreateStore) Create(ctx context.Context, a entity.Attachment) error {
+	const op = "attachment.CreateStore.Create"
+
+	query := `
+		INSERT INTO attachments (
+			id,
+			uploader_id,
+			chat_id,
+			kind,
+			object_key,
+			file_name,
+			mime_type,
+			size_bytes,
+			checksum,
+			status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	if _, err := s.executor.GetExecutor(ctx).Exec(ctx, query,
+		a.ID,
+		a.UploaderID,
+		a.ChatID,
+		a.Kind,
+		a.ObjectKey,
+		a.FileName,
+		a.MimeType,
+		a.Size,
+		a.Checksum,
+		a.Status,
+	); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package attachment
+
+import (
+	"awesome-chat/internal/domain/core/attachment/entity"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const selectAttachment = `
+	SELECT
+		id,
+		message_id,
+		uploader_id,
+		chat_id,
+		kind,
+		object_key,
+		file_name,
+		mime_type,
+		size_bytes,
+		checksum,
+		status,
+		created_at,
+		uploaded_at,
+		preview_status,
+		width,
+		height,
+		blurhash,
+		previews
+	FROM attachments
+`
+
+type GetStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewGetStore(executor ports.ExecutorManager) *GetStore {
+	return &GetStore{executor: executor}
+}
+
+func (s *GetStore) Get(ctx context.Context, id uuid.UUID) (entity.Attachment, error) {
+	const op = "attachment.GetStore.Get"
+
+	row := s.executor.GetExecutor(ctx).QueryRow(ctx, selectAttachment+`
+		WHERE id = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM messages m
+				WHERE m.id = attachments.message_id AND m.expires_at <= NOW()
+			)`, id)
+
+	a, err := scanAttachment(row)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.Attachment{}, fmt.Errorf("%s: %w", op, attachmentErrors.ErrAttachmentNotFound)
+	case err != nil:
+		return entity.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a, nil
+}
+
+func (s *GetStore) GetByMessageIDs(
+	ctx context.Context,
+	messageIDs []int,
+) (map[int][]entity.Attachment, error) {
+	const op = "attachment.GetStore.GetByMessageIDs"
+
+	result := make(map[int][]entity.Attachment)
+	if len(messageIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx,
+		selectAttachment+" WHERE message_id = ANY($1) ORDER BY created_at",
+		messageIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		a, scanErr := scanAttachment(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("%s: %w", op, scanErr)
+		}
+		result[a.MessageID] = append(result[a.MessageID], a)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+func scanAttachment(row pgx.Row) (entity.Attachment, error) {
+	var (
+		a             entity.Attachment
+		messageID     *int
+		uploadedAt    *time.Time
+		previewStatus *string
+		width         *int
+		height        *int
+		blurhash      *string
+		previews      []byte
+	)
+	if err := row.Scan(
+		&a.ID,
+		&messageID,
+		&a.UploaderID,
+		&a.ChatID,
+		&a.Kind,
+		&a.ObjectKey,
+		&a.FileName,
+		&a.MimeType,
+		&a.Size,
+		&a.Checksum,
+		&a.Status,
+		&a.CreatedAt,
+		&uploadedAt,
+		&previewStatus,
+		&width,
+		&height,
+		&blurhash,
+		&previews,
+	); err != nil {
+		return entity.Attachment{}, err
+	}
+
+	if messageID != nil {
+		a.MessageID = *messageID
+	}
+	if uploadedAt != nil {
+		a.UploadedAt = *uploadedAt
+	}
+	if previewStatus != nil {
+		a.PreviewStatus = vo.PreviewStatus(*previewStatus)
+	}
+	if width != nil {
+		a.Width = *width
+	}
+	if height != nil {
+		a.Height = *height
+	}
+	if blurhash != nil {
+		a.Blurhash = *blurhash
+	}
+	if len(previews) > 0 {
+		if err := json.Unmarshal(previews, &a.Previews); err != nil {
+			return entity.Attachment{}, fmt.Errorf("failed to decode previews: %w", err)
+		}
+	}
+
+	return a, nil
+}
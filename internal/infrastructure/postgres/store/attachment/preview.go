@@ -0,0 +1,127 @@
+package attachment
+
+import (
+	"awesome-chat/internal/domain/core/attachment/entity"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	"awesome-chat/internal/domain/core/attachment/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// a claim older than this belongs to a worker that died mid-way, it outlasts the job timeout of the worker
+	claimTimeout = "5 minutes"
+	// a failed image waits retryDelay, doubled on every further attempt
+	retryDelay = "30 seconds"
+)
+
+type PreviewStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewPreviewStore(executor ports.ExecutorManager) *PreviewStore {
+	return &PreviewStore{executor: executor}
+}
+
+func (s *PreviewStore) ClaimPending(ctx context.Context) (entity.Attachment, error) {
+	const op = "attachment.PreviewStore.ClaimPending"
+
+	// the claim is committed right away and leases the image, nothing stays locked while it is downloaded and resized
+	query := `
+		WITH claimed AS (
+			UPDATE attachments
+			SET preview_attempts = preview_attempts + 1,
+				preview_next_attempt_at = NOW() + $2::interval
+			WHERE id = (
+				SELECT id
+				FROM attachments
+				WHERE preview_status = $1 AND preview_next_attempt_at <= NOW()
+				ORDER BY preview_next_attempt_at, uploaded_at
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id
+		)
+	` + selectAttachment + `
+		WHERE id = (SELECT id FROM claimed)
+	`
+
+	a, err := scanAttachment(s.executor.GetExecutor(ctx).QueryRow(ctx, query, vo.PreviewStatusPending, claimTimeout))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.Attachment{}, fmt.Errorf("%s: %w", op, attachmentErrors.ErrNoPendingPreviews)
+	case err != nil:
+		return entity.Attachment{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a, nil
+}
+
+func (s *PreviewStore) SavePreviews(ctx context.Context, a entity.Attachment) error {
+	const op = "attachment.PreviewStore.SavePreviews"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	previews, err := json.Marshal(a.Previews)
+	if err != nil {
+		return fmt.Errorf("%s: failed to encode previews: %w", op, err)
+	}
+
+	attachmentQuery := `
+		UPDATE attachments
+		SET preview_status = $1,
+			width = $2,
+			height = $3,
+			blurhash = $4,
+			previews = $5
+		WHERE id = $6
+	`
+
+	if _, err = tx.Exec(ctx, attachmentQuery,
+		vo.PreviewStatusReady,
+		a.Width,
+		a.Height,
+		a.Blurhash,
+		previews,
+		a.ID,
+	); err != nil {
+		return fmt.Errorf("%s: failed to update attachment: %w", op, err)
+	}
+
+	// clients refetching messages by updated_at pick the previews up
+	if _, err = tx.Exec(ctx,
+		`UPDATE messages SET updated_at = NOW() WHERE id = $1`,
+		a.MessageID,
+	); err != nil {
+		return fmt.Errorf("%s: failed to update message: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *PreviewStore) MarkFailed(ctx context.Context, id uuid.UUID, maxAttempts int) error {
+	const op = "attachment.PreviewStore.MarkFailed"
+
+	// attempts were counted by the claim
+	query := `
+		UPDATE attachments
+		SET preview_status = CASE WHEN preview_attempts >= $1 THEN $2 ELSE preview_status END,
+			preview_next_attempt_at = NOW() + $3::interval * power(2, GREATEST(preview_attempts - 1, 0))
+		WHERE id = $4
+	`
+
+	if _, err := s.executor.GetExecutor(ctx).Exec(ctx, query, maxAttempts, vo.PreviewStatusFailed, retryDelay, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
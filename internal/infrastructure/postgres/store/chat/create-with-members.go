@@ -23,10 +23,11 @@ func (s *CreateWithMembersStore) CreateChat(
 	ctx context.Context,
 	chatID vo.ChatID,
 	chatName string,
+	chatType vo.ChatType,
 ) error {
 	const op = "CreateWithMembersStore.CreateChat"
 
-	query := "INSERT INTO chats (id, chat_name) VALUES ($1, $2)"
+	query := "INSERT INTO chats (id, chat_name, chat_type) VALUES ($1, $2, $3)"
 
 	id := chatID.ToUUID()
 	conn, err := s.executor.GetTxExecutor(ctx)
@@ -34,7 +35,7 @@ func (s *CreateWithMembersStore) CreateChat(
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if _, err = conn.Exec(ctx, query, id, chatName); err != nil {
+	if _, err = conn.Exec(ctx, query, id, chatName, chatType.String()); err != nil {
 		return err
 	}
 
@@ -45,11 +46,12 @@ func (s *CreateWithMembersStore) AddMembers(
 	ctx context.Context,
 	chatID vo.ChatID,
 	memberIDs userVO.UserIDs,
+	role vo.Role,
 ) error {
 	const op = "CreateWithMembersStore.AddMembers"
 	query := `
-    INSERT INTO user_chats (chat_id, user_id) 
-    SELECT $1, unnest($2::uuid[])
+    INSERT INTO user_chats (chat_id, user_id, role) 
+    SELECT $1, unnest($2::uuid[]), $3
     ON CONFLICT DO NOTHING
     `
 
@@ -61,18 +63,18 @@ func (s *CreateWithMembersStore) AddMembers(
 	chatUUID := chatID.ToUUID()
 	memberUUIDs := memberIDs.ToUUIDs()
 
-	if _, err = conn.Exec(ctx, query, chatUUID, memberUUIDs); err != nil {
+	if _, err = conn.Exec(ctx, query, chatUUID, memberUUIDs, role.String()); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
 }
 
-func (s *CreateWithMembersStore) AddMember(ctx context.Context, chatID uuid.UUID, memberID uuid.UUID) error {
+func (s *CreateWithMembersStore) AddMember(ctx context.Context, chatID uuid.UUID, memberID uuid.UUID, role vo.Role) error {
 	conn := s.executor.GetExecutor(ctx)
-	query := "INSERT INTO user_chats (user_id, chat_id) VALUES ($1, $2)"
+	query := "INSERT INTO user_chats (user_id, chat_id, role) VALUES ($1, $2, $3)"
 
-	if _, err := conn.Exec(ctx, query, memberID, chatID); err != nil {
+	if _, err := conn.Exec(ctx, query, memberID, chatID, role.String()); err != nil {
 		return err
 	}
 
@@ -0,0 +1,86 @@
+package chat
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	_ chatPorts.DirectStore   = (*DirectStore)(nil)
+	_ chatPorts.ChatTypeStore = (*DirectStore)(nil)
+)
+
+type DirectStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewDirectStore(executor ports.ExecutorManager) *DirectStore {
+	return &DirectStore{executor: executor}
+}
+
+func (s *DirectStore) FindDirect(ctx context.Context, pair vo.DirectPair) (uuid.UUID, error) {
+	const op = "chat.DirectStore.FindDirect"
+
+	query := `SELECT chat_id FROM direct_chats WHERE user_low = $1 AND user_high = $2;`
+
+	var chatID uuid.UUID
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, pair.Low, pair.High).Scan(&chatID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return chatID, nil
+}
+
+// CreateDirect relies on the unique pair, a concurrent open of the same pair waits on it and then inserts nothing.
+func (s *DirectStore) CreateDirect(ctx context.Context, chatID uuid.UUID, pair vo.DirectPair) (bool, error) {
+	const op = "chat.DirectStore.CreateDirect"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `INSERT INTO chats (id, chat_name, chat_type) VALUES ($1, '', $2);`
+	if _, err = tx.Exec(ctx, query, chatID, vo.ChatTypeDirect.String()); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query = `
+	INSERT INTO direct_chats (chat_id, user_low, user_high)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (user_low, user_high) DO NOTHING;`
+
+	tag, err := tx.Exec(ctx, query, chatID, pair.Low, pair.High)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (s *DirectStore) ChatType(ctx context.Context, chatID uuid.UUID) (vo.ChatType, error) {
+	const op = "chat.DirectStore.ChatType"
+
+	query := `SELECT chat_type FROM chats WHERE id = $1;`
+
+	var chatType string
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, chatID).Scan(&chatType); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return vo.ChatType(chatType), nil
+}
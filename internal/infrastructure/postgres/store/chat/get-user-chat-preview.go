@@ -2,11 +2,14 @@ package chat
 
 import (
 	"awesome-chat/internal/domain/core/chat/entity"
+	"awesome-chat/internal/domain/core/chat/vo"
 	"awesome-chat/internal/domain/core/shared/ports"
 	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"math"
+	"strconv"
 	"time"
 )
 
@@ -18,59 +21,196 @@ func NewGetUserChatPreviewStore(executor ports.ExecutorManager) *GetUserChatPrev
 	return &GetUserChatPreviewStore{executor: executor}
 }
 
+// unorderedSortKey places pinned chats without a sort order, and every unpinned chat, after the ordered ones.
+const unorderedSortKey = math.MaxInt32
+
 func (s *GetUserChatPreviewStore) SetupChatPreviews(
 	ctx context.Context,
 	userID uuid.UUID,
+	includeArchived bool,
+	page vo.PreviewPage,
 ) (
-	previews []entity.ChatPreview,
-	err error,
+	entity.ChatPreviewPage,
+	error,
 ) {
 	const op = "chat.GetUserChatPreviewStore.SetupChatPreviews"
 
 	conn := s.executor.GetExecutor(ctx)
+
+	var result entity.ChatPreviewPage
+	if err := conn.QueryRow(ctx,
+		`SELECT count(*) FROM user_chats WHERE user_id = $1 AND ($2 OR NOT archived)`,
+		userID, includeArchived,
+	).Scan(&result.Total); err != nil {
+		return entity.ChatPreviewPage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	args := []any{userID, includeArchived}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	after := "TRUE"
+	if !page.After.IsZero() {
+		pinned, sortKey := arg(page.After.Pinned), arg(previewSortKey(page.After))
+		activeAt, chatID := arg(page.After.ActiveAt), arg(page.After.ChatID)
+		after = fmt.Sprintf(`(o.pinned < %[1]s
+            OR (o.pinned = %[1]s AND o.sort_key > %[2]s)
+            OR (o.pinned = %[1]s AND o.sort_key = %[2]s AND o.active_at < %[3]s::timestamp)
+            OR (o.pinned = %[1]s AND o.sort_key = %[2]s AND o.active_at = %[3]s::timestamp AND o.chat_id > %[4]s))`,
+			pinned, sortKey, activeAt, chatID,
+		)
+	}
+
+	// the page is cut from the order keys alone, the subqueries of the preview only run for its chats.
+	// One chat more than the limit tells whether another page follows.
 	query := `
+    WITH ordered AS (
+        SELECT
+            uc.chat_id,
+            uc.pinned,
+            -- the manual order only applies among pinned chats, the rest follow their activity
+            COALESCE(CASE WHEN uc.pinned THEN uc.sort_order END, ` + strconv.Itoa(unorderedSortKey) + `) AS sort_key,
+            COALESCE(m.created_at, c.created_at, 'epoch') AS active_at
+        FROM user_chats uc
+        JOIN chats c ON c.id = uc.chat_id
+        -- an expired last message is hidden until the purge refreshes the projection to the one before
+        LEFT JOIN chat_last_message m ON m.chat_id = c.id
+            AND (m.expires_at IS NULL OR m.expires_at > NOW())
+        WHERE uc.user_id = $1
+            AND ($2 OR NOT uc.archived)
+    ), page AS (
+        SELECT o.*
+        FROM ordered o
+        WHERE ` + after + `
+        ORDER BY o.pinned DESC, o.sort_key, o.active_at DESC, o.chat_id
+        LIMIT ` + arg(page.Limit+1) + `
+    )
     SELECT 
         c.id AS chat_id,
+        c.chat_type,
         c.chat_name AS chat_name,
+        c.description,
+        c.avatar_key,
+        c.avatar_thumb_key,
+        (
+            SELECT COALESCE(SUM(mc.count), 0)
+            FROM chat_member_counts mc
+            WHERE mc.chat_id = c.id
+        ) AS member_count,
         m.content AS last_message_content,
         m.user_id AS last_message_sender_id,
         m.created_at AS last_message_time,
         -- TODO read field and count(read)
-        0 AS unread_count
-    FROM chats c
-    JOIN user_chats uc ON c.id = uc.chat_id
-    LEFT JOIN LATERAL (
-        SELECT content, user_id, created_at
-        FROM messages 
-        WHERE chat_id = c.id 
-        ORDER BY created_at DESC 
-        LIMIT 1
-    ) m ON true
-    WHERE uc.user_id = $1
+        0 AS unread_count,
+        (
+            SELECT COUNT(*)
+            FROM message_mentions mm
+            JOIN messages mentioned ON mentioned.id = mm.message_id
+            WHERE mm.chat_id = c.id
+                AND mm.user_id = uc.user_id
+                AND mm.read_at IS NULL
+                AND (mentioned.expires_at IS NULL OR mentioned.expires_at > NOW())
+        ) AS unread_mentions,
+        ARRAY(
+            SELECT p.message_id
+            FROM chat_pins p
+            JOIN messages pm ON pm.id = p.message_id
+            WHERE p.chat_id = c.id
+                AND (pm.expires_at IS NULL OR pm.expires_at > NOW())
+            ORDER BY p.pinned_at DESC, p.message_id DESC
+        ) AS pinned_message_ids,
+        c.message_ttl_seconds,
+        d.content AS draft_content,
+        d.updated_at AS draft_updated_at,
+        page.active_at,
+        uc.role,
+        uc.muted_until,
+        uc.archived,
+        uc.pinned,
+        uc.sort_order
+    FROM page
+    JOIN chats c ON c.id = page.chat_id
+    JOIN user_chats uc ON uc.chat_id = page.chat_id AND uc.user_id = $1
+    LEFT JOIN message_drafts d ON d.chat_id = c.id AND d.user_id = uc.user_id
+    LEFT JOIN chat_last_message m ON m.chat_id = c.id
+        AND (m.expires_at IS NULL OR m.expires_at > NOW())
+    ORDER BY page.pinned DESC, page.sort_key, page.active_at DESC, page.chat_id
 `
-	rows, err := conn.Query(ctx, query, userID)
+	rows, err := conn.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return entity.ChatPreviewPage{}, fmt.Errorf("%s: %w", op, err)
 	}
 	defer rows.Close()
 
+	previews := make([]entity.ChatPreview, 0, page.Limit+1)
 	for rows.Next() {
 		var (
 			cp          entity.ChatPreview
 			msgText     pgtype.Text
 			msgSenderID pgtype.UUID
 			msgTime     pgtype.Timestamp
+			pinnedIDs   []int64
+			ttlSeconds  pgtype.Int4
+			draftText   pgtype.Text
+			draftTime   pgtype.Timestamptz
+			role        string
+			chatType    string
+			avatarKey   pgtype.Text
+			thumbKey    pgtype.Text
+			mutedUntil  pgtype.Timestamptz
+			sortOrder   pgtype.Int4
 		)
 
 		if err = rows.Scan(
 			&cp.ChatID,
+			&chatType,
 			&cp.Name,
+			&cp.Description,
+			&avatarKey,
+			&thumbKey,
+			&cp.MemberCount,
 			&msgText,
 			&msgSenderID,
 			&msgTime,
 			&cp.UnreadCount,
+			&cp.UnreadMentions,
+			&pinnedIDs,
+			&ttlSeconds,
+			&draftText,
+			&draftTime,
+			&cp.ActiveAt,
+			&role,
+			&mutedUntil,
+			&cp.Preferences.Archived,
+			&cp.Preferences.Pinned,
+			&sortOrder,
 		); err != nil {
-			return nil, err
+			return entity.ChatPreviewPage{}, fmt.Errorf("%s: %w", op, err)
+		}
+		cp.Role = vo.Role(role)
+		cp.Type = vo.ChatType(chatType)
+		cp.Avatar = vo.Avatar{Key: avatarKey.String, ThumbKey: thumbKey.String}
+		if mutedUntil.Valid {
+			cp.Preferences.MutedUntil = &mutedUntil.Time
+		}
+		cp.Preferences.SortOrder = int(sortOrder.Int32)
+
+		if ttlSeconds.Valid {
+			cp.MessageTTL = time.Duration(ttlSeconds.Int32) * time.Second
+		}
+
+		if draftText.Valid && draftText.String != "" {
+			cp.Draft = &entity.DraftPreview{
+				Text:      draftText.String,
+				UpdatedAt: draftTime.Time,
+			}
+		}
+
+		cp.PinnedMessageIDs = make([]int, 0, len(pinnedIDs))
+		for _, id := range pinnedIDs {
+			cp.PinnedMessageIDs = append(cp.PinnedMessageIDs, int(id))
 		}
 
 		if msgText.Valid {
@@ -95,10 +235,24 @@ func (s *GetUserChatPreviewStore) SetupChatPreviews(
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return entity.ChatPreviewPage{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return previews, nil
+	if len(previews) > page.Limit {
+		previews = previews[:page.Limit]
+		result.Next = previews[len(previews)-1].Cursor()
+	}
+	result.Previews = previews
+
+	return result, nil
+}
+
+// previewSortKey is the sort key the query computes for the chat of the cursor.
+func previewSortKey(c vo.PreviewCursor) int {
+	if !c.Pinned || c.SortOrder == 0 {
+		return unorderedSortKey
+	}
+	return c.SortOrder
 }
 
 func (s *GetUserChatPreviewStore) ParticipantsForChat(
@@ -112,7 +266,8 @@ func (s *GetUserChatPreviewStore) ParticipantsForChat(
 	query := `
         SELECT 
             u.id,
-            u.username
+            u.username,
+            uc.role
         FROM users u
         JOIN user_chats uc ON u.id = uc.user_id
         WHERE uc.chat_id = $1
@@ -125,13 +280,18 @@ func (s *GetUserChatPreviewStore) ParticipantsForChat(
 	defer rows.Close()
 
 	for rows.Next() {
-		var p entity.Participant
+		var (
+			p    entity.Participant
+			role string
+		)
 		if err = rows.Scan(
 			&p.UserID,
 			&p.Username,
+			&role,
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		p.Role = vo.Role(role)
 		ps = append(ps, p)
 	}
 
@@ -142,24 +302,33 @@ func (s *GetUserChatPreviewStore) ParticipantsForChat(
 	return ps, nil
 }
 
-func (s *GetUserChatPreviewStore) BatchParticipantsForChats(
+func (s *GetUserChatPreviewStore) PreviewParticipants(
 	ctx context.Context,
+	viewerID uuid.UUID,
 	chatIDs []uuid.UUID,
+	limit int,
 ) (map[uuid.UUID][]entity.Participant, error) {
-	const op = "chat.GetUserChatPreviewStore.BatchParticipantsForChats"
+	const op = "chat.GetUserChatPreviewStore.PreviewParticipants"
 
+	// no ordering, so a large group stops reading members at the limit instead of sorting all of them
 	query := `
         SELECT 
-            uc.chat_id,
-            u.id,
-            u.username
-        FROM users u
-        JOIN user_chats uc ON u.id = uc.user_id
-        WHERE uc.chat_id = ANY($1)
-        ORDER BY uc.chat_id, u.username
+            c.id,
+            p.id,
+            p.username,
+            p.role
+        FROM unnest($1::uuid[]) AS c(id)
+        CROSS JOIN LATERAL (
+            SELECT u.id, u.username, uc.role
+            FROM user_chats uc
+            JOIN users u ON u.id = uc.user_id
+            WHERE uc.chat_id = c.id
+                AND uc.user_id <> $2
+            LIMIT $3
+        ) p
     `
 
-	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatIDs)
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatIDs, viewerID, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -170,14 +339,17 @@ func (s *GetUserChatPreviewStore) BatchParticipantsForChats(
 		var (
 			chatID      uuid.UUID
 			participant entity.Participant
+			role        string
 		)
 		if err = rows.Scan(
 			&chatID,
 			&participant.UserID,
 			&participant.Username,
+			&role,
 		); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		participant.Role = vo.Role(role)
 		result[chatID] = append(result[chatID], participant)
 	}
 
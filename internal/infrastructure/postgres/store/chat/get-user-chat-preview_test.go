@@ -0,0 +1,110 @@
+package chat
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"awesome-chat/internal/domain/core/chat/entity"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/infrastructure/postgres"
+	"awesome-chat/internal/infrastructure/postgres/executor"
+)
+
+// runs against a migrated database, everything it writes is rolled back
+func TestSetupChatPreviews_Pages(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer pool.Close()
+
+	txManager := executor.NewTransactionManager(&postgres.Pool{Pool: pool})
+	ctx, err = txManager.BeginAndInjectTx(ctx)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer func() { _ = txManager.RollbackTx(ctx) }()
+
+	tx, _ := txManager.GetTxExecutor(ctx)
+	userID := uuid.New()
+	if _, err = tx.Exec(ctx,
+		`INSERT INTO users (id, username, email, password) VALUES ($1, 'preview-order', $2, '')`,
+		userID, userID.String()+"@test",
+	); err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+
+	now := time.Now().UTC()
+	chats := []struct {
+		name      string
+		pinned    bool
+		sortOrder *int
+		createdAt time.Time
+	}{
+		{"pinned second", true, ptr(2), now.Add(-4 * time.Hour)},
+		{"pinned first", true, ptr(1), now.Add(-5 * time.Hour)},
+		{"pinned unordered", true, nil, now},
+		// an order kept from when the chat was pinned must not lift it above more active chats
+		{"unpinned with order", false, ptr(1), now.Add(-3 * time.Hour)},
+		{"unpinned recent", false, nil, now.Add(-time.Hour)},
+	}
+	ids := make(map[uuid.UUID]string, len(chats))
+	for _, c := range chats {
+		chatID := uuid.New()
+		ids[chatID] = c.name
+		if _, err = tx.Exec(ctx,
+			`INSERT INTO chats (id, chat_name, created_at) VALUES ($1, $2, $3)`,
+			chatID, c.name, c.createdAt,
+		); err != nil {
+			t.Fatalf("insert chat: %v", err)
+		}
+		if _, err = tx.Exec(ctx,
+			`INSERT INTO user_chats (user_id, chat_id, pinned, sort_order) VALUES ($1, $2, $3, $4)`,
+			userID, chatID, c.pinned, c.sortOrder,
+		); err != nil {
+			t.Fatalf("insert membership: %v", err)
+		}
+	}
+
+	// pages of two walk the list through every kind of cursor
+	store := NewGetUserChatPreviewStore(txManager)
+	var previews []entity.ChatPreview
+	page := vo.PreviewPage{Limit: 2}
+	for {
+		got, pageErr := store.SetupChatPreviews(ctx, userID, false, page)
+		if pageErr != nil {
+			t.Fatalf("SetupChatPreviews: %v", pageErr)
+		}
+		if got.Total != len(chats) {
+			t.Fatalf("Expected a total of %d, got %d", len(chats), got.Total)
+		}
+		previews = append(previews, got.Previews...)
+		if got.Next.IsZero() {
+			break
+		}
+		page.After = got.Next
+	}
+
+	expected := []string{"pinned first", "pinned second", "pinned unordered", "unpinned recent", "unpinned with order"}
+	if len(previews) != len(expected) {
+		t.Fatalf("Expected %d previews, got %d", len(expected), len(previews))
+	}
+	for i, p := range previews {
+		if got := ids[p.ChatID]; got != expected[i] {
+			t.Errorf("Position %d: expected %q, got %q", i, expected[i], got)
+		}
+	}
+}
+
+func ptr(v int) *int { return &v }
@@ -0,0 +1,145 @@
+package chat
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ chatPorts.InviteStore = (*InviteStore)(nil)
+
+type InviteStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewInviteStore(executor ports.ExecutorManager) *InviteStore {
+	return &InviteStore{executor: executor}
+}
+
+const inviteColumns = `id, chat_id, token, created_by, expires_at, max_uses, uses, requires_approval, revoked_at, created_at`
+
+func (s *InviteStore) CreateInvite(ctx context.Context, invite entity.Invite) error {
+	const op = "chat.InviteStore.CreateInvite"
+
+	query := `
+	INSERT INTO chat_invites (id, chat_id, token, created_by, expires_at, max_uses, requires_approval, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8);`
+
+	if _, err := s.executor.GetExecutor(ctx).Exec(ctx, query,
+		invite.ID, invite.ChatID, invite.Token, invite.CreatedBy,
+		invite.ExpiresAt, invite.MaxUses, invite.RequiresApproval, invite.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *InviteStore) ListInvites(ctx context.Context, chatID uuid.UUID) ([]entity.Invite, error) {
+	const op = "chat.InviteStore.ListInvites"
+
+	query := `
+	SELECT ` + inviteColumns + `
+	FROM chat_invites
+	WHERE chat_id = $1 AND revoked_at IS NULL
+	ORDER BY created_at DESC;`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	invites := make([]entity.Invite, 0)
+	for rows.Next() {
+		invite, scanErr := scanInvite(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("%s: %w", op, scanErr)
+		}
+		invites = append(invites, invite)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invites, nil
+}
+
+func (s *InviteStore) RevokeInvite(ctx context.Context, chatID uuid.UUID, inviteID uuid.UUID) error {
+	const op = "chat.InviteStore.RevokeInvite"
+
+	query := `
+	UPDATE chat_invites
+	SET revoked_at = NOW()
+	WHERE id = $1 AND chat_id = $2 AND revoked_at IS NULL;`
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx, query, inviteID, chatID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrInviteNotFound)
+	}
+
+	return nil
+}
+
+func (s *InviteStore) InviteForUpdate(ctx context.Context, token string) (entity.Invite, error) {
+	const op = "chat.InviteStore.InviteForUpdate"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return entity.Invite{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `SELECT ` + inviteColumns + ` FROM chat_invites WHERE token = $1 FOR UPDATE;`
+
+	invite, err := scanInvite(tx.QueryRow(ctx, query, token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Invite{}, fmt.Errorf("%s: %w", op, chatErrors.ErrInviteNotFound)
+		}
+		return entity.Invite{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invite, nil
+}
+
+func (s *InviteStore) UseInvite(ctx context.Context, inviteID uuid.UUID) error {
+	const op = "chat.InviteStore.UseInvite"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.Exec(ctx, `UPDATE chat_invites SET uses = uses + 1 WHERE id = $1;`, inviteID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func scanInvite(row pgx.Row) (entity.Invite, error) {
+	var invite entity.Invite
+	err := row.Scan(
+		&invite.ID,
+		&invite.ChatID,
+		&invite.Token,
+		&invite.CreatedBy,
+		&invite.ExpiresAt,
+		&invite.MaxUses,
+		&invite.Uses,
+		&invite.RequiresApproval,
+		&invite.RevokedAt,
+		&invite.CreatedAt,
+	)
+	return invite, err
+}
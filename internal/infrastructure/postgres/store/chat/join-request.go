@@ -0,0 +1,123 @@
+package chat
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var _ chatPorts.JoinRequestStore = (*JoinRequestStore)(nil)
+
+type JoinRequestStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewJoinRequestStore(executor ports.ExecutorManager) *JoinRequestStore {
+	return &JoinRequestStore{executor: executor}
+}
+
+func (s *JoinRequestStore) CreateJoinRequest(ctx context.Context, request entity.JoinRequest) error {
+	const op = "chat.JoinRequestStore.CreateJoinRequest"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+	INSERT INTO chat_join_requests (chat_id, user_id, invite_id, status, created_at)
+	VALUES ($1, $2, $3, 'pending', NOW())
+	ON CONFLICT (chat_id, user_id) DO UPDATE
+	SET invite_id = EXCLUDED.invite_id,
+		status = 'pending',
+		created_at = NOW(),
+		decided_by = NULL,
+		decided_at = NULL
+	WHERE chat_join_requests.status <> 'pending';`
+
+	tag, err := tx.Exec(ctx, query, request.ChatID, request.UserID, request.InviteID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrJoinRequestPending)
+	}
+
+	return nil
+}
+
+func (s *JoinRequestStore) ListJoinRequests(ctx context.Context, chatID uuid.UUID) ([]entity.JoinRequest, error) {
+	const op = "chat.JoinRequestStore.ListJoinRequests"
+
+	query := `
+	SELECT r.chat_id, r.user_id, u.username, r.invite_id, r.status, r.created_at
+	FROM chat_join_requests r
+	JOIN users u ON u.id = r.user_id
+	WHERE r.chat_id = $1 AND r.status = 'pending'
+	ORDER BY r.created_at;`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	requests := make([]entity.JoinRequest, 0)
+	for rows.Next() {
+		var (
+			request entity.JoinRequest
+			status  string
+		)
+		if err = rows.Scan(
+			&request.ChatID,
+			&request.UserID,
+			&request.Username,
+			&request.InviteID,
+			&status,
+			&request.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		request.Status = vo.JoinRequestStatus(status)
+		requests = append(requests, request)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return requests, nil
+}
+
+func (s *JoinRequestStore) DecideJoinRequest(
+	ctx context.Context,
+	chatID, userID, deciderID uuid.UUID,
+	status vo.JoinRequestStatus,
+) error {
+	const op = "chat.JoinRequestStore.DecideJoinRequest"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+	UPDATE chat_join_requests
+	SET status = $4, decided_by = $3, decided_at = NOW()
+	WHERE chat_id = $1 AND user_id = $2 AND status = 'pending';`
+
+	tag, err := tx.Exec(ctx, query, chatID, userID, deciderID, status.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrJoinRequestNotFound)
+	}
+
+	return nil
+}
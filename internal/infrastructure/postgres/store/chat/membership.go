@@ -0,0 +1,105 @@
+package chat
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ chatPorts.MembershipStore = (*MembershipStore)(nil)
+
+type MembershipStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewMembershipStore(executor ports.ExecutorManager) *MembershipStore {
+	return &MembershipStore{executor: executor}
+}
+
+func (s *MembershipStore) RemoveMember(ctx context.Context, chatID uuid.UUID, userID uuid.UUID) (vo.Role, error) {
+	const op = "chat.MembershipStore.RemoveMember"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	var locked int
+	if err = tx.QueryRow(ctx, `SELECT 1 FROM chats WHERE id = $1 FOR UPDATE;`, chatID).Scan(&locked); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `DELETE FROM user_chats WHERE chat_id = $1 AND user_id = $2 RETURNING role;`
+
+	var role string
+	if err = tx.QueryRow(ctx, query, chatID, userID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return vo.Role(role), nil
+}
+
+func (s *MembershipStore) PromoteSuccessor(ctx context.Context, chatID uuid.UUID) (uuid.UUID, error) {
+	const op = "chat.MembershipStore.PromoteSuccessor"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+	UPDATE user_chats
+	SET role = 'owner'
+	WHERE chat_id = $1 AND user_id = (
+		SELECT user_id
+		FROM user_chats
+		WHERE chat_id = $1
+		ORDER BY CASE role WHEN 'admin' THEN 0 WHEN 'member' THEN 1 ELSE 2 END, joined_at, user_id
+		LIMIT 1
+	)
+	RETURNING user_id;`
+
+	var successor uuid.UUID
+	if err = tx.QueryRow(ctx, query, chatID).Scan(&successor); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, nil
+		}
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return successor, nil
+}
+
+func (s *MembershipStore) DeleteIfEmpty(ctx context.Context, chatID uuid.UUID) (bool, error) {
+	const op = "chat.MembershipStore.DeleteIfEmpty"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+	DELETE FROM chats c
+	WHERE c.id = $1
+		AND NOT EXISTS (SELECT 1 FROM user_chats uc WHERE uc.chat_id = c.id);`
+
+	tag, err := tx.Exec(ctx, query, chatID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
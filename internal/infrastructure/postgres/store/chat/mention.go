@@ -0,0 +1,96 @@
+package chat
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type MentionStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewMentionStore(executor ports.ExecutorManager) *MentionStore {
+	return &MentionStore{executor: executor}
+}
+
+func (s *MentionStore) MentionPolicy(ctx context.Context, chatID uuid.UUID) (vo.MentionPolicy, error) {
+	const op = "chat.MentionStore.MentionPolicy"
+
+	var policy vo.MentionPolicy
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx,
+		`SELECT mention_policy FROM chats WHERE id = $1`,
+		chatID,
+	).Scan(&policy); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return policy, nil
+}
+
+func (s *MentionStore) SetMentionPolicy(ctx context.Context, chatID uuid.UUID, policy vo.MentionPolicy) error {
+	const op = "chat.MentionStore.SetMentionPolicy"
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx,
+		`UPDATE chats SET mention_policy = $2 WHERE id = $1`,
+		chatID, policy,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+	}
+
+	return nil
+}
+
+func (s *MentionStore) MissingMembers(
+	ctx context.Context,
+	chatID uuid.UUID,
+	usernames []string,
+) ([]string, error) {
+	const op = "chat.MentionStore.MissingMembers"
+
+	query := `
+		SELECT name
+		FROM unnest($2::text[]) AS name
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM user_chats uc
+			JOIN users u ON u.id = uc.user_id
+			WHERE uc.chat_id = $1 AND lower(u.username) = name
+		)
+	`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatID, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	defer rows.Close()
+
+	var missing []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		missing = append(missing, name)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return missing, nil
+}
@@ -0,0 +1,121 @@
+package chat
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ chatPorts.MetadataStore = (*MetadataStore)(nil)
+
+type MetadataStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewMetadataStore(executor ports.ExecutorManager) *MetadataStore {
+	return &MetadataStore{executor: executor}
+}
+
+const metadataColumns = `id, chat_type, chat_name, description, avatar_key, avatar_thumb_key, updated_at`
+
+func (s *MetadataStore) Metadata(ctx context.Context, chatID uuid.UUID) (entity.ChatMetadata, error) {
+	const op = "chat.MetadataStore.Metadata"
+
+	query := `SELECT ` + metadataColumns + ` FROM chats WHERE id = $1;`
+
+	metadata, err := scanMetadata(s.executor.GetExecutor(ctx).QueryRow(ctx, query, chatID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ChatMetadata{}, fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return entity.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return metadata, nil
+}
+
+func (s *MetadataStore) UpdateInfo(ctx context.Context, chatID uuid.UUID, update vo.ChatInfoUpdate) (entity.ChatMetadata, error) {
+	const op = "chat.MetadataStore.UpdateInfo"
+
+	query := `
+	UPDATE chats
+	SET chat_name = COALESCE($2, chat_name),
+		description = COALESCE($3, description),
+		updated_at = NOW()
+	WHERE id = $1
+	RETURNING ` + metadataColumns + `;`
+
+	metadata, err := scanMetadata(s.executor.GetExecutor(ctx).QueryRow(ctx, query, chatID, update.Name, update.Description))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ChatMetadata{}, fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return entity.ChatMetadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return metadata, nil
+}
+
+func (s *MetadataStore) SetAvatar(ctx context.Context, chatID uuid.UUID, avatar vo.Avatar) (vo.Avatar, error) {
+	const op = "chat.MetadataStore.SetAvatar"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return vo.Avatar{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var key, thumbKey pgtype.Text
+	err = tx.QueryRow(ctx,
+		`SELECT avatar_key, avatar_thumb_key FROM chats WHERE id = $1 FOR UPDATE;`,
+		chatID,
+	).Scan(&key, &thumbKey)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return vo.Avatar{}, fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return vo.Avatar{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+	UPDATE chats
+	SET avatar_key = NULLIF($2, ''), avatar_thumb_key = NULLIF($3, ''), updated_at = NOW()
+	WHERE id = $1;`
+
+	if _, err = tx.Exec(ctx, query, chatID, avatar.Key, avatar.ThumbKey); err != nil {
+		return vo.Avatar{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return vo.Avatar{Key: key.String, ThumbKey: thumbKey.String}, nil
+}
+
+func scanMetadata(row pgx.Row) (entity.ChatMetadata, error) {
+	var (
+		metadata      entity.ChatMetadata
+		chatType      string
+		key, thumbKey pgtype.Text
+	)
+	if err := row.Scan(
+		&metadata.ChatID,
+		&chatType,
+		&metadata.Name,
+		&metadata.Description,
+		&key,
+		&thumbKey,
+		&metadata.UpdatedAt,
+	); err != nil {
+		return entity.ChatMetadata{}, err
+	}
+	metadata.Type = vo.ChatType(chatType)
+	metadata.Avatar = vo.Avatar{Key: key.String, ThumbKey: thumbKey.String}
+
+	return metadata, nil
+}
@@ -0,0 +1,176 @@
+package chat
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type PinStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewPinStore(executor ports.ExecutorManager) *PinStore {
+	return &PinStore{executor: executor}
+}
+
+func (s *PinStore) Pin(
+	ctx context.Context,
+	chatID uuid.UUID,
+	messageID int,
+	userID uuid.UUID,
+) (entity.Pin, error) {
+	const op = "chat.PinStore.Pin"
+
+	conn, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// concurrent pins of the chat queue up on its row so the limit is checked against committed pins only,
+	// the lock still lets messages be sent meanwhile since it does not conflict with their foreign key
+	var locked int
+	if err = conn.QueryRow(ctx, `SELECT 1 FROM chats WHERE id = $1 FOR NO KEY UPDATE;`, chatID).Scan(&locked); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Pin{}, fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+		}
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var inChat, pinned bool
+	if err = conn.QueryRow(ctx,
+		`SELECT
+			EXISTS (
+				SELECT 1 FROM messages
+				WHERE id = $1 AND chat_id = $2 AND (expires_at IS NULL OR expires_at > NOW())
+			),
+			EXISTS (SELECT 1 FROM chat_pins WHERE chat_id = $2 AND message_id = $1)`,
+		messageID, chatID,
+	).Scan(&inChat, &pinned); err != nil {
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+	switch {
+	case !inChat:
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, chatErrors.ErrMessageNotInChat)
+	case pinned:
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, chatErrors.ErrAlreadyPinned)
+	}
+
+	// pins of expired messages wait for the purge, the banner does not show them so they do not count either
+	var count int
+	if err = conn.QueryRow(ctx, `
+		SELECT count(*)
+		FROM chat_pins p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.chat_id = $1
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	`, chatID).Scan(&count); err != nil {
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if count >= entity.MaxPins {
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, chatErrors.ErrTooManyPins)
+	}
+
+	query := `
+		WITH pinned AS (
+			INSERT INTO chat_pins (chat_id, message_id, pinned_by)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (chat_id, message_id) DO NOTHING
+			RETURNING chat_id, message_id, pinned_by, pinned_at
+		)
+		SELECT p.chat_id, p.pinned_by, p.pinned_at, m.id, m.user_id, m.content, m.created_at
+		FROM pinned p
+		JOIN messages m ON m.id = p.message_id
+	`
+
+	pin, err := scanPin(conn.QueryRow(ctx, query, chatID, messageID, userID))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, chatErrors.ErrAlreadyPinned)
+	case err != nil:
+		return entity.Pin{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pin, nil
+}
+
+func (s *PinStore) Unpin(ctx context.Context, chatID uuid.UUID, messageID int) error {
+	const op = "chat.PinStore.Unpin"
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx,
+		`DELETE FROM chat_pins WHERE chat_id = $1 AND message_id = $2`,
+		chatID, messageID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrNotPinned)
+	}
+
+	return nil
+}
+
+func (s *PinStore) List(ctx context.Context, chatID uuid.UUID) ([]entity.Pin, error) {
+	const op = "chat.PinStore.List"
+
+	query := `
+		SELECT p.chat_id, p.pinned_by, p.pinned_at, m.id, m.user_id, m.content, m.created_at
+		FROM chat_pins p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.chat_id = $1
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+		ORDER BY p.pinned_at DESC, p.message_id DESC
+	`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	pins := make([]entity.Pin, 0)
+	for rows.Next() {
+		pin, scanErr := scanPin(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("%s: %w", op, scanErr)
+		}
+		pins = append(pins, pin)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pins, nil
+}
+
+func scanPin(row pgx.Row) (entity.Pin, error) {
+	var (
+		pin     entity.Pin
+		content *string
+	)
+	if err := row.Scan(
+		&pin.ChatID,
+		&pin.PinnedBy,
+		&pin.PinnedAt,
+		&pin.Message.ID,
+		&pin.Message.SenderID,
+		&content,
+		&pin.Message.Timestamp,
+	); err != nil {
+		return entity.Pin{}, err
+	}
+
+	if content != nil {
+		pin.Message.Text = *content
+	}
+
+	return pin, nil
+}
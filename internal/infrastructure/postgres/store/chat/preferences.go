@@ -0,0 +1,73 @@
+package chat
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var _ chatPorts.PreferencesStore = (*PreferencesStore)(nil)
+
+type PreferencesStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewPreferencesStore(executor ports.ExecutorManager) *PreferencesStore {
+	return &PreferencesStore{executor: executor}
+}
+
+func (s *PreferencesStore) UpdatePreferences(
+	ctx context.Context,
+	chatID, userID uuid.UUID,
+	update vo.PreferencesUpdate,
+) (entity.ChatPreferences, error) {
+	const op = "chat.PreferencesStore.UpdatePreferences"
+
+	query := `
+	UPDATE user_chats
+	SET muted_until = CASE WHEN $3 THEN $4 ELSE muted_until END,
+		archived = COALESCE($5, archived),
+		pinned = COALESCE($6, pinned),
+		sort_order = CASE WHEN $7::int IS NULL THEN sort_order ELSE NULLIF($7, 0) END
+	WHERE chat_id = $1 AND user_id = $2
+	RETURNING muted_until, archived, pinned, sort_order;`
+
+	var mutedUntil *time.Time
+	if update.MutedUntil != nil && !update.MutedUntil.IsZero() {
+		mutedUntil = update.MutedUntil
+	}
+
+	var (
+		prefs     entity.ChatPreferences
+		muted     pgtype.Timestamptz
+		sortOrder pgtype.Int4
+	)
+	err := s.executor.GetExecutor(ctx).QueryRow(ctx, query,
+		chatID, userID,
+		update.MutedUntil != nil, mutedUntil,
+		update.Archived, update.Pinned, update.SortOrder,
+	).Scan(&muted, &prefs.Archived, &prefs.Pinned, &sortOrder)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ChatPreferences{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+		}
+		return entity.ChatPreferences{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if muted.Valid {
+		prefs.MutedUntil = &muted.Time
+	}
+	prefs.SortOrder = int(sortOrder.Int32)
+
+	return prefs, nil
+}
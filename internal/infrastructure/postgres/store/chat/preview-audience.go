@@ -0,0 +1,45 @@
+package chat
+
+import (
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var _ chatPorts.PreviewAudienceStore = (*PreviewAudienceStore)(nil)
+
+type PreviewAudienceStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewPreviewAudienceStore(executor ports.ExecutorManager) *PreviewAudienceStore {
+	return &PreviewAudienceStore{executor: executor}
+}
+
+func (s *PreviewAudienceStore) PreviewAudience(ctx context.Context, chatIDs []uuid.UUID) ([]uuid.UUID, error) {
+	const op = "chat.PreviewAudienceStore.PreviewAudience"
+
+	if len(chatIDs) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT ARRAY(
+			SELECT DISTINCT uc.user_id
+			FROM user_chats uc
+			JOIN chats c ON c.id = uc.chat_id
+			WHERE uc.chat_id = ANY($1)
+				AND c.chat_type <> 'channel'
+		)
+	`
+
+	var userIDs []uuid.UUID
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, chatIDs).Scan(&userIDs); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userIDs, nil
+}
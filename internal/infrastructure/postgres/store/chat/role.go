@@ -0,0 +1,60 @@
+package chat
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ chatPorts.RoleStore = (*RoleStore)(nil)
+
+type RoleStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewRoleStore(executor ports.ExecutorManager) *RoleStore {
+	return &RoleStore{executor: executor}
+}
+
+func (s *RoleStore) Role(ctx context.Context, chatID uuid.UUID, userID uuid.UUID) (vo.Role, error) {
+	const op = "chat.RoleStore.Role"
+
+	query := `SELECT role FROM user_chats WHERE chat_id = $1 AND user_id = $2;`
+
+	var role string
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, chatID, userID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return vo.Role(role), nil
+}
+
+// SetRole never touches the owner row, ownership moves by transfer only.
+func (s *RoleStore) SetRole(ctx context.Context, chatID uuid.UUID, userID uuid.UUID, role vo.Role) error {
+	const op = "chat.RoleStore.SetRole"
+
+	query := `
+	UPDATE user_chats
+	SET role = $3
+	WHERE chat_id = $1 AND user_id = $2 AND role <> 'owner';`
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx, query, chatID, userID, role.String())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	}
+
+	return nil
+}
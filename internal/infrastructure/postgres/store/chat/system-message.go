@@ -0,0 +1,72 @@
+package chat
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var _ chatPorts.SystemMessageStore = (*SystemMessageStore)(nil)
+
+type SystemMessageStore struct {
+	executor ports.ExecutorManager
+	ids      ports.IDGenerator
+}
+
+func NewSystemMessageStore(executor ports.ExecutorManager, ids ports.IDGenerator) *SystemMessageStore {
+	return &SystemMessageStore{executor: executor, ids: ids}
+}
+
+// SaveSystem writes the message inside the transaction of the change it reports.
+func (s *SystemMessageStore) SaveSystem(
+	ctx context.Context,
+	chatID uuid.UUID,
+	payload vo.SystemPayload,
+	content string,
+) (entity.SystemMessage, error) {
+	const op = "chat.SystemMessageStore.SaveSystem"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return entity.SystemMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return entity.SystemMessage{}, fmt.Errorf("%s: encode payload: %w", op, err)
+	}
+
+	query := `
+		INSERT INTO messages (
+			id,
+			user_id,
+			chat_id,
+			message_type,
+			content,
+			system_payload
+		) VALUES ($1, $2, $3, 'system', $4, $5)
+		RETURNING created_at
+	`
+
+	msg := entity.SystemMessage{
+		ID:      s.ids.NextID(),
+		ChatID:  chatID,
+		Payload: payload,
+		Content: content,
+	}
+	if err = tx.QueryRow(ctx, query, msg.ID, payload.ActorID, chatID, content, raw).Scan(&msg.CreatedAt); err != nil {
+		return entity.SystemMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.Exec(ctx, `SELECT refresh_chat_last_message(ARRAY[$1::uuid])`, chatID); err != nil {
+		return entity.SystemMessage{}, fmt.Errorf("%s: refresh last message: %w", op, err)
+	}
+
+	return msg, nil
+}
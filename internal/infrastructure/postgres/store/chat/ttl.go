@@ -0,0 +1,42 @@
+package chat
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TTLStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewTTLStore(executor ports.ExecutorManager) *TTLStore {
+	return &TTLStore{executor: executor}
+}
+
+func (s *TTLStore) SetMessageTTL(ctx context.Context, chatID uuid.UUID, ttl time.Duration) error {
+	const op = "chat.TTLStore.SetMessageTTL"
+
+	var seconds *int
+	if ttl > 0 {
+		v := int(ttl / time.Second)
+		seconds = &v
+	}
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx,
+		`UPDATE chats SET message_ttl_seconds = $2 WHERE id = $1`,
+		chatID, seconds,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package chat
 
 import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
 	"awesome-chat/internal/domain/core/shared/ports"
 	"context"
 	"errors"
@@ -20,12 +21,12 @@ func NewValidatorStore(executor ports.ExecutorManager) *ValidatorStore {
 
 func (v *ValidatorStore) ValidateExists(ctx context.Context, id uuid.UUID) error {
 	conn := v.executor.GetPoolExecutor()
-	query := `SELECT 1 FROM chat WHERE id = $1 LIMIT 1;`
+	query := `SELECT 1 FROM chats WHERE id = $1 LIMIT 1;`
 
 	var exists int
 	if err := conn.QueryRow(ctx, query, id).Scan(&exists); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("chat %s not found", id) // 404
+			return fmt.Errorf("chat %s: %w", id, chatErrors.ErrChatNotFound) // 404
 		}
 		return fmt.Errorf("db error: %w", err) // 500
 	}
@@ -0,0 +1,115 @@
+package draft
+
+import (
+	"awesome-chat/internal/domain/core/draft/entity"
+	draftErrors "awesome-chat/internal/domain/core/draft/errors"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type Store struct {
+	executor ports.ExecutorManager
+}
+
+func NewStore(executor ports.ExecutorManager) *Store {
+	return &Store{executor: executor}
+}
+
+func (s *Store) Get(ctx context.Context, userID, chatID uuid.UUID) (entity.Draft, error) {
+	const op = "draft.Store.Get"
+
+	query := `
+		SELECT content, version, updated_at
+		FROM message_drafts
+		WHERE user_id = $1 AND chat_id = $2
+	`
+
+	draft := entity.Draft{UserID: userID, ChatID: chatID}
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, userID, chatID).Scan(
+		&draft.Content,
+		&draft.Version,
+		&draft.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Draft{}, fmt.Errorf("%s: %w", op, draftErrors.ErrDraftNotFound)
+		}
+		return entity.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return draft, nil
+}
+
+func (s *Store) Upsert(ctx context.Context, drafts []entity.Draft) error {
+	const op = "draft.Store.Upsert"
+
+	if len(drafts) == 0 {
+		return nil
+	}
+
+	// a draft of a chat the user has left by now is dropped instead of failing the whole batch
+	upsertQuery := `
+		INSERT INTO message_drafts (user_id, chat_id, content, version, updated_at)
+		SELECT $1::uuid, $2::uuid, $3::text, $4::bigint, $5::timestamptz
+		WHERE EXISTS (
+			SELECT 1 FROM user_chats WHERE user_id = $1 AND chat_id = $2
+		)
+		ON CONFLICT (user_id, chat_id) DO UPDATE
+		SET content = EXCLUDED.content,
+			version = EXCLUDED.version,
+			updated_at = EXCLUDED.updated_at
+		WHERE message_drafts.version < EXCLUDED.version
+	`
+	deleteQuery := `
+		DELETE FROM message_drafts
+		WHERE user_id = $1 AND chat_id = $2 AND version < $3
+	`
+
+	batch := &pgx.Batch{}
+	for _, d := range drafts {
+		if d.IsEmpty() {
+			batch.Queue(deleteQuery, d.UserID, d.ChatID, d.Version)
+			continue
+		}
+		batch.Queue(upsertQuery, d.UserID, d.ChatID, d.Content, d.Version, d.UpdatedAt)
+	}
+
+	results := s.executor.GetExecutor(ctx).SendBatch(ctx, batch)
+	for range drafts {
+		if _, err := results.Exec(); err != nil {
+			_ = results.Close()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := results.Close(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *Store) ClearBefore(ctx context.Context, userID, chatID uuid.UUID, sentAt time.Time) (int64, bool, error) {
+	const op = "draft.Store.ClearBefore"
+
+	query := `
+		DELETE FROM message_drafts
+		WHERE user_id = $1 AND chat_id = $2 AND updated_at <= $3
+		RETURNING version
+	`
+
+	var version int64
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, userID, chatID, sentAt).Scan(&version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, true, nil
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"awesome-chat/internal/domain/core/export/entity"
+	exportErrors "awesome-chat/internal/domain/core/export/errors"
+	exportPorts "awesome-chat/internal/domain/core/export/ports"
+	"awesome-chat/internal/domain/core/export/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ exportPorts.JobStore = (*JobStore)(nil)
+
+const (
+	// a claim older than this belongs to a worker that died mid-way, it outlasts the job timeout of the worker
+	claimTimeout = "45 minutes"
+	// a failed export waits retryDelay, doubled on every further attempt
+	retryDelay = "1 minute"
+)
+
+type JobStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewJobStore(executor ports.ExecutorManager) *JobStore {
+	return &JobStore{executor: executor}
+}
+
+func (s *JobStore) ClaimPending(ctx context.Context) (entity.Export, error) {
+	const op = "export.JobStore.ClaimPending"
+
+	// the claim is committed right away, an export runs far longer than a transaction should stay open
+	query := `
+		UPDATE chat_exports
+		SET status = $1, claimed_at = NOW(), attempts = attempts + 1
+		WHERE id = (
+			SELECT id
+			FROM chat_exports
+			WHERE (status = $2 AND next_attempt_at <= NOW())
+				OR (status = $1 AND claimed_at < NOW() - $3::interval)
+			ORDER BY requested_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + exportColumns
+
+	e, err := scanExport(s.executor.GetExecutor(ctx).QueryRow(ctx, query,
+		vo.StatusRunning,
+		vo.StatusPending,
+		claimTimeout,
+	))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.Export{}, fmt.Errorf("%s: %w", op, exportErrors.ErrNoPendingExports)
+	case err != nil:
+		return entity.Export{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return e, nil
+}
+
+func (s *JobStore) MarkReady(ctx context.Context, e entity.Export) error {
+	const op = "export.JobStore.MarkReady"
+
+	query := `
+		UPDATE chat_exports
+		SET status = $1,
+			object_key = $2,
+			size_bytes = $3,
+			message_count = $4,
+			error = NULL,
+			claimed_at = NULL,
+			finished_at = NOW()
+		WHERE id = $5
+	`
+
+	if _, err := s.executor.GetExecutor(ctx).Exec(ctx, query,
+		vo.StatusReady,
+		e.ObjectKey,
+		e.SizeBytes,
+		e.MessageCount,
+		e.ID,
+	); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *JobStore) MarkFailed(ctx context.Context, id uuid.UUID, maxAttempts int, reason string) (vo.Status, error) {
+	const op = "export.JobStore.MarkFailed"
+
+	query := `
+		UPDATE chat_exports
+		SET status = CASE WHEN attempts >= $2 THEN $3 ELSE $4 END,
+			error = $5,
+			claimed_at = NULL,
+			next_attempt_at = NOW() + $6::interval * power(2, GREATEST(attempts - 1, 0)),
+			finished_at = CASE WHEN attempts >= $2 THEN NOW() END
+		WHERE id = $1
+		RETURNING status
+	`
+
+	var status string
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx, query,
+		id,
+		maxAttempts,
+		vo.StatusFailed,
+		vo.StatusPending,
+		reason,
+		retryDelay,
+	).Scan(&status); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return vo.Status(status), nil
+}
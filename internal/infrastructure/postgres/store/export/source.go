@@ -0,0 +1,201 @@
+package export
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/export/entity"
+	exportPorts "awesome-chat/internal/domain/core/export/ports"
+	msgVo "awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ exportPorts.SourceStore = (*SourceStore)(nil)
+
+type SourceStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewSourceStore(executor ports.ExecutorManager) *SourceStore {
+	return &SourceStore{executor: executor}
+}
+
+func (s *SourceStore) Chat(ctx context.Context, chatID uuid.UUID) (entity.Chat, error) {
+	const op = "export.SourceStore.Chat"
+
+	query := `SELECT id, chat_name, chat_type, description FROM chats WHERE id = $1`
+
+	var chat entity.Chat
+	err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, chatID).Scan(
+		&chat.ID,
+		&chat.Name,
+		&chat.Type,
+		&chat.Description,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.Chat{}, fmt.Errorf("%s: %w", op, chatErrors.ErrChatNotFound)
+	case err != nil:
+		return entity.Chat{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return chat, nil
+}
+
+// ParticipantsAfter pages members by user id, uuid.Nil starts from the first one.
+func (s *SourceStore) ParticipantsAfter(
+	ctx context.Context,
+	chatID, afterUserID uuid.UUID,
+	limit int,
+) ([]entity.Participant, error) {
+	const op = "export.SourceStore.ParticipantsAfter"
+
+	query := `
+		SELECT uc.user_id, u.username, uc.role, uc.joined_at
+		FROM user_chats uc
+		JOIN users u ON u.id = uc.user_id
+		WHERE uc.chat_id = $1 AND uc.user_id > $2
+		ORDER BY uc.user_id
+		LIMIT $3
+	`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatID, afterUserID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	participants := make([]entity.Participant, 0, limit)
+	for rows.Next() {
+		var p entity.Participant
+		if err = rows.Scan(&p.UserID, &p.Username, &p.Role, &p.JoinedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		participants = append(participants, p)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return participants, nil
+}
+
+// MessagesAfter reads one page oldest first with a keyset on (created_at, id), a zero cursor starts
+// from the first message. Attachments of the page are read with a second query.
+func (s *SourceStore) MessagesAfter(
+	ctx context.Context,
+	chatID uuid.UUID,
+	until time.Time,
+	after msgVo.Cursor,
+	limit int,
+) ([]entity.Message, error) {
+	const op = "export.SourceStore.MessagesAfter"
+
+	args := []any{chatID, until, limit}
+	query := `
+		SELECT m.id, m.message_type, m.user_id, u.username, COALESCE(m.content, ''), m.created_at, m.reply_to_id,
+			v.object_key, v.duration
+		FROM messages m
+		JOIN users u ON u.id = m.user_id
+		LEFT JOIN voice_messages v ON v.message_id = m.id
+		WHERE m.chat_id = $1
+			AND m.created_at <= $2
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	`
+	if !after.IsZero() {
+		query += " AND (m.created_at, m.id) > ($4, $5)"
+		args = append(args, after.CreatedAt, after.ID)
+	}
+	query += " ORDER BY m.created_at, m.id LIMIT $3"
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	messages := make([]entity.Message, 0, limit)
+	index := make(map[int]int, limit)
+	for rows.Next() {
+		var (
+			msg       entity.Message
+			voiceKey  *string
+			voiceSecs *int
+		)
+		if err = rows.Scan(
+			&msg.ID,
+			&msg.Type,
+			&msg.SenderID,
+			&msg.SenderName,
+			&msg.Content,
+			&msg.CreatedAt,
+			&msg.ReplyToID,
+			&voiceKey,
+			&voiceSecs,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if voiceKey != nil {
+			msg.Voice = &entity.VoiceRef{ObjectKey: *voiceKey}
+			if voiceSecs != nil {
+				msg.Voice.Duration = *voiceSecs
+			}
+		}
+		index[msg.ID] = len(messages)
+		messages = append(messages, msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = s.attachAttachments(ctx, messages, index); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return messages, nil
+}
+
+func (s *SourceStore) attachAttachments(ctx context.Context, messages []entity.Message, index map[int]int) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+
+	query := `
+		SELECT message_id, id, kind, file_name, mime_type, size_bytes, object_key
+		FROM attachments
+		WHERE message_id = ANY($1) AND status = 'uploaded'
+		ORDER BY created_at
+	`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageID int
+			a         entity.AttachmentRef
+		)
+		if err = rows.Scan(&messageID, &a.ID, &a.Kind, &a.FileName, &a.MimeType, &a.SizeBytes, &a.ObjectKey); err != nil {
+			return err
+		}
+		i := index[messageID]
+		messages[i].Attachments = append(messages[i].Attachments, a)
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,90 @@
+package export
+
+import (
+	"awesome-chat/internal/domain/core/export/entity"
+	exportErrors "awesome-chat/internal/domain/core/export/errors"
+	exportPorts "awesome-chat/internal/domain/core/export/ports"
+	"awesome-chat/internal/domain/core/export/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var _ exportPorts.Store = (*Store)(nil)
+
+const exportColumns = `
+	id, chat_id, user_id, status, requested_at, attempts,
+	COALESCE(object_key, ''), COALESCE(size_bytes, 0), COALESCE(message_count, 0), COALESCE(error, ''),
+	finished_at
+`
+
+type Store struct {
+	executor ports.ExecutorManager
+}
+
+func NewStore(executor ports.ExecutorManager) *Store {
+	return &Store{executor: executor}
+}
+
+func (s *Store) Create(ctx context.Context, e entity.Export) error {
+	const op = "export.Store.Create"
+
+	query := `
+		INSERT INTO chat_exports (id, chat_id, user_id, status, requested_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (chat_id, user_id) WHERE status IN ('pending', 'running') DO NOTHING
+	`
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx, query, e.ID, e.ChatID, e.UserID, e.Status, e.RequestedAt)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, exportErrors.ErrExportInProgress)
+	}
+
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, id uuid.UUID) (entity.Export, error) {
+	const op = "export.Store.Get"
+
+	e, err := scanExport(s.executor.GetExecutor(ctx).QueryRow(ctx, `SELECT `+exportColumns+` FROM chat_exports WHERE id = $1`, id))
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.Export{}, fmt.Errorf("%s: %w", op, exportErrors.ErrExportNotFound)
+	case err != nil:
+		return entity.Export{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return e, nil
+}
+
+func scanExport(row pgx.Row) (entity.Export, error) {
+	var (
+		e      entity.Export
+		status string
+	)
+	if err := row.Scan(
+		&e.ID,
+		&e.ChatID,
+		&e.UserID,
+		&status,
+		&e.RequestedAt,
+		&e.Attempts,
+		&e.ObjectKey,
+		&e.SizeBytes,
+		&e.MessageCount,
+		&e.Error,
+		&e.FinishedAt,
+	); err != nil {
+		return entity.Export{}, err
+	}
+	e.Status = vo.Status(status)
+
+	return e, nil
+}
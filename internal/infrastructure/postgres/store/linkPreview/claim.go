@@ -0,0 +1,86 @@
+package linkPreview
+
+import (
+	"awesome-chat/internal/domain/core/linkPreview/entity"
+	"awesome-chat/internal/domain/core/linkPreview/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+)
+
+const (
+	// only fresh messages are unfurled, history from before the feature is left alone
+	lookback = "1 day"
+	// a claim older than this belongs to a worker that died mid-way
+	claimTimeout = "5 minutes"
+)
+
+type ClaimStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewClaimStore(executor ports.ExecutorManager) *ClaimStore {
+	return &ClaimStore{executor: executor}
+}
+
+func (s *ClaimStore) ClaimPending(ctx context.Context, limit int) ([]entity.Job, error) {
+	const op = "linkPreview.ClaimStore.ClaimPending"
+
+	// the insert is the claim: with ON CONFLICT only one worker gets a message back
+	query := `
+		WITH stale AS (
+			UPDATE message_link_previews
+			SET claimed_at = NOW()
+			WHERE message_id IN (
+				SELECT message_id
+				FROM message_link_previews
+				WHERE status = $2 AND claimed_at < NOW() - $4::interval
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING message_id
+		), fresh AS (
+			INSERT INTO message_link_previews (message_id, chat_id, status)
+			SELECT m.id, m.chat_id, $2
+			FROM messages m
+			WHERE m.has_links
+				AND m.created_at > NOW() - $3::interval
+				AND (m.expires_at IS NULL OR m.expires_at > NOW())
+				AND NOT EXISTS (
+					SELECT 1 FROM message_link_previews p WHERE p.message_id = m.id
+				)
+			ORDER BY m.created_at
+			LIMIT $1
+			ON CONFLICT (message_id) DO NOTHING
+			RETURNING message_id
+		)
+		SELECT m.id, m.chat_id, m.content
+		FROM messages m
+		WHERE m.id IN (
+			SELECT message_id FROM stale
+			UNION ALL
+			SELECT message_id FROM fresh
+		)
+	`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, limit, vo.StatusPending, lookback, claimTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	jobs := make([]entity.Job, 0, limit)
+	for rows.Next() {
+		var job entity.Job
+		if err = rows.Scan(&job.MessageID, &job.ChatID, &job.Content); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return jobs, nil
+}
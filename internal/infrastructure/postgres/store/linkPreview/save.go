@@ -0,0 +1,46 @@
+package linkPreview
+
+import (
+	"awesome-chat/internal/domain/core/linkPreview/entity"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+)
+
+type SaveStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewSaveStore(executor ports.ExecutorManager) *SaveStore {
+	return &SaveStore{executor: executor}
+}
+
+func (s *SaveStore) Save(ctx context.Context, p entity.LinkPreview) error {
+	const op = "linkPreview.SaveStore.Save"
+
+	query := `
+		UPDATE message_link_previews
+		SET url = $1,
+			title = $2,
+			description = $3,
+			image_url = $4,
+			site_name = $5,
+			status = $6,
+			fetched_at = NOW()
+		WHERE message_id = $7
+	`
+
+	if _, err := s.executor.GetExecutor(ctx).Exec(ctx, query,
+		p.URL,
+		p.Title,
+		p.Description,
+		p.ImageURL,
+		p.SiteName,
+		p.Status,
+		p.MessageID,
+	); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
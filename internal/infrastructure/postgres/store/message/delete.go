@@ -0,0 +1,82 @@
+package message
+
+import (
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type DeleteStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewDeleteStore(executor ports.ExecutorManager) *DeleteStore {
+	return &DeleteStore{executor: executor}
+}
+
+func (s *DeleteStore) Author(ctx context.Context, chatID uuid.UUID, messageID int) (uuid.UUID, error) {
+	const op = "message.DeleteStore.Author"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var authorID uuid.UUID
+	err = tx.QueryRow(ctx, `
+		SELECT user_id
+		FROM messages
+		WHERE id = $1 AND chat_id = $2
+			AND (expires_at IS NULL OR expires_at > NOW())
+		FOR UPDATE
+	`, messageID, chatID).Scan(&authorID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return uuid.Nil, fmt.Errorf("%s: %w", op, msgErrors.ErrMessageNotFound)
+	case err != nil:
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return authorID, nil
+}
+
+// Delete expires the message now instead of removing the row, reads hide it from then on
+// and the purge deletes its attachments and voice objects with the row, as it does for any expired message.
+func (s *DeleteStore) Delete(ctx context.Context, chatID uuid.UUID, messageID int) error {
+	const op = "message.DeleteStore.Delete"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// the projection only moves forward, a deleted last message gives up its row
+	// so the refresh falls back to the message before it
+	tag, err := tx.Exec(ctx, `
+		WITH hidden AS (
+			UPDATE messages SET expires_at = NOW()
+			WHERE id = $1 AND chat_id = $2
+				AND (expires_at IS NULL OR expires_at > NOW())
+			RETURNING id
+		)
+		DELETE FROM chat_last_message
+		WHERE chat_id = $2 AND message_id IN (SELECT id FROM hidden)
+	`, messageID, chatID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+
+	if _, err = tx.Exec(ctx, `SELECT refresh_chat_last_message($1)`, []uuid.UUID{chatID}); err != nil {
+		return fmt.Errorf("%s: refresh last message: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package message
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ExpiryStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewExpiryStore(executor ports.ExecutorManager) *ExpiryStore {
+	return &ExpiryStore{executor: executor}
+}
+
+func (s *ExpiryStore) ClaimExpired(ctx context.Context, limit int) ([]entity.ExpiredMessage, error) {
+	const op = "message.ExpiryStore.ClaimExpired"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		WITH expired AS (
+			SELECT id, chat_id
+			FROM messages
+			WHERE expires_at <= NOW()
+			ORDER BY expires_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		), owned_attachments AS (
+			-- forwarded copies share objects, an object goes only with its last message
+			SELECT a.message_id, a.object_key, a.previews
+			FROM attachments a
+			WHERE a.message_id IN (SELECT id FROM expired)
+				AND NOT EXISTS (
+					SELECT 1 FROM attachments o
+					WHERE o.object_key = a.object_key
+						AND (o.message_id IS NULL OR o.message_id NOT IN (SELECT id FROM expired))
+				)
+		)
+		SELECT
+			e.id,
+			e.chat_id,
+			ARRAY(
+				SELECT a.object_key FROM owned_attachments a WHERE a.message_id = e.id
+				UNION ALL
+				SELECT p->>'object_key'
+				FROM owned_attachments a, jsonb_array_elements(a.previews) p
+				WHERE a.message_id = e.id
+			) AS attachment_keys,
+			ARRAY(
+				SELECT v.object_key
+				FROM voice_messages v
+				WHERE v.message_id = e.id
+					AND v.object_key IS NOT NULL
+					AND NOT EXISTS (
+						SELECT 1 FROM voice_messages o
+						WHERE o.object_key = v.object_key
+							AND o.message_id NOT IN (SELECT id FROM expired)
+					)
+			) AS voice_keys
+		FROM expired e
+	`
+
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	messages := make([]entity.ExpiredMessage, 0)
+	for rows.Next() {
+		var m entity.ExpiredMessage
+		if err = rows.Scan(&m.ID, &m.ChatID, &m.AttachmentKeys, &m.VoiceKeys); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		messages = append(messages, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return messages, nil
+}
+
+// Delete removes the messages, attachments, voice rows, pins and link previews go with them by cascade.
+func (s *ExpiryStore) Delete(ctx context.Context, ids []int) error {
+	const op = "message.ExpiryStore.Delete"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var chatIDs []uuid.UUID
+	err = tx.QueryRow(ctx, `
+		WITH deleted AS (
+			DELETE FROM messages WHERE id = ANY($1) RETURNING chat_id
+		)
+		SELECT ARRAY(SELECT DISTINCT chat_id FROM deleted ORDER BY chat_id)
+	`, ids).Scan(&chatIDs)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// a purged last message took its projection row with it, the chat falls back to the one before.
+	// The ids are sorted so concurrent writers lock the projection rows in the same order.
+	if _, err = tx.Exec(ctx, `SELECT refresh_chat_last_message($1)`, chatIDs); err != nil {
+		return fmt.Errorf("%s: refresh last message: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package message
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type ForwardStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewForwardStore(executor ports.ExecutorManager) *ForwardStore {
+	return &ForwardStore{executor: executor}
+}
+
+func (s *ForwardStore) GetSources(
+	ctx context.Context,
+	chatID uuid.UUID,
+	ids []int,
+) ([]entity.ForwardSource, error) {
+	const op = "message.ForwardStore.GetSources"
+
+	// a forward of a forward keeps the original author
+	query := `
+		SELECT
+			id,
+			COALESCE(content, ''),
+			format,
+			entities,
+			expires_at,
+			COALESCE(forwarded_from_user_id, user_id)::text,
+			COALESCE(forwarded_from_chat_id, chat_id)::text
+		FROM messages
+		WHERE chat_id = $1
+			AND id = ANY($2)
+			AND (expires_at IS NULL OR expires_at > NOW())
+		ORDER BY created_at, id
+	`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	sources := make([]entity.ForwardSource, 0, len(ids))
+	for rows.Next() {
+		var (
+			src      entity.ForwardSource
+			entities []byte
+		)
+		if err = rows.Scan(
+			&src.ID,
+			&src.Content,
+			&src.Format,
+			&entities,
+			&src.ExpiresAt,
+			&src.Origin.UserID,
+			&src.Origin.ChatID,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if len(entities) > 0 {
+			if err = json.Unmarshal(entities, &src.Entities); err != nil {
+				return nil, fmt.Errorf("%s: failed to decode entities: %w", op, err)
+			}
+		}
+		src.Origin.MessageID = src.ID
+		sources = append(sources, src)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sources, nil
+}
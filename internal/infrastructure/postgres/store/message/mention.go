@@ -0,0 +1,218 @@
+package message
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type MentionStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewMentionStore(executor ports.ExecutorManager) *MentionStore {
+	return &MentionStore{executor: executor}
+}
+
+func (s *MentionStore) Feed(ctx context.Context, filter vo.MentionFilter) ([]entity.Mention, error) {
+	const op = "message.MentionStore.Feed"
+
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+
+	args := []any{filter.UserID}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	conditions := []string{
+		"mm.user_id = $1",
+		"(m.expires_at IS NULL OR m.expires_at > NOW())",
+	}
+	if filter.ChatID != nil {
+		conditions = append(conditions, "mm.chat_id = "+arg(*filter.ChatID))
+	}
+	if filter.UnreadOnly {
+		conditions = append(conditions, "mm.read_at IS NULL")
+	}
+	if !filter.Cursor.IsZero() {
+		conditions = append(conditions, fmt.Sprintf(
+			"(m.created_at, m.id) < (%s, %s)",
+			arg(filter.Cursor.CreatedAt), arg(filter.Cursor.ID),
+		))
+	}
+
+	query := `
+        SELECT
+            m.id,
+            m.chat_id,
+            m.user_id,
+            COALESCE(m.content, ''),
+            mm.is_all,
+            m.created_at,
+            mm.read_at
+        FROM message_mentions mm
+        JOIN messages m ON m.id = mm.message_id
+        -- mentions stay behind when the user leaves, the feed only lists the chats they are still in
+        JOIN user_chats uc ON uc.chat_id = mm.chat_id AND uc.user_id = mm.user_id
+        WHERE ` + strings.Join(conditions, " AND ") + `
+        ORDER BY m.created_at DESC, m.id DESC
+        LIMIT ` + arg(filter.Limit)
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var mentions []entity.Mention
+	for rows.Next() {
+		var (
+			m      entity.Mention
+			readAt pgtype.Timestamptz
+		)
+		if err = rows.Scan(
+			&m.MessageID,
+			&m.ChatID,
+			&m.SenderID,
+			&m.Content,
+			&m.IsAll,
+			&m.Timestamp,
+			&readAt,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if readAt.Valid {
+			m.ReadAt = &readAt.Time
+		}
+		mentions = append(mentions, m)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return mentions, nil
+}
+
+func (s *MentionStore) MarkRead(ctx context.Context, userID, chatID uuid.UUID) (int, error) {
+	const op = "message.MentionStore.MarkRead"
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx, `
+		UPDATE message_mentions
+		SET read_at = NOW()
+		WHERE user_id = $1 AND chat_id = $2 AND read_at IS NULL
+	`, userID, chatID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+type MentionNotifyStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewMentionNotifyStore(executor ports.ExecutorManager) *MentionNotifyStore {
+	return &MentionNotifyStore{executor: executor}
+}
+
+func (s *MentionNotifyStore) ClaimUnnotified(ctx context.Context, limit int) ([]entity.MentionNotice, error) {
+	const op = "message.MentionNotifyStore.ClaimUnnotified"
+
+	conn, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+        SELECT
+            mm.message_id,
+            mm.chat_id,
+            mm.user_id,
+            m.user_id,
+            COALESCE(m.content, ''),
+            mm.is_all,
+            m.created_at,
+            COALESCE(uc.muted_until > NOW(), FALSE)
+        FROM message_mentions mm
+        JOIN messages m ON m.id = mm.message_id
+        LEFT JOIN user_chats uc ON uc.chat_id = mm.chat_id AND uc.user_id = mm.user_id
+        WHERE mm.notified_at IS NULL
+            AND (m.expires_at IS NULL OR m.expires_at > NOW())
+        ORDER BY mm.created_at
+        LIMIT $1
+        FOR UPDATE OF mm SKIP LOCKED
+    `
+
+	rows, err := conn.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var notices []entity.MentionNotice
+	for rows.Next() {
+		var n entity.MentionNotice
+		if err = rows.Scan(
+			&n.MessageID,
+			&n.ChatID,
+			&n.UserID,
+			&n.SenderID,
+			&n.Content,
+			&n.IsAll,
+			&n.Timestamp,
+			&n.Muted,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		notices = append(notices, n)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return notices, nil
+}
+
+func (s *MentionNotifyStore) MarkNotified(ctx context.Context, notices []entity.MentionNotice) error {
+	const op = "message.MentionNotifyStore.MarkNotified"
+
+	if len(notices) == 0 {
+		return nil
+	}
+
+	conn, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	messageIDs := make([]int64, 0, len(notices))
+	userIDs := make([]uuid.UUID, 0, len(notices))
+	for _, n := range notices {
+		messageIDs = append(messageIDs, int64(n.MessageID))
+		userIDs = append(userIDs, n.UserID)
+	}
+
+	if _, err = conn.Exec(ctx, `
+		UPDATE message_mentions mm
+		SET notified_at = NOW()
+		FROM unnest($1::bigint[], $2::uuid[]) AS n(message_id, user_id)
+		WHERE mm.message_id = n.message_id AND mm.user_id = n.user_id
+	`, messageIDs, userIDs); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
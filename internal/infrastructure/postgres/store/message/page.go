@@ -0,0 +1,227 @@
+package message
+
+import (
+	linkPreviewVo "awesome-chat/internal/domain/core/linkPreview/vo"
+	"awesome-chat/internal/domain/core/message/entity"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const selectPageMessages = `
+	SELECT
+		m.id, m.message_type, m.user_id, COALESCE(m.content, ''), m.format, m.entities, m.created_at, m.system_payload,
+		lp.url, lp.title, lp.description, lp.image_url, lp.site_name,
+		m.forwarded_from_message_id, m.forwarded_from_user_id::text, m.forwarded_from_chat_id::text
+	FROM messages m
+	LEFT JOIN message_link_previews lp ON lp.message_id = m.id AND lp.status = 'ready'
+	WHERE m.chat_id = $1
+		AND (m.expires_at IS NULL OR m.expires_at > NOW())
+`
+
+type PageStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewPageStore(executor ports.ExecutorManager) *PageStore {
+	return &PageStore{executor: executor}
+}
+
+// Page reads one page with a keyset on (created_at, id), every side is fetched with one extra row
+// to know whether there is more.
+func (s *PageStore) Page(ctx context.Context, q vo.PageQuery) (entity.MessagePage, error) {
+	const op = "message.PageStore.Page"
+
+	var page entity.MessagePage
+
+	switch q.Direction {
+	case vo.PageAfter:
+		newer, fetchErr := s.fetch(ctx, q.ChatID, q.Cursor, ">", q.Limit+1)
+		if fetchErr != nil {
+			return entity.MessagePage{}, fmt.Errorf("%s: %w", op, fetchErr)
+		}
+		page = assemblePage(nil, 0, newer, q.Limit)
+		// the cursor came from an older message
+		page.HasOlder = true
+
+	case vo.PageAround:
+		anchor, anchorErr := s.anchor(ctx, q.ChatID, q.AroundID)
+		if anchorErr != nil {
+			return entity.MessagePage{}, fmt.Errorf("%s: %w", op, anchorErr)
+		}
+
+		// the anchor goes to the newer side, so it is always on the page
+		olderLimit := (q.Limit - 1) / 2
+		newerLimit := q.Limit - olderLimit
+
+		older, fetchErr := s.fetch(ctx, q.ChatID, anchor, "<", olderLimit+1)
+		if fetchErr != nil {
+			return entity.MessagePage{}, fmt.Errorf("%s: %w", op, fetchErr)
+		}
+		newer, fetchErr := s.fetch(ctx, q.ChatID, anchor, ">=", newerLimit+1)
+		if fetchErr != nil {
+			return entity.MessagePage{}, fmt.Errorf("%s: %w", op, fetchErr)
+		}
+		page = assemblePage(older, olderLimit, newer, newerLimit)
+
+	default:
+		older, fetchErr := s.fetch(ctx, q.ChatID, q.Cursor, "<", q.Limit+1)
+		if fetchErr != nil {
+			return entity.MessagePage{}, fmt.Errorf("%s: %w", op, fetchErr)
+		}
+		page = assemblePage(older, q.Limit, nil, 0)
+		page.HasNewer = !q.Cursor.IsZero()
+	}
+
+	return page, nil
+}
+
+// assemblePage trims the extra rows and joins both sides newest first.
+// Older rows come newest first, newer rows oldest first, as the queries return them.
+func assemblePage(
+	older []entity.MessageForPreview,
+	olderLimit int,
+	newer []entity.MessageForPreview,
+	newerLimit int,
+) entity.MessagePage {
+	page := entity.MessagePage{
+		HasOlder: len(older) > olderLimit,
+		HasNewer: len(newer) > newerLimit,
+	}
+
+	newer = newer[:min(len(newer), newerLimit)]
+	older = older[:min(len(older), olderLimit)]
+
+	page.Messages = make([]entity.MessageForPreview, 0, len(newer)+len(older))
+	page.Messages = append(page.Messages, newer...)
+	slices.Reverse(page.Messages)
+	page.Messages = append(page.Messages, older...)
+
+	return page
+}
+
+func (s *PageStore) anchor(ctx context.Context, chatID uuid.UUID, messageID int) (vo.Cursor, error) {
+	query := `
+		SELECT created_at, id
+		FROM messages
+		WHERE chat_id = $1
+			AND id = $2
+			AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	var c vo.Cursor
+	err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, chatID, messageID).Scan(&c.CreatedAt, &c.ID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return vo.Cursor{}, msgErrors.ErrMessageNotFound
+	case err != nil:
+		return vo.Cursor{}, err
+	}
+
+	return c, nil
+}
+
+// fetch reads rows on one side of the cursor, < and <= read newest first, > and >= oldest first.
+// A zero cursor reads from the newest message.
+func (s *PageStore) fetch(
+	ctx context.Context,
+	chatID uuid.UUID,
+	cursor vo.Cursor,
+	cmp string,
+	limit int,
+) ([]entity.MessageForPreview, error) {
+	order := "DESC"
+	if cmp == ">" || cmp == ">=" {
+		order = "ASC"
+	}
+
+	args := []any{chatID, limit}
+	query := selectPageMessages
+	if !cursor.IsZero() {
+		query += fmt.Sprintf(" AND (m.created_at, m.id) %s ($3, $4)", cmp)
+		args = append(args, cursor.CreatedAt, cursor.ID)
+	}
+	query += fmt.Sprintf(" ORDER BY m.created_at %s, m.id %s LIMIT $2", order, order)
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := make([]entity.MessageForPreview, 0, limit)
+	for rows.Next() {
+		var (
+			msg                                      entity.MessageForPreview
+			url, title, description, image, siteName *string
+			fwdMessageID                             *int
+			fwdUserID, fwdChatID                     *string
+			entities                                 []byte
+		)
+		if err = rows.Scan(
+			&msg.ID,
+			&msg.Type,
+			&msg.SenderID,
+			&msg.Text,
+			&msg.Format,
+			&entities,
+			&msg.Timestamp,
+			&msg.System,
+			&url,
+			&title,
+			&description,
+			&image,
+			&siteName,
+			&fwdMessageID,
+			&fwdUserID,
+			&fwdChatID,
+		); err != nil {
+			return nil, err
+		}
+		if len(entities) > 0 {
+			if err = json.Unmarshal(entities, &msg.Entities); err != nil {
+				return nil, fmt.Errorf("failed to decode entities: %w", err)
+			}
+		}
+		if url != nil {
+			msg.LinkPreview = &linkPreviewVo.Metadata{
+				URL:         *url,
+				Title:       deref(title),
+				Description: deref(description),
+				ImageURL:    deref(image),
+				SiteName:    deref(siteName),
+			}
+		}
+		if fwdUserID != nil || fwdChatID != nil {
+			msg.ForwardedFrom = &vo.ForwardOrigin{
+				UserID: deref(fwdUserID),
+				ChatID: deref(fwdChatID),
+			}
+			if fwdMessageID != nil {
+				msg.ForwardedFrom.MessageID = *fwdMessageID
+			}
+		}
+		messages = append(messages, msg)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
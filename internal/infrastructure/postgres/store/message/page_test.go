@@ -0,0 +1,81 @@
+package message
+
+import (
+	"slices"
+	"testing"
+
+	"awesome-chat/internal/domain/core/message/entity"
+)
+
+func TestAssemblePage(t *testing.T) {
+	rows := func(ids ...int) []entity.MessageForPreview {
+		messages := make([]entity.MessageForPreview, 0, len(ids))
+		for _, id := range ids {
+			messages = append(messages, entity.MessageForPreview{ID: id})
+		}
+		return messages
+	}
+
+	tests := []struct {
+		name       string
+		older      []entity.MessageForPreview
+		olderLimit int
+		newer      []entity.MessageForPreview
+		newerLimit int
+		expected   []int
+		hasOlder   bool
+		hasNewer   bool
+	}{
+		{
+			name:       "Older page with more left",
+			older:      rows(9, 8, 7, 6),
+			olderLimit: 3,
+			expected:   []int{9, 8, 7},
+			hasOlder:   true,
+		},
+		{
+			name:       "Last older page",
+			older:      rows(2, 1),
+			olderLimit: 3,
+			expected:   []int{2, 1},
+		},
+		{
+			name:       "Newer page comes back newest first",
+			newer:      rows(4, 5, 6, 7),
+			newerLimit: 3,
+			expected:   []int{6, 5, 4},
+			hasNewer:   true,
+		},
+		{
+			name:       "Around keeps the anchor",
+			older:      rows(4, 3, 2),
+			olderLimit: 2,
+			newer:      rows(5, 6, 7),
+			newerLimit: 3,
+			expected:   []int{7, 6, 5, 4, 3},
+			hasOlder:   true,
+		},
+		{
+			name:       "Empty chat",
+			olderLimit: 3,
+			expected:   []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := assemblePage(tt.older, tt.olderLimit, tt.newer, tt.newerLimit)
+
+			ids := make([]int, 0, len(page.Messages))
+			for _, m := range page.Messages {
+				ids = append(ids, m.ID)
+			}
+			if !slices.Equal(ids, tt.expected) {
+				t.Errorf("ids = %v, want %v", ids, tt.expected)
+			}
+			if page.HasOlder != tt.hasOlder || page.HasNewer != tt.hasNewer {
+				t.Errorf("has older/newer = %v/%v, want %v/%v", page.HasOlder, page.HasNewer, tt.hasOlder, tt.hasNewer)
+			}
+		})
+	}
+}
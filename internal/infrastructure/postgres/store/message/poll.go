@@ -0,0 +1,319 @@
+package message
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type PollStore struct {
+	executor ports.ExecutorManager
+	ids      ports.IDGenerator
+}
+
+func NewPollStore(executor ports.ExecutorManager, ids ports.IDGenerator) *PollStore {
+	return &PollStore{executor: executor, ids: ids}
+}
+
+func (s *PollStore) Create(
+	ctx context.Context,
+	userID, chatID uuid.UUID,
+	draft vo.PollDraft,
+) (entity.Poll, error) {
+	const op = "message.PollStore.Create"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return entity.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	poll := entity.Poll{
+		MessageID:      s.ids.NextID(),
+		ChatID:         chatID,
+		AuthorID:       userID,
+		Question:       draft.Question,
+		MultipleChoice: draft.MultipleChoice,
+		Anonymous:      draft.Anonymous,
+		ClosesAt:       draft.ClosesAt,
+		Options:        make([]entity.PollOption, 0, len(draft.Options)),
+		MyVote:         []int{},
+	}
+
+	messageQuery := `
+		INSERT INTO messages (
+			id,
+			user_id,
+			chat_id,
+			message_type,
+			content
+		) VALUES ($1, $2, $3, 'poll', $4)
+		RETURNING created_at
+	`
+
+	err = tx.QueryRow(ctx, messageQuery, poll.MessageID, userID, chatID, draft.Question).Scan(&poll.CreatedAt)
+	if err != nil {
+		return entity.Poll{}, fmt.Errorf("%s: failed to insert message: %w", op, err)
+	}
+
+	pollQuery := `
+		INSERT INTO polls (
+			message_id,
+			multiple_choice,
+			anonymous,
+			closes_at
+		) VALUES ($1, $2, $3, $4)
+	`
+
+	_, err = tx.Exec(ctx, pollQuery, poll.MessageID, draft.MultipleChoice, draft.Anonymous, draft.ClosesAt)
+	if err != nil {
+		return entity.Poll{}, fmt.Errorf("%s: failed to insert poll: %w", op, err)
+	}
+
+	optionsQuery := `
+		INSERT INTO poll_options (poll_id, position, text)
+		SELECT $1, o.ord - 1, o.text
+		FROM unnest($2::text[]) WITH ORDINALITY AS o(text, ord)
+	`
+
+	_, err = tx.Exec(ctx, optionsQuery, poll.MessageID, draft.Options)
+	if err != nil {
+		return entity.Poll{}, fmt.Errorf("%s: failed to insert options: %w", op, err)
+	}
+
+	if _, err = tx.Exec(ctx, `SELECT refresh_chat_last_message(ARRAY[$1::uuid])`, chatID); err != nil {
+		return entity.Poll{}, fmt.Errorf("%s: failed to refresh last message: %w", op, err)
+	}
+
+	for i, text := range draft.Options {
+		poll.Options = append(poll.Options, entity.PollOption{Position: i, Text: text})
+	}
+
+	return poll, nil
+}
+
+func (s *PollStore) Get(ctx context.Context, messageID int, viewer uuid.UUID) (entity.Poll, error) {
+	const op = "message.PollStore.Get"
+
+	polls, err := s.GetMany(ctx, []int{messageID}, viewer)
+	if err != nil {
+		return entity.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	poll, ok := polls[messageID]
+	if !ok {
+		return entity.Poll{}, fmt.Errorf("%s: %w", op, msgErrors.ErrPollNotFound)
+	}
+
+	return poll, nil
+}
+
+func (s *PollStore) GetMany(
+	ctx context.Context,
+	messageIDs []int,
+	viewer uuid.UUID,
+) (map[int]entity.Poll, error) {
+	const op = "message.PollStore.GetMany"
+
+	polls := make(map[int]entity.Poll, len(messageIDs))
+	if len(messageIDs) == 0 {
+		return polls, nil
+	}
+
+	executor := s.executor.GetExecutor(ctx)
+
+	pollsQuery := `
+		SELECT
+			p.message_id,
+			m.chat_id,
+			m.user_id,
+			COALESCE(m.content, ''),
+			p.multiple_choice,
+			p.anonymous,
+			p.closes_at,
+			m.created_at,
+			(SELECT COUNT(DISTINCT v.user_id) FROM poll_votes v WHERE v.poll_id = p.message_id)
+		FROM polls p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.message_id = ANY($1)
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	`
+
+	rows, err := executor.Query(ctx, pollsQuery, messageIDs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p entity.Poll
+		if err = rows.Scan(
+			&p.MessageID,
+			&p.ChatID,
+			&p.AuthorID,
+			&p.Question,
+			&p.MultipleChoice,
+			&p.Anonymous,
+			&p.ClosesAt,
+			&p.CreatedAt,
+			&p.TotalVoters,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		p.MyVote = []int{}
+		polls[p.MessageID] = p
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(polls) == 0 {
+		return polls, nil
+	}
+
+	// voters of anonymous polls never leave the database
+	optionsQuery := `
+		SELECT
+			o.poll_id,
+			o.position,
+			o.text,
+			COUNT(v.user_id),
+			COALESCE(array_agg(v.user_id::text ORDER BY v.voted_at) FILTER (
+				WHERE v.user_id IS NOT NULL AND NOT p.anonymous
+			), '{}'),
+			COALESCE(bool_or(v.user_id = $2), FALSE)
+		FROM poll_options o
+		JOIN polls p ON p.message_id = o.poll_id
+		LEFT JOIN poll_votes v ON v.poll_id = o.poll_id AND v.position = o.position
+		WHERE o.poll_id = ANY($1)
+		GROUP BY o.poll_id, o.position, o.text, p.anonymous
+		ORDER BY o.poll_id, o.position
+	`
+
+	optionRows, err := executor.Query(ctx, optionsQuery, messageIDs, viewer)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer optionRows.Close()
+
+	for optionRows.Next() {
+		var (
+			pollID int
+			option entity.PollOption
+			voters []string
+			mine   bool
+		)
+		if err = optionRows.Scan(
+			&pollID,
+			&option.Position,
+			&option.Text,
+			&option.Votes,
+			&voters,
+			&mine,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		poll, ok := polls[pollID]
+		if !ok {
+			continue
+		}
+
+		for _, raw := range voters {
+			voter, err := uuid.Parse(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			option.Voters = append(option.Voters, voter)
+		}
+
+		poll.Options = append(poll.Options, option)
+		if mine {
+			poll.MyVote = append(poll.MyVote, option.Position)
+		}
+		polls[pollID] = poll
+	}
+
+	if err = optionRows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return polls, nil
+}
+
+func (s *PollStore) LockForVote(ctx context.Context, messageID int) (entity.Poll, error) {
+	const op = "message.PollStore.LockForVote"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return entity.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		SELECT
+			m.chat_id,
+			p.multiple_choice,
+			p.closes_at,
+			(SELECT COUNT(*) FROM poll_options o WHERE o.poll_id = p.message_id)
+		FROM polls p
+		JOIN messages m ON m.id = p.message_id
+		WHERE p.message_id = $1
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+		FOR UPDATE OF p
+	`
+
+	poll := entity.Poll{MessageID: messageID}
+	var optionsCount int
+	err = tx.QueryRow(ctx, query, messageID).Scan(&poll.ChatID, &poll.MultipleChoice, &poll.ClosesAt, &optionsCount)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.Poll{}, fmt.Errorf("%s: %w", op, msgErrors.ErrPollNotFound)
+	case err != nil:
+		return entity.Poll{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	poll.Options = make([]entity.PollOption, optionsCount)
+	for i := range poll.Options {
+		poll.Options[i].Position = i
+	}
+
+	return poll, nil
+}
+
+func (s *PollStore) ReplaceVote(ctx context.Context, messageID int, userID uuid.UUID, choices []int) error {
+	const op = "message.PollStore.ReplaceVote"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM poll_votes WHERE poll_id = $1 AND user_id = $2`, messageID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to retract vote: %w", op, err)
+	}
+
+	if len(choices) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO poll_votes (poll_id, position, user_id)
+		SELECT $1, c, $2
+		FROM unnest($3::smallint[]) AS c
+	`
+
+	_, err = tx.Exec(ctx, query, messageID, userID, choices)
+	if err != nil {
+		return fmt.Errorf("%s: failed to insert vote: %w", op, err)
+	}
+
+	return nil
+}
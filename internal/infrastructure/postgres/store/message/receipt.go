@@ -0,0 +1,311 @@
+package message
+
+import (
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/message/entity"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ReceiptStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewReceiptStore(executor ports.ExecutorManager) *ReceiptStore {
+	return &ReceiptStore{executor: executor}
+}
+
+func (s *ReceiptStore) Advance(
+	ctx context.Context,
+	userID, chatID uuid.UUID,
+	cursors vo.ReceiptCursors,
+) (entity.ReceiptAdvance, error) {
+	const op = "message.ReceiptStore.Advance"
+
+	// GREATEST skips nulls, so a missing cursor stays as it was
+	query := `
+		UPDATE user_chats uc
+		SET delivered_until = GREATEST(uc.delivered_until, $3),
+			read_until = GREATEST(uc.read_until, $4)
+		FROM (
+			SELECT delivered_until, read_until
+			FROM user_chats
+			WHERE user_id = $1 AND chat_id = $2
+			FOR UPDATE
+		) prev
+		WHERE uc.user_id = $1 AND uc.chat_id = $2
+		RETURNING
+			prev.delivered_until,
+			prev.read_until,
+			uc.delivered_until,
+			uc.read_until,
+			(SELECT COUNT(*) FROM user_chats WHERE chat_id = $2)
+	`
+
+	var a entity.ReceiptAdvance
+	err := s.executor.GetExecutor(ctx).QueryRow(
+		ctx,
+		query,
+		userID,
+		chatID,
+		cursors.DeliveredUntil,
+		cursors.ReadUntil,
+	).Scan(
+		&a.Prev.DeliveredUntil,
+		&a.Prev.ReadUntil,
+		&a.Current.DeliveredUntil,
+		&a.Current.ReadUntil,
+		&a.Members,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.ReceiptAdvance{}, fmt.Errorf("%s: %w", op, chatErrors.ErrNotChatMember)
+	case err != nil:
+		return entity.ReceiptAdvance{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a, nil
+}
+
+func (s *ReceiptStore) Enqueue(ctx context.Context, chatID uuid.UUID, since time.Time) error {
+	const op = "message.ReceiptStore.Enqueue"
+
+	if err := enqueueRecount(ctx, s.executor, chatID, since); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *ReceiptStore) Get(ctx context.Context, messageID int) (entity.MessageReceipts, error) {
+	const op = "message.ReceiptStore.Get"
+
+	query := `
+		SELECT
+			m.chat_id,
+			m.user_id,
+			m.created_at,
+			(SELECT COUNT(*) FROM user_chats uc WHERE uc.chat_id = m.chat_id),
+			COALESCE(rc.delivered_count, 0),
+			COALESCE(rc.read_count, 0)
+		FROM messages m
+		LEFT JOIN message_receipt_counts rc ON rc.message_id = m.id
+		WHERE m.id = $1
+			AND (m.expires_at IS NULL OR m.expires_at > NOW())
+	`
+
+	r := entity.MessageReceipts{MessageID: messageID}
+	err := s.executor.GetExecutor(ctx).QueryRow(ctx, query, messageID).Scan(
+		&r.ChatID,
+		&r.SenderID,
+		&r.Timestamp,
+		&r.Members,
+		&r.Delivered,
+		&r.Read,
+	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return entity.MessageReceipts{}, fmt.Errorf("%s: %w", op, msgErrors.ErrMessageNotFound)
+	case err != nil:
+		return entity.MessageReceipts{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r, nil
+}
+
+func (s *ReceiptStore) Recipients(ctx context.Context, chatID, senderID uuid.UUID) ([]entity.RecipientCursors, error) {
+	const op = "message.ReceiptStore.Recipients"
+
+	query := `
+		SELECT user_id, delivered_until, read_until
+		FROM user_chats
+		WHERE chat_id = $1 AND user_id <> $2
+		ORDER BY user_id
+	`
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, chatID, senderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var recipients []entity.RecipientCursors
+	for rows.Next() {
+		var r entity.RecipientCursors
+		if err = rows.Scan(&r.UserID, &r.Cursors.DeliveredUntil, &r.Cursors.ReadUntil); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		recipients = append(recipients, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return recipients, nil
+}
+
+type ReceiptCountStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewReceiptCountStore(executor ports.ExecutorManager) *ReceiptCountStore {
+	return &ReceiptCountStore{executor: executor}
+}
+
+func (s *ReceiptCountStore) ClaimQueued(ctx context.Context, limit int) ([]entity.ReceiptQueueEntry, error) {
+	const op = "message.ReceiptCountStore.ClaimQueued"
+
+	conn, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		DELETE FROM receipt_count_queue q
+		WHERE q.chat_id IN (
+			SELECT chat_id
+			FROM receipt_count_queue
+			ORDER BY queued_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING q.chat_id, q.since
+	`
+
+	rows, err := conn.Query(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var entries []entity.ReceiptQueueEntry
+	for rows.Next() {
+		var e entity.ReceiptQueueEntry
+		if err = rows.Scan(&e.ChatID, &e.Since); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		entries = append(entries, e)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return entries, nil
+}
+
+func (s *ReceiptCountStore) Recount(
+	ctx context.Context,
+	chatID uuid.UUID,
+	since time.Time,
+	limit int,
+) (entity.ReceiptRecount, error) {
+	const op = "message.ReceiptCountStore.Recount"
+
+	conn, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return entity.ReceiptRecount{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var recount entity.ReceiptRecount
+	err = conn.QueryRow(ctx, `SELECT COUNT(*) FROM user_chats WHERE chat_id = $1`, chatID).Scan(&recount.Members)
+	if err != nil {
+		return entity.ReceiptRecount{}, fmt.Errorf("%s: failed to count members: %w", op, err)
+	}
+
+	// messages nobody got yet are not stored, a missing row reads as zero counts
+	query := `
+		WITH counted AS (
+			SELECT
+				m.id,
+				m.chat_id,
+				m.user_id,
+				m.created_at,
+				(SELECT COUNT(*) FROM user_chats uc
+					WHERE uc.chat_id = m.chat_id AND uc.user_id <> m.user_id AND uc.delivered_until >= m.created_at
+				) AS delivered,
+				(SELECT COUNT(*) FROM user_chats uc
+					WHERE uc.chat_id = m.chat_id AND uc.user_id <> m.user_id AND uc.read_until >= m.created_at
+				) AS read
+			FROM messages m
+			WHERE m.chat_id = $1
+				AND m.created_at > $2
+				AND (m.expires_at IS NULL OR m.expires_at > NOW())
+			ORDER BY m.created_at, m.id
+			LIMIT $3
+		), changed AS (
+			INSERT INTO message_receipt_counts (message_id, chat_id, delivered_count, read_count)
+			SELECT id, chat_id, delivered, read
+			FROM counted
+			WHERE delivered > 0 OR read > 0
+			ON CONFLICT (message_id) DO UPDATE
+			SET delivered_count = EXCLUDED.delivered_count,
+				read_count = EXCLUDED.read_count,
+				updated_at = NOW()
+			WHERE (message_receipt_counts.delivered_count, message_receipt_counts.read_count)
+				IS DISTINCT FROM (EXCLUDED.delivered_count, EXCLUDED.read_count)
+			RETURNING message_id
+		)
+		SELECT c.id, c.user_id, c.created_at, c.delivered, c.read, c.id IN (SELECT message_id FROM changed)
+		FROM counted c
+		ORDER BY c.created_at, c.id
+	`
+
+	rows, err := conn.Query(ctx, query, chatID, since, limit)
+	if err != nil {
+		return entity.ReceiptRecount{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			c       entity.ReceiptCount
+			changed bool
+		)
+		if err = rows.Scan(&c.MessageID, &c.SenderID, &c.Timestamp, &c.Delivered, &c.Read, &changed); err != nil {
+			return entity.ReceiptRecount{}, fmt.Errorf("%s: %w", op, err)
+		}
+		recount.Scanned++
+		recount.Last = c.Timestamp
+		if changed {
+			recount.Changed = append(recount.Changed, c)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return entity.ReceiptRecount{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return recount, nil
+}
+
+func (s *ReceiptCountStore) Enqueue(ctx context.Context, chatID uuid.UUID, since time.Time) error {
+	const op = "message.ReceiptCountStore.Enqueue"
+
+	if err := enqueueRecount(ctx, s.executor, chatID, since); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// enqueueRecount keeps the earliest since of a chat, so a pending recount never shrinks.
+func enqueueRecount(ctx context.Context, executor ports.ExecutorManager, chatID uuid.UUID, since time.Time) error {
+	_, err := executor.GetExecutor(ctx).Exec(ctx, `
+		INSERT INTO receipt_count_queue (chat_id, since)
+		VALUES ($1, $2)
+		ON CONFLICT (chat_id) DO UPDATE
+		SET since = LEAST(receipt_count_queue.since, EXCLUDED.since)
+	`, chatID, since)
+
+	return err
+}
@@ -4,7 +4,11 @@ import (
 	"awesome-chat/internal/domain/core/message/vo"
 	"awesome-chat/internal/domain/core/shared/ports"
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/jackc/pgx/v5"
 )
 
@@ -16,32 +20,150 @@ func NewSaveFromStreamStore(executor ports.ExecutorManager) *SaveFromStreamStore
 	return &SaveFromStreamStore{executor: executor}
 }
 
-func (s *SaveFromStreamStore) SaveBatch(ctx context.Context, messages []vo.StreamMessage) error {
+func (s *SaveFromStreamStore) SaveBatch(ctx context.Context, messages []vo.StreamMessage) ([]int, error) {
 	const op = "message.SaveFromStreamStore.SaveBatch"
 
 	if len(messages) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	tx, err := s.executor.GetPool().Begin(ctx)
 	if err != nil {
-		return fmt.Errorf("%s: begin transaction failed: %w", op, err)
+		return nil, fmt.Errorf("%s: begin transaction failed: %w", op, err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	_, err = tx.CopyFrom(
+	// entries published before ids were assigned at publish time take theirs from the sequence
+	var withID, withoutID []vo.StreamMessage
+	for _, msg := range messages {
+		if msg.ID != 0 {
+			withID = append(withID, msg)
+		} else {
+			withoutID = append(withoutID, msg)
+		}
+	}
+
+	var collided []int
+	if len(withID) > 0 {
+		// a redelivered stream entry must not fail the batch, so rows go through a staging table
+		// and ids already stored are skipped
+		_, err = tx.Exec(ctx, `CREATE TEMP TABLE incoming_messages (LIKE messages INCLUDING DEFAULTS) ON COMMIT DROP`)
+		if err != nil {
+			return nil, fmt.Errorf("%s: create staging table failed: %w", op, err)
+		}
+		if err = copyMessages(ctx, tx, "incoming_messages", withID, true); err != nil {
+			return nil, fmt.Errorf("%s: copy from failed: %w", op, err)
+		}
+		columns := strings.Join(append(slices.Clone(messageColumns), "id"), ", ")
+		_, err = tx.Exec(ctx, `
+			INSERT INTO messages (`+columns+`)
+			SELECT `+columns+` FROM incoming_messages
+			ON CONFLICT (id) DO NOTHING
+		`)
+		if err != nil {
+			return nil, fmt.Errorf("%s: insert from staging table failed: %w", op, err)
+		}
+		if collided, err = collidedIDs(ctx, tx); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if err = copyMessages(ctx, tx, "messages", withoutID, false); err != nil {
+		return nil, fmt.Errorf("%s: copy from failed: %w", op, err)
+	}
+
+	// previews read the last message of a chat from the projection, it is kept in the same transaction
+	if _, err = tx.Exec(ctx, `SELECT refresh_chat_last_message($1)`, vo.StreamChatIDs(messages)); err != nil {
+		return nil, fmt.Errorf("%s: refresh last message failed: %w", op, err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return collided, nil
+}
+
+// collidedIDs finds the staged ids a different message was stored under, a redelivered entry
+// matches the stored one by chat, author and time.
+func collidedIDs(ctx context.Context, tx pgx.Tx) ([]int, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT i.id
+		FROM incoming_messages i
+		JOIN messages m ON m.id = i.id
+		WHERE (m.chat_id, m.user_id, m.created_at) IS DISTINCT FROM (i.chat_id, i.user_id, i.created_at)
+		ORDER BY i.id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("find collided ids failed: %w", err)
+	}
+
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err = rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("find collided ids failed: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("find collided ids failed: %w", err)
+	}
+	return ids, nil
+}
+
+var messageColumns = []string{
+	"user_id", "chat_id", "content", "created_at", "expires_at",
+	"forwarded_from_message_id", "forwarded_from_user_id", "forwarded_from_chat_id",
+	"mentioned_usernames", "mentions_all", "format", "entities",
+}
+
+func copyMessages(ctx context.Context, tx pgx.Tx, table string, messages []vo.StreamMessage, withID bool) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	columns := slices.Clone(messageColumns)
+	if withID {
+		columns = append(columns, "id")
+	}
+
+	_, err := tx.CopyFrom(
 		ctx,
-		pgx.Identifier{"messages"},
-		[]string{"user_id", "chat_id", "content", "created_at"},
+		pgx.Identifier{table},
+		columns,
 		pgx.CopyFromSlice(len(messages), func(i int) ([]any, error) {
 			msg := messages[i]
-			return []any{msg.UserID, msg.ChatID, msg.Content, msg.Timestamp}, nil
+			row := []any{
+				msg.UserID, msg.ChatID, msg.Content, msg.Timestamp, msg.ExpiresAtOrNil(),
+				nil, nil, nil, nil, false, string(msg.FormatOrPlain()), nil,
+			}
+			if withID {
+				row = append(row, msg.ID)
+			}
+			if len(msg.Entities) > 0 {
+				entities, err := json.Marshal(msg.Entities)
+				if err != nil {
+					return nil, err
+				}
+				row[11] = entities
+			}
+			if from := msg.ForwardedFrom; !from.IsZero() {
+				if from.MessageID > 0 {
+					row[5] = from.MessageID
+				}
+				row[6], row[7] = from.UserID, from.ChatID
+				// a forward does not mention anyone again
+				return row, nil
+			}
+			// the database keeps mentions of chat members only
+			if mentions := msg.Mentions(); !mentions.IsZero() {
+				row[8], row[9] = mentions.Usernames, mentions.All
+			}
+			return row, nil
 		}),
 	)
 
-	if err != nil {
-		return fmt.Errorf("%s: copy from failed: %w", op, err)
-	}
-
-	return tx.Commit(ctx)
+	return err
 }
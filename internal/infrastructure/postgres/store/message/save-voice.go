@@ -9,10 +9,11 @@ import (
 
 type SaveVoiceStore struct {
 	executor ports.ExecutorManager
+	ids      ports.IDGenerator
 }
 
-func NewSaveVoiceStore(executor ports.ExecutorManager) *SaveVoiceStore {
-	return &SaveVoiceStore{executor: executor}
+func NewSaveVoiceStore(executor ports.ExecutorManager, ids ports.IDGenerator) *SaveVoiceStore {
+	return &SaveVoiceStore{executor: executor, ids: ids}
 }
 
 func (s *SaveVoiceStore) Execute(ctx context.Context, data vo.SaveVoiceData) error {
@@ -25,16 +26,16 @@ func (s *SaveVoiceStore) Execute(ctx context.Context, data vo.SaveVoiceData) err
 
 	messageQuery := `
 		INSERT INTO messages (
+			id,
 			user_id, 
 			chat_id, 
 			message_type,
 			content
-		) VALUES ($1, $2, 'voice', $3)
-		RETURNING id
+		) VALUES ($1, $2, $3, 'voice', $4)
 	`
 
-	var messageID int64
-	err = tx.QueryRow(ctx, messageQuery, data.UserID, data.ChatID, data.AudioURL).Scan(&messageID)
+	messageID := s.ids.NextID()
+	_, err = tx.Exec(ctx, messageQuery, messageID, data.UserID, data.ChatID, data.AudioURL)
 	if err != nil {
 		return fmt.Errorf("%s: failed to insert message: %w", op, err)
 	}
@@ -43,15 +44,20 @@ func (s *SaveVoiceStore) Execute(ctx context.Context, data vo.SaveVoiceData) err
 		INSERT INTO voice_messages (
 			message_id,
 			audio_url,
+			object_key,
 			duration,
 			waveform
-		) VALUES ($1, $2, $3, $4)
+		) VALUES ($1, $2, $3, $4, $5)
 	`
 
-	_, err = tx.Exec(ctx, voiceQuery, messageID, data.AudioURL, data.Duration, data.Waveform)
+	_, err = tx.Exec(ctx, voiceQuery, messageID, data.AudioURL, data.ObjectKey, data.Duration, data.Waveform)
 	if err != nil {
 		return fmt.Errorf("%s: failed to insert voice message: %w", op, err)
 	}
 
+	if _, err = tx.Exec(ctx, `SELECT refresh_chat_last_message(ARRAY[$1::uuid])`, data.ChatID); err != nil {
+		return fmt.Errorf("%s: failed to refresh last message: %w", op, err)
+	}
+
 	return nil
 }
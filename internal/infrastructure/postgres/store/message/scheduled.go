@@ -0,0 +1,270 @@
+package message
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	messageErrors "awesome-chat/internal/domain/core/message/errors"
+	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const selectScheduled = `
+	SELECT id, user_id, chat_id, content, send_at, status, created_at, updated_at
+	FROM scheduled_messages
+`
+
+type ScheduledStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewScheduledStore(executor ports.ExecutorManager) *ScheduledStore {
+	return &ScheduledStore{executor: executor}
+}
+
+func (s *ScheduledStore) Create(ctx context.Context, m entity.ScheduledMessage) error {
+	const op = "message.ScheduledStore.Create"
+
+	query := `
+		INSERT INTO scheduled_messages (id, user_id, chat_id, content, send_at, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	if _, err := s.executor.GetExecutor(ctx).Exec(ctx, query,
+		m.ID,
+		m.UserID,
+		m.ChatID,
+		m.Content,
+		m.SendAt,
+		vo.ScheduledStatusPending,
+	); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *ScheduledStore) ListPending(
+	ctx context.Context,
+	userID uuid.UUID,
+	chatID *uuid.UUID,
+) ([]entity.ScheduledMessage, error) {
+	const op = "message.ScheduledStore.ListPending"
+
+	args := []any{userID, vo.ScheduledStatusPending}
+	query := selectScheduled + " WHERE user_id = $1 AND status = $2"
+	if chatID != nil {
+		args = append(args, *chatID)
+		query += " AND chat_id = $3"
+	}
+	query += " ORDER BY send_at, id"
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	messages, err := collectScheduled(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return messages, nil
+}
+
+func (s *ScheduledStore) Update(
+	ctx context.Context,
+	id, userID uuid.UUID,
+	update vo.ScheduledUpdate,
+) (entity.ScheduledMessage, error) {
+	const op = "message.ScheduledStore.Update"
+
+	args := []any{id, userID, vo.ScheduledStatusPending}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	sets := []string{"updated_at = NOW()"}
+	if update.Content != nil {
+		sets = append(sets, "content = "+arg(*update.Content))
+	}
+	if update.SendAt != nil {
+		sets = append(sets, "send_at = "+arg(*update.SendAt))
+	}
+
+	query := `
+		UPDATE scheduled_messages
+		SET ` + strings.Join(sets, ", ") + `
+		WHERE id = $1 AND user_id = $2 AND status = $3
+		RETURNING id, user_id, chat_id, content, send_at, status, created_at, updated_at
+	`
+
+	m, err := scanScheduled(s.executor.GetExecutor(ctx).QueryRow(ctx, query, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ScheduledMessage{}, fmt.Errorf("%s: %w", op, s.missingReason(ctx, id, userID))
+	}
+	if err != nil {
+		return entity.ScheduledMessage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return m, nil
+}
+
+func (s *ScheduledStore) Cancel(ctx context.Context, id, userID uuid.UUID) error {
+	const op = "message.ScheduledStore.Cancel"
+
+	query := `
+		UPDATE scheduled_messages
+		SET status = $4, updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND status = $3
+	`
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx, query,
+		id, userID, vo.ScheduledStatusPending, vo.ScheduledStatusCancelled,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", op, s.missingReason(ctx, id, userID))
+	}
+
+	return nil
+}
+
+// missingReason tells a message that is gone or foreign apart from one the scheduler already took.
+func (s *ScheduledStore) missingReason(ctx context.Context, id, userID uuid.UUID) error {
+	var exists bool
+	if err := s.executor.GetExecutor(ctx).QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM scheduled_messages WHERE id = $1 AND user_id = $2)`,
+		id, userID,
+	).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return messageErrors.ErrScheduledAlreadyProcessed
+	}
+	return messageErrors.ErrScheduledNotFound
+}
+
+type ScheduledDispatchStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewScheduledDispatchStore(executor ports.ExecutorManager) *ScheduledDispatchStore {
+	return &ScheduledDispatchStore{executor: executor}
+}
+
+func (s *ScheduledDispatchStore) ClaimDue(ctx context.Context, limit int) ([]entity.ScheduledMessage, error) {
+	const op = "message.ScheduledDispatchStore.ClaimDue"
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := selectScheduled + `
+		WHERE status = $1 AND send_at <= NOW()
+		ORDER BY send_at, id
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.Query(ctx, query, vo.ScheduledStatusPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	messages, err := collectScheduled(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return messages, nil
+}
+
+func (s *ScheduledDispatchStore) MarkSent(ctx context.Context, ids []uuid.UUID) error {
+	const op = "message.ScheduledDispatchStore.MarkSent"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	tx, err := s.executor.GetTxExecutor(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	query := `
+		UPDATE scheduled_messages
+		SET status = $1, sent_at = NOW(), updated_at = NOW()
+		WHERE id = ANY($2)
+	`
+
+	if _, err = tx.Exec(ctx, query, vo.ScheduledStatusSent, ids); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (s *ScheduledDispatchStore) CancelOrphaned(ctx context.Context) (int64, error) {
+	const op = "message.ScheduledDispatchStore.CancelOrphaned"
+
+	query := `
+		UPDATE scheduled_messages s
+		SET status = $2, updated_at = NOW()
+		WHERE s.status = $1
+			AND s.send_at <= NOW()
+			AND NOT EXISTS (
+				SELECT 1 FROM user_chats uc
+				WHERE uc.chat_id = s.chat_id AND uc.user_id = s.user_id AND uc.role <> 'read_only'
+			)
+	`
+
+	tag, err := s.executor.GetExecutor(ctx).Exec(ctx, query,
+		vo.ScheduledStatusPending, vo.ScheduledStatusCancelled,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func collectScheduled(rows pgx.Rows) ([]entity.ScheduledMessage, error) {
+	defer rows.Close()
+
+	messages := make([]entity.ScheduledMessage, 0)
+	for rows.Next() {
+		m, err := scanScheduled(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+
+	return messages, rows.Err()
+}
+
+func scanScheduled(row pgx.Row) (entity.ScheduledMessage, error) {
+	var m entity.ScheduledMessage
+	err := row.Scan(
+		&m.ID,
+		&m.UserID,
+		&m.ChatID,
+		&m.Content,
+		&m.SendAt,
+		&m.Status,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+	)
+	return m, err
+}
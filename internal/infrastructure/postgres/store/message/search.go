@@ -0,0 +1,122 @@
+package message
+
+import (
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// ts_headline marks the matches with private use characters, the snippet is html escaped before they turn into
+// <mark> tags so the content of a message can never inject markup into the client rendering it.
+const (
+	markStart = "\uE000"
+	markStop  = "\uE001"
+
+	headlineOptions = `StartSel=` + markStart + `, StopSel=` + markStop + `, MaxWords=35, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "`
+)
+
+var markReplacer = strings.NewReplacer(markStart, "<mark>", markStop, "</mark>")
+
+func snippet(headline string) string {
+	return markReplacer.Replace(html.EscapeString(headline))
+}
+
+type SearchStore struct {
+	executor ports.ExecutorManager
+}
+
+func NewSearchStore(executor ports.ExecutorManager) *SearchStore {
+	return &SearchStore{executor: executor}
+}
+
+func (s *SearchStore) Execute(
+	ctx context.Context,
+	filter vo.SearchFilter,
+) ([]entity.SearchHit, error) {
+	const op = "message.SearchStore.Execute"
+
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+
+	args := []any{filter.UserID, filter.Query, headlineOptions}
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	conditions := []string{
+		"m.content_tsv @@ q.query",
+		"(m.expires_at IS NULL OR m.expires_at > NOW())",
+	}
+	if filter.ChatID != nil {
+		conditions = append(conditions, "m.chat_id = "+arg(*filter.ChatID))
+	}
+	if filter.SenderID != nil {
+		conditions = append(conditions, "m.user_id = "+arg(*filter.SenderID))
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "m.created_at >= "+arg(*filter.From))
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "m.created_at < "+arg(*filter.To))
+	}
+	if !filter.Cursor.IsZero() {
+		conditions = append(conditions, fmt.Sprintf(
+			"(m.created_at, m.id) < (%s, %s)",
+			arg(filter.Cursor.CreatedAt), arg(filter.Cursor.ID),
+		))
+	}
+
+	query := `
+        SELECT
+            m.id,
+            m.chat_id,
+            m.user_id,
+            m.content,
+            -- markers typed into the message itself are dropped, only the ones of ts_headline remain
+            ts_headline('simple', translate(m.content, U&'\E000\E001', ''), q.query, $3),
+            ts_rank(m.content_tsv, q.query),
+            m.created_at
+        FROM messages m
+        JOIN user_chats uc ON uc.chat_id = m.chat_id AND uc.user_id = $1
+        CROSS JOIN websearch_to_tsquery('simple', $2) AS q(query)
+        WHERE ` + strings.Join(conditions, " AND ") + `
+        ORDER BY m.created_at DESC, m.id DESC
+        LIMIT ` + arg(filter.Limit)
+
+	rows, err := s.executor.GetExecutor(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	hits := make([]entity.SearchHit, 0, filter.Limit)
+	for rows.Next() {
+		var hit entity.SearchHit
+		if err = rows.Scan(
+			&hit.ID,
+			&hit.ChatID,
+			&hit.SenderID,
+			&hit.Text,
+			&hit.Snippet,
+			&hit.Rank,
+			&hit.Timestamp,
+		); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		hit.Snippet = snippet(hit.Snippet)
+		hits = append(hits, hit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return hits, nil
+}
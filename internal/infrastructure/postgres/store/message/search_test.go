@@ -0,0 +1,30 @@
+package message
+
+import "testing"
+
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		headline string
+		expected string
+	}{
+		{
+			name:     "Matches are marked",
+			headline: "see you " + markStart + "tomorrow" + markStop,
+			expected: "see you <mark>tomorrow</mark>",
+		},
+		{
+			name:     "Markup of the message is escaped",
+			headline: `<img src=x onerror="alert(1)"> ` + markStart + "hi" + markStop + " </mark>",
+			expected: `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>hi</mark> &lt;/mark&gt;`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := snippet(tt.headline); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Package channel caches the reads of broadcast channels, which tens of thousands of subscribers
+// repeat after every post. Posts reach connected subscribers over ws, so a few seconds of staleness are fine.
+package channel
+
+import "time"
+
+// ReadTTL bounds how stale a cached channel read is.
+const ReadTTL = 5 * time.Second
@@ -0,0 +1,41 @@
+package channel
+
+import (
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports/cache"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// chatTypeTTL only bounds the memory of deleted chats, the type of a chat never changes.
+const chatTypeTTL = 24 * time.Hour
+
+var _ chatPorts.ChatTypeStore = (*ChatTypeCache)(nil)
+
+// ChatTypeCache answers the chat type lookups done on every channel read without the database.
+type ChatTypeCache struct {
+	store chatPorts.ChatTypeStore
+	cache cache.Storage
+}
+
+func NewChatTypeCache(store chatPorts.ChatTypeStore, cache cache.Storage) *ChatTypeCache {
+	return &ChatTypeCache{store: store, cache: cache}
+}
+
+func (c *ChatTypeCache) ChatType(ctx context.Context, chatID uuid.UUID) (vo.ChatType, error) {
+	if raw, err := c.cache.Read(ctx, chatID.String()); err == nil && raw != "" {
+		return vo.ChatType(raw), nil
+	}
+
+	chatType, err := c.store.ChatType(ctx, chatID)
+	if err != nil {
+		return "", err
+	}
+	// best effort, the next lookup reads the database again
+	_ = c.cache.Set(ctx, chatID.String(), chatType.String(), chatTypeTTL)
+
+	return chatType, nil
+}
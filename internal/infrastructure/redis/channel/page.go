@@ -0,0 +1,67 @@
+package channel
+
+import (
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	chatVO "awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/message/entity"
+	"awesome-chat/internal/domain/core/message/ports/store"
+	"awesome-chat/internal/domain/core/message/vo"
+	"awesome-chat/internal/domain/core/shared/ports/cache"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/sync/singleflight"
+)
+
+var _ store.PageStore = (*PageCache)(nil)
+
+// PageCache serves channel history from redis, every subscriber opening the channel
+// after a post asks for the same newest page. Other chats read the store directly.
+type PageCache struct {
+	store     store.PageStore
+	chatTypes chatPorts.ChatTypeStore
+	cache     cache.Storage
+	// loads collapses the misses of one node, only one of them reads the database
+	loads singleflight.Group
+}
+
+func NewPageCache(store store.PageStore, chatTypes chatPorts.ChatTypeStore, cache cache.Storage) *PageCache {
+	return &PageCache{store: store, chatTypes: chatTypes, cache: cache}
+}
+
+func (c *PageCache) Page(ctx context.Context, q vo.PageQuery) (entity.MessagePage, error) {
+	chatType, err := c.chatTypes.ChatType(ctx, q.ChatID)
+	if err != nil || chatType != chatVO.ChatTypeChannel {
+		// the store reports a missing chat itself
+		return c.store.Page(ctx, q)
+	}
+
+	key := pageKey(q)
+	if raw, readErr := c.cache.Read(ctx, key); readErr == nil {
+		var page entity.MessagePage
+		if json.Unmarshal([]byte(raw), &page) == nil {
+			return page, nil
+		}
+	}
+
+	loaded, err, _ := c.loads.Do(key, func() (any, error) {
+		page, loadErr := c.store.Page(ctx, q)
+		if loadErr != nil {
+			return entity.MessagePage{}, loadErr
+		}
+		if data, marshalErr := json.Marshal(page); marshalErr == nil {
+			_ = c.cache.Set(ctx, key, string(data), ReadTTL)
+		}
+		return page, nil
+	})
+	if err != nil {
+		return entity.MessagePage{}, err
+	}
+
+	return loaded.(entity.MessagePage), nil
+}
+
+func pageKey(q vo.PageQuery) string {
+	return fmt.Sprintf("%s:%s:%s:%d:%d", q.ChatID, q.Direction, q.Cursor.Encode(), q.AroundID, q.Limit)
+}
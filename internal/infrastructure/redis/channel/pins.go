@@ -0,0 +1,56 @@
+package channel
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/domain/core/shared/ports/cache"
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+var _ chatPorts.PinStore = (*PinCache)(nil)
+
+// PinCache serves the pinned messages of channels from redis. The pin use cases drop the cached list after commit,
+// a read which loaded the list before the commit may still cache the old one for ReadTTL at most.
+type PinCache struct {
+	chatPorts.PinStore
+	chatTypes chatPorts.ChatTypeStore
+	cache     cache.Storage
+}
+
+func NewPinCache(store chatPorts.PinStore, chatTypes chatPorts.ChatTypeStore, cache cache.Storage) *PinCache {
+	return &PinCache{PinStore: store, chatTypes: chatTypes, cache: cache}
+}
+
+// Invalidate drops the cached list. It is called once the pin change is committed,
+// dropping it inside the transaction lets a read cache the list from before the change.
+func (c *PinCache) Invalidate(ctx context.Context, chatID uuid.UUID) error {
+	return c.cache.Delete(ctx, chatID.String())
+}
+
+func (c *PinCache) List(ctx context.Context, chatID uuid.UUID) ([]entity.Pin, error) {
+	chatType, err := c.chatTypes.ChatType(ctx, chatID)
+	if err != nil || chatType != vo.ChatTypeChannel {
+		return c.PinStore.List(ctx, chatID)
+	}
+
+	if raw, readErr := c.cache.Read(ctx, chatID.String()); readErr == nil {
+		var pins []entity.Pin
+		if json.Unmarshal([]byte(raw), &pins) == nil {
+			return pins, nil
+		}
+	}
+
+	pins, err := c.PinStore.List(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+	if data, marshalErr := json.Marshal(pins); marshalErr == nil {
+		_ = c.cache.Set(ctx, chatID.String(), string(data), ReadTTL)
+	}
+
+	return pins, nil
+}
@@ -0,0 +1,315 @@
+package draft
+
+import (
+	"awesome-chat/internal/domain/core/draft/entity"
+	draftErrors "awesome-chat/internal/domain/core/draft/errors"
+	"awesome-chat/internal/domain/core/draft/vo"
+	conn "awesome-chat/internal/infrastructure/redis"
+	"awesome-chat/internal/infrastructure/redis/storage"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	redisLib "github.com/redis/go-redis/v9"
+)
+
+// The version is at least the write time in milliseconds, so it keeps growing
+// even when the cached draft expired and the next write starts from scratch.
+// The dirty set is scored by the first unflushed write, later writes do not move it.
+var saveScript = redisLib.NewScript(`
+local current = tonumber(redis.call('HGET', KEYS[1], 'version') or '0')
+local version = math.max(current + 1, tonumber(ARGV[2]))
+redis.call('HSET', KEYS[1], 'content', ARGV[1], 'version', string.format('%d', version), 'updated_at', ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+redis.call('ZADD', KEYS[2], 'NX', ARGV[2], ARGV[4])
+return version
+`)
+
+// A draft edited after the message was sent is kept, an already cleared draft is left alone.
+// A draft that is not cached is not written, -1 sends the caller to the store.
+var clearScript = redisLib.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+local fields = redis.call('HMGET', KEYS[1], 'content', 'version', 'updated_at')
+if fields[1] == '' then
+	return 0
+end
+if fields[3] and tonumber(fields[3]) > tonumber(ARGV[1]) then
+	return 0
+end
+local version = math.max(tonumber(fields[2] or '0') + 1, tonumber(ARGV[2]))
+redis.call('HSET', KEYS[1], 'content', '', 'version', string.format('%d', version), 'updated_at', ARGV[2])
+redis.call('PEXPIRE', KEYS[1], ARGV[3])
+redis.call('ZADD', KEYS[2], 'NX', ARGV[2], ARGV[4])
+return version
+`)
+
+// KEYS[1] is the dirty set, KEYS[i+1] the draft of the member ARGV[2i-1] flushed with version ARGV[2i].
+// A draft saved again during the flush waits another window instead of being flushed right away.
+var ackScript = redisLib.NewScript(`
+local now = ARGV[#ARGV]
+for i = 2, #KEYS do
+	local member = ARGV[(i - 1) * 2 - 1]
+	local version = redis.call('HGET', KEYS[i], 'version')
+	if not version or version == ARGV[(i - 1) * 2] then
+		redis.call('ZREM', KEYS[1], member)
+	else
+		redis.call('ZADD', KEYS[1], 'XX', now, member)
+	end
+end
+return 0
+`)
+
+type Cache struct {
+	conn   *conn.Connection
+	prefix storage.Prefix
+}
+
+func NewCache(conn *conn.Connection) *Cache {
+	return &Cache{
+		conn:   conn,
+		prefix: storage.NewPrefix(storage.Draft),
+	}
+}
+
+func (c *Cache) Save(ctx context.Context, userID, chatID uuid.UUID, content string) (entity.Draft, error) {
+	const op = "redis.draft.Cache.Save"
+
+	now := time.Now().UTC()
+	version, err := saveScript.Run(ctx, c.conn,
+		[]string{c.key(userID, chatID), c.dirtyKey()},
+		content, now.UnixMilli(), vo.CacheTTL.Milliseconds(), member(userID, chatID),
+	).Int64()
+	if err != nil {
+		return entity.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return entity.Draft{
+		UserID:    userID,
+		ChatID:    chatID,
+		Content:   content,
+		Version:   version,
+		UpdatedAt: now.Truncate(time.Millisecond),
+	}, nil
+}
+
+func (c *Cache) Get(ctx context.Context, userID, chatID uuid.UUID) (entity.Draft, error) {
+	const op = "redis.draft.Cache.Get"
+
+	fields, err := c.conn.HMGet(ctx, c.key(userID, chatID), "content", "version", "updated_at").Result()
+	if err != nil {
+		return entity.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	draft, ok, err := parseDraft(userID, chatID, fields)
+	if err != nil {
+		return entity.Draft{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if !ok {
+		return entity.Draft{}, fmt.Errorf("%s: %w", op, draftErrors.ErrDraftNotFound)
+	}
+
+	return draft, nil
+}
+
+// GetMany reads the cached drafts of the chats in one round trip, chats without one are left out.
+func (c *Cache) GetMany(ctx context.Context, userID uuid.UUID, chatIDs []uuid.UUID) (map[uuid.UUID]entity.Draft, error) {
+	const op = "redis.draft.Cache.GetMany"
+
+	if len(chatIDs) == 0 {
+		return nil, nil
+	}
+
+	pipe := c.conn.Pipeline()
+	cmds := make([]*redisLib.SliceCmd, 0, len(chatIDs))
+	for _, chatID := range chatIDs {
+		cmds = append(cmds, pipe.HMGet(ctx, c.key(userID, chatID), "content", "version", "updated_at"))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redisLib.Nil) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	drafts := make(map[uuid.UUID]entity.Draft, len(chatIDs))
+	for i, cmd := range cmds {
+		draft, ok, err := parseDraft(userID, chatIDs[i], cmd.Val())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if ok {
+			drafts[chatIDs[i]] = draft
+		}
+	}
+
+	return drafts, nil
+}
+
+func (c *Cache) Clear(
+	ctx context.Context,
+	userID, chatID uuid.UUID,
+	sentAt time.Time,
+) (entity.Draft, bool, error) {
+	const op = "redis.draft.Cache.Clear"
+
+	now := time.Now().UTC()
+	version, err := clearScript.Run(ctx, c.conn,
+		[]string{c.key(userID, chatID), c.dirtyKey()},
+		sentAt.UnixMilli(), now.UnixMilli(), vo.CacheTTL.Milliseconds(), member(userID, chatID),
+	).Int64()
+	if err != nil {
+		return entity.Draft{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	switch version {
+	case -1:
+		return entity.Draft{}, false, fmt.Errorf("%s: %w", op, draftErrors.ErrDraftNotFound)
+	case 0:
+		return entity.Draft{}, false, nil
+	}
+
+	return entity.Draft{
+		UserID:    userID,
+		ChatID:    chatID,
+		Version:   version,
+		UpdatedAt: now.Truncate(time.Millisecond),
+	}, true, nil
+}
+
+func (c *Cache) Dirty(ctx context.Context, dirtySince time.Time, limit int) ([]entity.Draft, error) {
+	const op = "redis.draft.Cache.Dirty"
+
+	members, err := c.conn.ZRangeByScore(ctx, c.dirtyKey(), &redisLib.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(dirtySince.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	type ids struct{ userID, chatID uuid.UUID }
+	keys := make([]ids, 0, len(members))
+	cmds := make([]*redisLib.SliceCmd, 0, len(members))
+	var broken []any
+
+	pipe := c.conn.Pipeline()
+	for _, m := range members {
+		userID, chatID, parseErr := parseMember(m)
+		if parseErr != nil {
+			broken = append(broken, m)
+			continue
+		}
+		keys = append(keys, ids{userID: userID, chatID: chatID})
+		cmds = append(cmds, pipe.HMGet(ctx, c.key(userID, chatID), "content", "version", "updated_at"))
+	}
+	if _, err = pipe.Exec(ctx); err != nil && !errors.Is(err, redisLib.Nil) {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	drafts := make([]entity.Draft, 0, len(cmds))
+	for i, cmd := range cmds {
+		draft, ok, parseErr := parseDraft(keys[i].userID, keys[i].chatID, cmd.Val())
+		if parseErr != nil {
+			return nil, fmt.Errorf("%s: %w", op, parseErr)
+		}
+		if !ok {
+			// the cached draft expired, there is nothing newer to flush
+			broken = append(broken, member(keys[i].userID, keys[i].chatID))
+			continue
+		}
+		drafts = append(drafts, draft)
+	}
+
+	if len(broken) > 0 {
+		if err = c.conn.ZRem(ctx, c.dirtyKey(), broken...).Err(); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return drafts, nil
+}
+
+func (c *Cache) Ack(ctx context.Context, drafts []entity.Draft) error {
+	const op = "redis.draft.Cache.Ack"
+
+	if len(drafts) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(drafts)+1)
+	args := make([]any, 0, len(drafts)*2+1)
+	keys = append(keys, c.dirtyKey())
+	for _, d := range drafts {
+		keys = append(keys, c.key(d.UserID, d.ChatID))
+		args = append(args, member(d.UserID, d.ChatID), strconv.FormatInt(d.Version, 10))
+	}
+	args = append(args, time.Now().UnixMilli())
+
+	if err := ackScript.Run(ctx, c.conn, keys, args...).Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (c *Cache) key(userID, chatID uuid.UUID) string {
+	return c.prefix.WithValue(member(userID, chatID))
+}
+
+func (c *Cache) dirtyKey() string {
+	return c.prefix.WithValue("dirty")
+}
+
+func member(userID, chatID uuid.UUID) string {
+	return userID.String() + ":" + chatID.String()
+}
+
+func parseMember(m string) (uuid.UUID, uuid.UUID, error) {
+	rawUserID, rawChatID, found := strings.Cut(m, ":")
+	if !found {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("malformed draft member %q", m)
+	}
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	chatID, err := uuid.Parse(rawChatID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	return userID, chatID, nil
+}
+
+// parseDraft reads the content, version and updated_at fields, ok is false for a missing hash.
+func parseDraft(userID, chatID uuid.UUID, fields []any) (entity.Draft, bool, error) {
+	if len(fields) != 3 || fields[1] == nil {
+		return entity.Draft{}, false, nil
+	}
+
+	content, _ := fields[0].(string)
+	rawVersion, _ := fields[1].(string)
+	rawUpdatedAt, _ := fields[2].(string)
+
+	version, err := strconv.ParseInt(rawVersion, 10, 64)
+	if err != nil {
+		return entity.Draft{}, false, fmt.Errorf("malformed draft version %q: %w", rawVersion, err)
+	}
+	updatedAt, err := strconv.ParseInt(rawUpdatedAt, 10, 64)
+	if err != nil {
+		return entity.Draft{}, false, fmt.Errorf("malformed draft time %q: %w", rawUpdatedAt, err)
+	}
+
+	return entity.Draft{
+		UserID:    userID,
+		ChatID:    chatID,
+		Content:   content,
+		Version:   version,
+		UpdatedAt: time.UnixMilli(updatedAt).UTC(),
+	}, true, nil
+}
@@ -0,0 +1,65 @@
+package preview
+
+import (
+	"awesome-chat/internal/domain/core/chat/entity"
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	"awesome-chat/internal/domain/core/chat/vo"
+	conn "awesome-chat/internal/infrastructure/redis"
+	"awesome-chat/internal/infrastructure/redis/storage"
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	redisLib "github.com/redis/go-redis/v9"
+)
+
+var _ chatPorts.GetUserChatPreviewStore = (*Cache)(nil)
+
+// Cache serves the preview pages from redis, each page on its own so a drop only costs the pages read again.
+// Participants are not cached, they are only loaded for the chats of the page shown.
+type Cache struct {
+	chatPorts.GetUserChatPreviewStore
+	conn   *conn.Connection
+	prefix storage.Prefix
+}
+
+func NewCache(store chatPorts.GetUserChatPreviewStore, conn *conn.Connection) *Cache {
+	return &Cache{
+		GetUserChatPreviewStore: store,
+		conn:                    conn,
+		prefix:                  storage.NewPrefix(storage.ChatPreviews),
+	}
+}
+
+func (c *Cache) SetupChatPreviews(
+	ctx context.Context,
+	userID uuid.UUID,
+	includeArchived bool,
+	page vo.PreviewPage,
+) (entity.ChatPreviewPage, error) {
+	version, err := c.conn.Get(ctx, versionKey(c.prefix, userID)).Int64()
+	if err != nil && !errors.Is(err, redisLib.Nil) {
+		// without the version no page is known to be current, the database answers
+		return c.GetUserChatPreviewStore.SetupChatPreviews(ctx, userID, includeArchived, page)
+	}
+	key := pageKey(c.prefix, userID, version, includeArchived, page)
+
+	if raw, readErr := c.conn.Get(ctx, key).Bytes(); readErr == nil {
+		var cached entity.ChatPreviewPage
+		if json.Unmarshal(raw, &cached) == nil {
+			return cached, nil
+		}
+	}
+
+	result, err := c.GetUserChatPreviewStore.SetupChatPreviews(ctx, userID, includeArchived, page)
+	if err != nil {
+		return entity.ChatPreviewPage{}, err
+	}
+	// best effort, the next load reads the database again
+	if data, marshalErr := json.Marshal(result); marshalErr == nil {
+		_ = c.conn.Set(ctx, key, data, ListTTL).Err()
+	}
+
+	return result, nil
+}
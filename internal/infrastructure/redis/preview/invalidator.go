@@ -0,0 +1,51 @@
+package preview
+
+import (
+	chatPorts "awesome-chat/internal/domain/core/chat/ports"
+	conn "awesome-chat/internal/infrastructure/redis"
+	"awesome-chat/internal/infrastructure/redis/storage"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	redisLib "github.com/redis/go-redis/v9"
+)
+
+// bumpChunk keeps the drop for a large group from becoming one huge pipeline blocking redis.
+const bumpChunk = 500
+
+var _ chatPorts.PreviewCache = (*Invalidator)(nil)
+
+type Invalidator struct {
+	conn   *conn.Connection
+	prefix storage.Prefix
+}
+
+func NewInvalidator(conn *conn.Connection) *Invalidator {
+	return &Invalidator{
+		conn:   conn,
+		prefix: storage.NewPrefix(storage.ChatPreviews),
+	}
+}
+
+// Invalidate bumps the list version of every user, the pages cached under the old one are never read again.
+func (i *Invalidator) Invalidate(ctx context.Context, userIDs []uuid.UUID) error {
+	const op = "redis.preview.Invalidator.Invalidate"
+
+	for start := 0; start < len(userIDs); start += bumpChunk {
+		end := min(start+bumpChunk, len(userIDs))
+		_, err := i.conn.Pipelined(ctx, func(pipe redisLib.Pipeliner) error {
+			for _, userID := range userIDs[start:end] {
+				key := versionKey(i.prefix, userID)
+				pipe.Incr(ctx, key)
+				pipe.Expire(ctx, key, versionTTL)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+// Package preview caches the pages of the ordered preview list of every user. The pages of a user are dropped
+// together on the message and membership events of their chats, ListTTL covers what is not reported, e.g. channel posts.
+package preview
+
+import (
+	"awesome-chat/internal/domain/core/chat/vo"
+	"awesome-chat/internal/infrastructure/redis/storage"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListTTL bounds how stale a page gets when no event drops it. A read racing a drop caches its page
+// under the version it started with, so the page from before the change is not served after it.
+const ListTTL = time.Minute
+
+// versionTTL outlives every page cached under a version, an expired version starting over at zero finds none left.
+const versionTTL = 24 * time.Hour
+
+// The pages of a user are keyed by the version of their list, a drop bumps the version instead of
+// looking for the pages, whichever cursors they were loaded with.
+func versionKey(prefix storage.Prefix, userID uuid.UUID) string {
+	return prefix.WithValue(userID.String() + ":version")
+}
+
+// A user has pages with archived chats and pages without.
+func pageKey(prefix storage.Prefix, userID uuid.UUID, version int64, includeArchived bool, page vo.PreviewPage) string {
+	scope := "active"
+	if includeArchived {
+		scope = "all"
+	}
+	return prefix.WithValue(userID.String() + ":" + strconv.FormatInt(version, 10) + ":" + scope + ":" +
+		strconv.Itoa(page.Limit) + ":" + page.After.Encode())
+}
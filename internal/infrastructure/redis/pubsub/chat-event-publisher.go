@@ -0,0 +1,30 @@
+package pubsub
+
+import (
+	"awesome-chat/internal/domain/core/shared/events/entity"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ChatEventPublisher sends chat events to the channel every ws-server node is subscribed to.
+type ChatEventPublisher struct {
+	pub *Publisher
+}
+
+func NewChatEventPublisher(pub *Publisher) *ChatEventPublisher {
+	return &ChatEventPublisher{pub: pub}
+}
+
+func (p *ChatEventPublisher) PublishChatEvent(ctx context.Context, event entity.ChatEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal chat event: %w", err)
+	}
+
+	if err = p.pub.Publish(ctx, payload); err != nil {
+		return fmt.Errorf("failed to publish chat event: %w", err)
+	}
+
+	return nil
+}
@@ -7,8 +7,18 @@ import (
 type Prefix string
 
 const (
-	Message Prefix = "message"
-	Voice   Prefix = "voice"
+	Message      Prefix = "message"
+	Voice        Prefix = "voice"
+	Attachment   Prefix = "attachment"
+	LinkPreview  Prefix = "link-preview"
+	Draft        Prefix = "draft"
+	Avatar       Prefix = "avatar"
+	ChatType     Prefix = "chat-type"
+	ChannelPage  Prefix = "channel-page"
+	ChannelPins  Prefix = "channel-pins"
+	ChatPreviews Prefix = "chat-previews"
+	Export       Prefix = "export"
+	IDNode       Prefix = "id-node"
 )
 
 func NewPrefix(prefixes ...Prefix) Prefix {
@@ -0,0 +1,75 @@
+package snowflake
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"awesome-chat/internal/infrastructure/config/snowflake"
+)
+
+// An id is 53 bits, so javascript clients read it as a number without losing precision:
+// 40 bits of milliseconds since the epoch, 5 bits of node and 8 bits of sequence.
+// That lasts until 2059 with 32 nodes generating up to 256 ids per millisecond each.
+const (
+	nodeBits     = 5
+	sequenceBits = 8
+
+	MaxNodeID   = 1<<nodeBits - 1
+	maxSequence = 1<<sequenceBits - 1
+)
+
+// Epoch puts every generated id far above the BIGSERIAL ids of the messages stored before the generator,
+// which every path inserting messages uses since.
+var Epoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type Generator struct {
+	mu       sync.Mutex
+	node     int
+	lastMs   int64
+	sequence int
+	now      func() time.Time
+}
+
+func NewGenerator(cfg snowflake.Config) (*Generator, error) {
+	if cfg.NodeID < 0 || cfg.NodeID > MaxNodeID {
+		return nil, fmt.Errorf("snowflake node id must be between 0 and %d, got %d", MaxNodeID, cfg.NodeID)
+	}
+
+	return &Generator{
+		node: cfg.NodeID,
+		now:  time.Now,
+	}, nil
+}
+
+func MustNewGenerator(cfg snowflake.Config) *Generator {
+	g, err := NewGenerator(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// NextID never goes backwards, a clock moved back keeps counting from the last millisecond
+// and a full millisecond borrows the next one.
+func (g *Generator) NextID() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := g.now().Sub(Epoch).Milliseconds()
+	switch {
+	case ms > g.lastMs:
+		g.lastMs, g.sequence = ms, 0
+	case g.sequence < maxSequence:
+		g.sequence++
+	default:
+		g.lastMs, g.sequence = g.lastMs+1, 0
+	}
+
+	return int(g.lastMs)<<(nodeBits+sequenceBits) | g.node<<sequenceBits | g.sequence
+}
+
+// Time tells when the id was generated, to the millisecond.
+func Time(id int) time.Time {
+	return Epoch.Add(time.Duration(id>>(nodeBits+sequenceBits)) * time.Millisecond)
+}
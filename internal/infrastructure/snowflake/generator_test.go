@@ -0,0 +1,50 @@
+package snowflake
+
+import (
+	"testing"
+	"time"
+
+	"awesome-chat/internal/infrastructure/config/snowflake"
+)
+
+func TestGeneratorNextID(t *testing.T) {
+	now := time.Date(2025, 9, 7, 12, 0, 0, 0, time.UTC)
+
+	g := MustNewGenerator(snowflake.Config{NodeID: 3})
+	g.now = func() time.Time { return now }
+
+	seen := make(map[int]struct{})
+	last := 0
+	// more than one millisecond worth of ids, then the clock goes back
+	for i := 0; i < 3*(maxSequence+1); i++ {
+		if i == 2*(maxSequence+1) {
+			now = now.Add(-time.Second)
+		}
+
+		id := g.NextID()
+		if id <= last {
+			t.Fatalf("id %d is not above %d", id, last)
+		}
+		if _, ok := seen[id]; ok {
+			t.Fatalf("id %d generated twice", id)
+		}
+		if node := id >> sequenceBits & MaxNodeID; node != 3 {
+			t.Fatalf("node = %d, want 3", node)
+		}
+		seen[id] = struct{}{}
+		last = id
+	}
+
+	if last >= 1<<53 {
+		t.Errorf("id %d does not fit 53 bits", last)
+	}
+	if got := Time(last); got.Sub(now.Add(time.Second)) > 10*time.Millisecond {
+		t.Errorf("Time = %v, want about %v", got, now.Add(time.Second))
+	}
+}
+
+func TestNewGeneratorRejectsNode(t *testing.T) {
+	if _, err := NewGenerator(snowflake.Config{NodeID: MaxNodeID + 1}); err == nil {
+		t.Error("expected an error for a node id out of range")
+	}
+}
@@ -0,0 +1,129 @@
+package snowflake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	redisLib "github.com/redis/go-redis/v9"
+
+	"awesome-chat/internal/infrastructure/config/snowflake"
+	conn "awesome-chat/internal/infrastructure/redis"
+	"awesome-chat/internal/infrastructure/redis/storage"
+)
+
+// A crashed process keeps its node id for leaseTTL at most, a running one renews it well before.
+const (
+	leaseTTL      = 30 * time.Second
+	renewInterval = leaseTTL / 3
+	// stopMargin is how long before the lease could expire a process failing to renew it stops,
+	// more than a renew interval so a late tick does not miss the deadline.
+	stopMargin = renewInterval + renewInterval/2
+)
+
+var ErrNodeLeaseLost = errors.New("snowflake node lease lost")
+
+var renewScript = redisLib.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+var releaseScript = redisLib.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// NodeLease holds a node id in redis while the process runs, so no two running processes generate
+// ids on the same node, whatever their config says.
+type NodeLease struct {
+	conn  *conn.Connection
+	key   string
+	token string
+	node  int
+	// acquiredAt is taken before the key is set, the lease lasts leaseTTL past it at least
+	acquiredAt time.Time
+}
+
+// LeaseNode takes the first free node id starting from the configured one,
+// replicas started from the same config end up on different nodes.
+func LeaseNode(ctx context.Context, conn *conn.Connection, cfg snowflake.Config) (*NodeLease, error) {
+	const op = "snowflake.LeaseNode"
+
+	if cfg.NodeID < 0 || cfg.NodeID > MaxNodeID {
+		return nil, fmt.Errorf("%s: node id must be between 0 and %d, got %d", op, MaxNodeID, cfg.NodeID)
+	}
+
+	prefix := storage.NewPrefix(storage.IDNode)
+	token := uuid.NewString()
+
+	for i := 0; i <= MaxNodeID; i++ {
+		node := (cfg.NodeID + i) % (MaxNodeID + 1)
+		key := prefix.WithValue(strconv.Itoa(node))
+
+		acquiredAt := time.Now()
+		ok, err := conn.SetNX(ctx, key, token, leaseTTL).Result()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if ok {
+			return &NodeLease{conn: conn, key: key, token: token, node: node, acquiredAt: acquiredAt}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: all %d node ids are taken", op, MaxNodeID+1)
+}
+
+func MustLeaseNode(ctx context.Context, conn *conn.Connection, cfg snowflake.Config) *NodeLease {
+	l, err := LeaseNode(ctx, conn, cfg)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Config is what the generator of the process is built from.
+func (l *NodeLease) Config() snowflake.Config {
+	return snowflake.Config{NodeID: l.node}
+}
+
+// Start renews the lease. A lost lease stops the process, another one may already generate on the node.
+func (l *NodeLease) Start(ctx context.Context) error {
+	ticker := time.NewTicker(renewInterval)
+	defer ticker.Stop()
+
+	renewedAt := l.acquiredAt
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			started := time.Now()
+			renewed, err := renewScript.Run(ctx, l.conn, []string{l.key}, l.token, leaseTTL.Milliseconds()).Int()
+			switch {
+			case ctx.Err() != nil:
+				return nil
+			case err == nil && renewed == 1:
+				// the key lives at least leaseTTL past the start of the call which renewed it
+				renewedAt = started
+			// a failed renewal is outlived by the lease, the next tick tries again. Stopping stopMargin ahead
+			// leaves the process time to shut down before another one may take the node.
+			case err == nil || time.Since(renewedAt) >= leaseTTL-stopMargin:
+				return fmt.Errorf("%w: node %d", ErrNodeLeaseLost, l.node)
+			}
+		}
+	}
+}
+
+func (l *NodeLease) Shutdown(ctx context.Context) error {
+	if err := releaseScript.Run(ctx, l.conn, []string{l.key}, l.token).Err(); err != nil {
+		return fmt.Errorf("snowflake.NodeLease.Shutdown: %w", err)
+	}
+	return nil
+}
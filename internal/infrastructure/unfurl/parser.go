@@ -0,0 +1,118 @@
+package unfurl
+
+import (
+	"awesome-chat/internal/domain/core/linkPreview/vo"
+	"io"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	maxTitleRunes       = 300
+	maxDescriptionRunes = 1000
+	maxSiteNameRunes    = 100
+	maxURLLength        = 2048
+)
+
+// parseMetadata reads the document head. OpenGraph wins over Twitter cards, those win over plain tags.
+func parseMetadata(r io.Reader, pageURL *url.URL) vo.Metadata {
+	var (
+		meta     = make(map[string]string)
+		title    string
+		inTitle  bool
+		tokenize = html.NewTokenizer(r)
+	)
+
+loop:
+	for {
+		switch tokenize.Next() {
+		case html.ErrorToken:
+			break loop
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenize.Token()
+			switch token.DataAtom {
+			case atom.Body:
+				break loop
+			case atom.Title:
+				inTitle = title == ""
+			case atom.Meta:
+				key, content := metaTag(token)
+				if _, seen := meta[key]; key != "" && !seen {
+					meta[key] = content
+				}
+			}
+		case html.TextToken:
+			if inTitle {
+				title += string(tokenize.Text())
+			}
+		case html.EndTagToken:
+			switch tokenize.Token().DataAtom {
+			case atom.Head:
+				break loop
+			case atom.Title:
+				inTitle = false
+			}
+		}
+	}
+
+	first := func(keys ...string) string {
+		for _, k := range keys {
+			if v := strings.TrimSpace(meta[k]); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	metaTitle := first("og:title", "twitter:title")
+	if metaTitle == "" {
+		metaTitle = title
+	}
+
+	return vo.Metadata{
+		URL:         pageURL.String(),
+		Title:       truncate(metaTitle, maxTitleRunes),
+		Description: truncate(first("og:description", "twitter:description", "description"), maxDescriptionRunes),
+		ImageURL:    resolveURL(pageURL, first("og:image:secure_url", "og:image", "twitter:image", "twitter:image:src")),
+		SiteName:    truncate(first("og:site_name"), maxSiteNameRunes),
+	}
+}
+
+func metaTag(token html.Token) (string, string) {
+	var key, content string
+	for _, attr := range token.Attr {
+		switch strings.ToLower(attr.Key) {
+		case "property", "name":
+			if key == "" {
+				key = strings.ToLower(strings.TrimSpace(attr.Val))
+			}
+		case "content":
+			content = attr.Val
+		}
+	}
+	return key, content
+}
+
+func truncate(s string, maxRunes int) string {
+	s = strings.Join(strings.Fields(strings.ToValidUTF8(s, "")), " ")
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+	return string([]rune(s)[:maxRunes-1]) + "…"
+}
+
+// resolveURL makes relative image links absolute and drops anything that is not http(s).
+func resolveURL(base *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.String()) > maxURLLength {
+		return ""
+	}
+	return u.String()
+}
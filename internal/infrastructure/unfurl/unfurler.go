@@ -0,0 +1,137 @@
+package unfurl
+
+import (
+	linkPreviewErrors "awesome-chat/internal/domain/core/linkPreview/errors"
+	"awesome-chat/internal/domain/core/linkPreview/vo"
+	cfg "awesome-chat/internal/infrastructure/config/unfurl"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// Unfurler fetches pages posted in chats. Every connection, redirects included, is checked
+// against the resolved IP right before dialing, so DNS tricks can not reach internal services.
+type Unfurler struct {
+	cfg       cfg.Config
+	client    *http.Client
+	allowAddr func(addr netip.Addr) bool
+}
+
+func NewUnfurler(config *cfg.Config) *Unfurler {
+	u := &Unfurler{
+		cfg:       *config,
+		allowAddr: isPublicAddr,
+	}
+
+	dialer := &net.Dialer{
+		Timeout: config.Timeout,
+		Control: u.control,
+	}
+
+	u.client = &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 nil, // a proxy would dial on our behalf and skip the address check
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   config.Timeout,
+			ResponseHeaderTimeout: config.Timeout,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > config.MaxRedirects {
+				return fmt.Errorf("stopped after %d redirects", config.MaxRedirects)
+			}
+			return validateURL(req.URL)
+		},
+		Timeout: config.Timeout,
+	}
+
+	return u
+}
+
+func (u *Unfurler) Unfurl(ctx context.Context, rawURL string) (vo.Metadata, error) {
+	const op = "unfurl.Unfurler.Unfurl"
+
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return vo.Metadata{}, fmt.Errorf("%s: %w", op, linkPreviewErrors.ErrInvalidURL)
+	}
+	if err = validateURL(target); err != nil {
+		return vo.Metadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return vo.Metadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	req.Header.Set("User-Agent", u.cfg.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return vo.Metadata{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return vo.Metadata{}, fmt.Errorf("%s: %w: %d", op, linkPreviewErrors.ErrUnexpectedStatus, resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return vo.Metadata{}, fmt.Errorf("%s: %w: %q", op, linkPreviewErrors.ErrUnsupportedContent, contentType)
+	}
+
+	// metadata lives in the head, whatever is past the cap is never read
+	var body io.Reader = io.LimitReader(resp.Body, u.cfg.MaxBodyBytes)
+	if decoded, charsetErr := charset.NewReader(body, contentType); charsetErr == nil {
+		body = decoded
+	}
+
+	meta := parseMetadata(body, resp.Request.URL)
+	if meta.IsEmpty() {
+		return vo.Metadata{}, fmt.Errorf("%s: %w", op, linkPreviewErrors.ErrNoMetadata)
+	}
+
+	return meta, nil
+}
+
+func (u *Unfurler) control(network, address string, _ syscall.RawConn) error {
+	if network != "tcp4" && network != "tcp6" {
+		return fmt.Errorf("%w: network %s", linkPreviewErrors.ErrBlockedAddress, network)
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !u.allowAddr(addr) {
+		return fmt.Errorf("%w: %s", linkPreviewErrors.ErrBlockedAddress, addr)
+	}
+
+	return nil
+}
+
+func validateURL(u *url.URL) error {
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+		return linkPreviewErrors.ErrInvalidURL
+	}
+	return nil
+}
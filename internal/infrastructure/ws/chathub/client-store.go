@@ -1,15 +1,26 @@
 package chathub
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+)
 
 type ClientStore interface {
 	Add(client *Client)
 	Remove(client *Client)
-	GetClients(chatID string) (map[string]*Client, bool)
+	// Subscribers lists the clients of the chat. The slice is shared and must not be changed,
+	// broadcasts range over it without locking however large the chat is.
+	Subscribers(chatID string) []*Client
+	Subscriber(chatID, clientID string) (*Client, bool)
+	// Unsubscribe stops delivering the chat to the client, e.g. after the user left it.
+	Unsubscribe(chatID, clientID string)
+	// Subscribe starts delivering the chat to a connected client, e.g. after the user joined it.
+	Subscribe(chatID, clientID string)
 }
 
 type InMemoryClientStoreImpl struct {
 	chatClients sync.Map // chatID -> *chatEntry
+	clients     sync.Map // clientID -> *Client
 }
 
 func NewInMemoryClientStoreImpl() *InMemoryClientStoreImpl {
@@ -21,35 +32,100 @@ func NewInMemoryClientStoreImpl() *InMemoryClientStoreImpl {
 type chatEntry struct {
 	mu      sync.Mutex
 	clients map[string]*Client
+	// snapshot is dropped on every change and rebuilt by the next broadcast,
+	// so a burst of subscribers joining a channel costs one copy instead of one per join.
+	snapshot atomic.Pointer[[]*Client]
 }
 
 func (i *InMemoryClientStoreImpl) Add(client *Client) {
-	for _, chat := range client.chats {
-		entry, _ := i.chatClients.LoadOrStore(chat, &chatEntry{clients: make(map[string]*Client)})
-		e := entry.(*chatEntry)
-		e.mu.Lock()
-		e.clients[client.id] = client
-		e.mu.Unlock()
+	i.clients.Store(client.id, client)
+	for _, chat := range client.chatIDs() {
+		i.add(chat, client)
 	}
 }
 
 func (i *InMemoryClientStoreImpl) Remove(client *Client) {
-	for _, chat := range client.chats {
-		entry, loaded := i.chatClients.Load(chat)
-		if !loaded {
-			continue
-		}
-		e := entry.(*chatEntry)
-		e.mu.Lock()
-		delete(e.clients, client.id)
-		if len(e.clients) == 0 {
-			i.chatClients.Delete(chat)
-		}
-		e.mu.Unlock()
-	}
-}
-
-func (i *InMemoryClientStoreImpl) GetClients(chatID string) (map[string]*Client, bool) {
+	// a reconnect of the user may already have replaced the client
+	i.clients.CompareAndDelete(client.id, client)
+	for _, chat := range client.chatIDs() {
+		i.remove(chat, client.id)
+	}
+}
+
+func (i *InMemoryClientStoreImpl) Subscribe(chatID, clientID string) {
+	stored, ok := i.clients.Load(clientID)
+	if !ok {
+		return
+	}
+	client := stored.(*Client)
+	if !client.join(chatID) {
+		return
+	}
+	i.add(chatID, client)
+	// a client closing meanwhile may have been removed from its chats without this one
+	if client.isClosed.Load() {
+		i.remove(chatID, clientID)
+	}
+}
+
+func (i *InMemoryClientStoreImpl) add(chatID string, client *Client) {
+	entry, _ := i.chatClients.LoadOrStore(chatID, &chatEntry{clients: make(map[string]*Client)})
+	e := entry.(*chatEntry)
+	e.mu.Lock()
+	e.clients[client.id] = client
+	e.snapshot.Store(nil)
+	e.mu.Unlock()
+}
+
+func (i *InMemoryClientStoreImpl) Unsubscribe(chatID, clientID string) {
+	client := i.remove(chatID, clientID)
+	if client != nil {
+		client.leave(chatID)
+	}
+}
+
+func (i *InMemoryClientStoreImpl) remove(chatID, clientID string) *Client {
+	entry, loaded := i.chatClients.Load(chatID)
+	if !loaded {
+		return nil
+	}
+	e := entry.(*chatEntry)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	client := e.clients[clientID]
+	delete(e.clients, clientID)
+	e.snapshot.Store(nil)
+	if len(e.clients) == 0 {
+		i.chatClients.Delete(chatID)
+	}
+	return client
+}
+
+func (i *InMemoryClientStoreImpl) Subscribers(chatID string) []*Client {
+	entry, loaded := i.chatClients.Load(chatID)
+	if !loaded {
+		return nil
+	}
+	e := entry.(*chatEntry)
+	if snapshot := e.snapshot.Load(); snapshot != nil {
+		return *snapshot
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if snapshot := e.snapshot.Load(); snapshot != nil {
+		return *snapshot
+	}
+	clients := make([]*Client, 0, len(e.clients))
+	for _, client := range e.clients {
+		clients = append(clients, client)
+	}
+	e.snapshot.Store(&clients)
+	return clients
+}
+
+func (i *InMemoryClientStoreImpl) Subscriber(chatID, clientID string) (*Client, bool) {
 	entry, loaded := i.chatClients.Load(chatID)
 	if !loaded {
 		return nil, false
@@ -57,5 +133,6 @@ func (i *InMemoryClientStoreImpl) GetClients(chatID string) (map[string]*Client,
 	e := entry.(*chatEntry)
 	e.mu.Lock()
 	defer e.mu.Unlock()
-	return e.clients, true
+	client, ok := e.clients[clientID]
+	return client, ok
 }
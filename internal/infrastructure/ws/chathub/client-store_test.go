@@ -0,0 +1,87 @@
+package chathub
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInMemoryClientStore_Unsubscribe(t *testing.T) {
+	store := NewInMemoryClientStoreImpl()
+	alice := NewClient(nil, nil, "alice", nil, "chat-1", "chat-2")
+	bob := NewClient(nil, nil, "bob", nil, "chat-1")
+	store.Add(alice)
+	store.Add(bob)
+
+	store.Unsubscribe("chat-1", "alice")
+
+	clients := store.Subscribers("chat-1")
+	if len(clients) != 1 || clients[0] != bob {
+		t.Fatalf("expected only bob in chat-1, got %v", clients)
+	}
+	if client, ok := store.Subscriber("chat-2", "alice"); !ok || client != alice {
+		t.Fatalf("expected alice to stay in chat-2, got %v", client)
+	}
+	if chats := alice.chatIDs(); !slices.Equal(chats, []string{"chat-2"}) {
+		t.Fatalf("expected alice to keep chat-2 only, got %v", chats)
+	}
+
+	// a later disconnect must not touch the chat she left
+	store.Remove(alice)
+	if clients = store.Subscribers("chat-2"); clients != nil {
+		t.Fatal("expected chat-2 to be dropped with its last client")
+	}
+	if client, _ := store.Subscriber("chat-1", "bob"); client != bob {
+		t.Fatalf("expected bob to stay in chat-1, got %v", client)
+	}
+
+	store.Unsubscribe("chat-3", "bob")
+}
+
+func TestInMemoryClientStore_SubscribersSnapshot(t *testing.T) {
+	store := NewInMemoryClientStoreImpl()
+	alice := NewClient(nil, nil, "alice", nil, "channel")
+	store.Add(alice)
+
+	before := store.Subscribers("channel")
+	if len(before) != 1 || before[0] != alice {
+		t.Fatalf("expected alice, got %v", before)
+	}
+
+	bob := NewClient(nil, nil, "bob", nil, "channel")
+	store.Add(bob)
+
+	// a broadcast already ranging over the old snapshot is not affected by the join
+	if len(before) != 1 {
+		t.Fatalf("expected the old snapshot to stay as it was, got %v", before)
+	}
+	if after := store.Subscribers("channel"); len(after) != 2 {
+		t.Fatalf("expected alice and bob, got %v", after)
+	}
+}
+
+func TestInMemoryClientStore_Subscribe(t *testing.T) {
+	store := NewInMemoryClientStoreImpl()
+	alice := NewClient(nil, nil, "alice", nil, "chat-1")
+	store.Add(alice)
+
+	store.Subscribe("chat-2", "alice")
+	store.Subscribe("chat-2", "alice")
+	store.Subscribe("chat-2", "bob")
+
+	if clients := store.Subscribers("chat-2"); len(clients) != 1 || clients[0] != alice {
+		t.Fatalf("expected only alice in chat-2, got %v", clients)
+	}
+	if chats := alice.chatIDs(); !slices.Equal(chats, []string{"chat-1", "chat-2"}) {
+		t.Fatalf("expected alice in chat-1 and chat-2, got %v", chats)
+	}
+
+	// the disconnect drops the joined chat along with the ones she connected with
+	store.Remove(alice)
+	if clients := store.Subscribers("chat-2"); clients != nil {
+		t.Fatalf("expected chat-2 to be dropped with alice, got %v", clients)
+	}
+	store.Subscribe("chat-3", "alice")
+	if clients := store.Subscribers("chat-3"); clients != nil {
+		t.Fatalf("expected a disconnected client not to be subscribed, got %v", clients)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"awesome-chat/internal/infrastructure/ws/chathub/consts"
 	"context"
 	"net"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -16,8 +17,9 @@ import (
 type Client struct {
 	log ports.Logger
 
-	id    string
-	chats []string
+	id      string
+	chats   []string
+	chatsMu sync.Mutex
 
 	socket       *websocket.Conn
 	send         chan []byte
@@ -51,6 +53,30 @@ func NewClient(
 	}
 }
 
+// chatIDs is a copy of the chats the client is subscribed to.
+func (c *Client) chatIDs() []string {
+	c.chatsMu.Lock()
+	defer c.chatsMu.Unlock()
+	return slices.Clone(c.chats)
+}
+
+// join adds the chat, false when the client is subscribed to it already.
+func (c *Client) join(chatID string) bool {
+	c.chatsMu.Lock()
+	defer c.chatsMu.Unlock()
+	if slices.Contains(c.chats, chatID) {
+		return false
+	}
+	c.chats = append(c.chats, chatID)
+	return true
+}
+
+func (c *Client) leave(chatID string) {
+	c.chatsMu.Lock()
+	defer c.chatsMu.Unlock()
+	c.chats = slices.DeleteFunc(c.chats, func(id string) bool { return id == chatID })
+}
+
 func (c *Client) Run(ctx context.Context) error {
 	c.log.Info("starting client session", "client_id", c.id)
 	defer func() {
@@ -160,7 +186,7 @@ func (c *Client) readPump(ctx context.Context) error {
 					c.respChanPool.Put(respChan)
 				}()
 
-				opCtx, opCancel := context.WithTimeout(ctx, 5*time.Second)
+				opCtx, opCancel := context.WithTimeout(WithClientID(ctx, c.id), 5*time.Second)
 				defer opCancel()
 
 				op := Operation{
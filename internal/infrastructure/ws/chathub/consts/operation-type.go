@@ -3,8 +3,13 @@ package consts
 type OperationType string
 
 const (
-	SendMessage OperationType = "send_message"
-	Broadcast   OperationType = "broadcast"
+	SendMessage    OperationType = "send_message"
+	ForwardMessage OperationType = "forward_message"
+	SaveDraft      OperationType = "save_draft"
+	VotePoll       OperationType = "vote_poll"
+	AckReceipts    OperationType = "ack_receipts"
+	DeleteMessage  OperationType = "delete_message"
+	Broadcast      OperationType = "broadcast"
 	// GetMessages etc
 )
 
@@ -2,6 +2,8 @@ package chathub
 
 import (
 	"awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/shared/events/entity"
 	"awesome-chat/internal/infrastructure/ws/chathub/consts"
 	chathubErrors "awesome-chat/internal/infrastructure/ws/chathub/errors"
 	"context"
@@ -20,6 +22,7 @@ type ClientManagerV2 struct {
 	upgrader    *websocket.Upgrader
 
 	broadcast chan Message
+	events    chan entity.ChatEvent
 	opChan    chan Operation
 	errChan   chan error
 
@@ -46,6 +49,7 @@ func NewClientManagerV2(
 		},
 		opChan:    make(chan Operation, consts.ChanBuff),
 		broadcast: make(chan Message, consts.ChanBuff),
+		events:    make(chan entity.ChatEvent, consts.ChanBuff),
 		errChan:   make(chan error, consts.ChanBuff),
 	}
 }
@@ -105,38 +109,83 @@ func (m *ClientManagerV2) broadcastToClients(message Message) {
 	}
 	opRespBytes := opResp.ToJSON()
 
-	clients, ok := m.clientStore.GetClients(message.ChatID)
-	if !ok {
-		m.log.Warn("no clients found for chat", "chat_id", message.ChatID)
-		return
-	}
-
 	m.log.Info("broadcasting message to chat",
 		"chat_id", message.ChatID,
 		"sender_id", message.UserID,
 		"content_length", len(message.Content),
 	)
 
-	for id, client := range clients {
-		if client.isClosed.Load() {
-			m.log.Debug("skipping message for client",
-				"client_id", id,
-				"chat_id", message.ChatID,
-				"queue_size", len(client.send),
-				"details", "client is closed",
-			)
-			continue
-		}
-		select {
-		case client.send <- opRespBytes:
-		default:
-			m.log.Warn("client buffer full, disconnecting",
-				"client_id", id,
-				"buffer_size", cap(client.send))
-			go func(c *Client) {
-				_ = c.Close()
-			}(client)
-		}
+	m.sendToChat(message.ChatID, opRespBytes)
+}
+
+func (m *ClientManagerV2) broadcastEventToClients(event entity.ChatEvent) {
+	opResp := &OperationResponse{
+		OperationType: event.Type.String(),
+		Success:       true,
+		Data:          event,
+	}
+
+	m.log.Info("broadcasting event to chat",
+		"chat_id", event.ChatID,
+		"event_type", event.Type,
+	)
+
+	// every node gets the event, so each subscribes the connection it holds before the member is sent the event
+	if event.GrantedID != nil {
+		m.clientStore.Subscribe(event.ChatID.String(), event.GrantedID.String())
+		m.log.Info("chat subscription granted", "chat_id", event.ChatID, "client_id", event.GrantedID)
+	}
+
+	if event.RecipientID != nil {
+		m.sendToMember(event.ChatID.String(), event.RecipientID.String(), opResp.ToJSON())
+	} else {
+		m.sendToChat(event.ChatID.String(), opResp.ToJSON())
+	}
+
+	// every node gets the event, so each drops the subscriptions of its own connections
+	if event.RevokedID != nil {
+		m.clientStore.Unsubscribe(event.ChatID.String(), event.RevokedID.String())
+		m.log.Info("chat subscription revoked", "chat_id", event.ChatID, "client_id", event.RevokedID)
+	}
+}
+
+func (m *ClientManagerV2) sendToChat(chatID string, payload []byte) {
+	clients := m.clientStore.Subscribers(chatID)
+	if len(clients) == 0 {
+		m.log.Warn("no clients found for chat", "chat_id", chatID)
+		return
+	}
+
+	for _, client := range clients {
+		m.send(chatID, client, payload)
+	}
+}
+
+func (m *ClientManagerV2) sendToMember(chatID, userID string, payload []byte) {
+	if client, found := m.clientStore.Subscriber(chatID, userID); found {
+		m.send(chatID, client, payload)
+	}
+}
+
+func (m *ClientManagerV2) send(chatID string, client *Client, payload []byte) {
+	if client.isClosed.Load() {
+		m.log.Debug("skipping message for client",
+			"client_id", client.id,
+			"chat_id", chatID,
+			"queue_size", len(client.send),
+			"details", "client is closed",
+		)
+		return
+	}
+	select {
+	case client.send <- payload:
+	default:
+		m.log.Warn("client buffer full, disconnecting",
+			"client_id", client.id,
+			"buffer_size", cap(client.send))
+		go func(c *Client) {
+			_ = c.Close()
+		}(client)
 	}
 }
 
@@ -156,6 +205,10 @@ func (m *ClientManagerV2) Start(ctx context.Context) error {
 			if !m.isClosed.Load() {
 				m.broadcastToClients(message)
 			}
+		case event := <-m.events:
+			if !m.isClosed.Load() {
+				m.broadcastEventToClients(event)
+			}
 		case op := <-m.opChan:
 			if m.isClosed.Load() {
 				op.RespChan <- OperationResponse{Error: errors.New("client manager is shutting down")}
@@ -177,6 +230,9 @@ func (m *ClientManagerV2) Start(ctx context.Context) error {
 					op.RespChan <- opResp
 				case errors.Is(opResp.Error, chathubErrors.ErrInvalidOpFormat):
 					op.RespChan <- opResp
+				case errors.Is(opResp.Error, chatErrors.ErrNotChatMember),
+					errors.Is(opResp.Error, chatErrors.ErrPermissionDenied):
+					op.RespChan <- opResp
 				default:
 					if op.Retries < 3 {
 						op.Retries++
@@ -211,6 +267,19 @@ func (m *ClientManagerV2) Broadcast(ctx context.Context, message Message) error
 	}
 }
 
+func (m *ClientManagerV2) BroadcastChatEvent(ctx context.Context, event entity.ChatEvent) error {
+	if m.isClosed.Load() {
+		return errors.New("client manager is shutting down")
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case m.events <- event:
+		return nil
+	}
+}
+
 func (m *ClientManagerV2) Shutdown(ctx context.Context) error {
 	if m.isClosed.Swap(true) {
 		return nil
@@ -218,6 +287,7 @@ func (m *ClientManagerV2) Shutdown(ctx context.Context) error {
 
 	close(m.opChan)
 	close(m.broadcast)
+	close(m.events)
 	close(m.errChan)
 
 	done := make(chan struct{})
@@ -2,6 +2,7 @@ package chathub
 
 import (
 	"awesome-chat/internal/domain/app/ports"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
 	"awesome-chat/internal/infrastructure/ws/chathub/consts"
 	chathubErrors "awesome-chat/internal/infrastructure/ws/chathub/errors"
 	"context"
@@ -103,7 +104,7 @@ func (m *ClientManager) registerClient(client *Client) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	for _, chat := range client.chats {
+	for _, chat := range client.chatIDs() {
 		if _, ok := m.chatClients[chat]; !ok {
 			m.chatClients[chat] = make(map[string]*Client)
 		}
@@ -116,7 +117,7 @@ func (m *ClientManager) unregisterClient(client *Client) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	for _, chat := range client.chats {
+	for _, chat := range client.chatIDs() {
 		if clients, ok := m.chatClients[chat]; ok {
 			delete(clients, client.id)
 			if len(clients) == 0 {
@@ -215,6 +216,9 @@ func (m *ClientManager) Start(ctx context.Context) error {
 					op.RespChan <- opResp
 				case errors.Is(opResp.Error, chathubErrors.ErrInvalidOpFormat):
 					op.RespChan <- opResp
+				case errors.Is(opResp.Error, chatErrors.ErrNotChatMember),
+					errors.Is(opResp.Error, chatErrors.ErrPermissionDenied):
+					op.RespChan <- opResp
 				default:
 					if op.Retries < 3 {
 						op.Retries++
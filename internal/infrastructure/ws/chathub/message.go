@@ -1,14 +1,34 @@
 package chathub
 
-import "encoding/json"
+import (
+	"awesome-chat/internal/domain/core/message/vo"
+	"encoding/json"
+)
 
 type Message struct {
+	ID int `json:"id,omitempty"`
+	// Type is empty for text messages sent by users, "system" ones report chat changes.
+	Type      string `json:"type,omitempty"`
 	UserID    string `json:"user_id"`
 	ChatID    string `json:"chat_id"`
 	Content   string `json:"content"`
 	Timestamp string `json:"timestamp,omitempty"`
-	ServerIP  string `json:"server_ip,omitempty"` // k8s
-	SenderIP  string `json:"sender_ip,omitempty"` // k8s
+	ExpiresAt string `json:"expires_at,omitempty"`
+	// Format and Entities describe how to render Content, which is plain text.
+	Format   string      `json:"format,omitempty"`
+	Entities []vo.Entity `json:"entities,omitempty"`
+	// ForwardedFrom names the original author of a forwarded message.
+	ForwardedFrom *ForwardOrigin `json:"forwarded_from,omitempty"`
+	// System is the structured payload of a system message, relayed untouched.
+	System   json.RawMessage `json:"system,omitempty"`
+	ServerIP string          `json:"server_ip,omitempty"` // k8s
+	SenderIP string          `json:"sender_ip,omitempty"` // k8s
+}
+
+type ForwardOrigin struct {
+	MessageID int    `json:"message_id,omitempty"`
+	UserID    string `json:"user_id"`
+	ChatID    string `json:"chat_id"`
 }
 
 func (m *Message) ToJSON() []byte {
@@ -14,6 +14,18 @@ type Operation struct {
 	Ctx      context.Context
 }
 
+type clientIDKey struct{}
+
+// WithClientID marks the context of an operation with the user of the connection it came from.
+func WithClientID(ctx context.Context, clientID string) context.Context {
+	return context.WithValue(ctx, clientIDKey{}, clientID)
+}
+
+func ClientIDFromContext(ctx context.Context) (string, bool) {
+	clientID, ok := ctx.Value(clientIDKey{}).(string)
+	return clientID, ok && clientID != ""
+}
+
 type OperationResponse struct {
 	ID            int    `json:"id,omitempty"`
 	OperationType string `json:"operation_type"`
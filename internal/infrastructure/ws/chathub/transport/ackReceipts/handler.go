@@ -0,0 +1,43 @@
+package ackReceipts
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	"awesome-chat/internal/domain/core/message/ports/usecases"
+	"awesome-chat/internal/infrastructure/ws/chathub"
+	"awesome-chat/internal/infrastructure/ws/chathub/consts"
+	"awesome-chat/internal/infrastructure/ws/chathub/transport"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Handler struct {
+	opType consts.OperationType
+	uc     usecases.ReceiptAck
+}
+
+func New(uc usecases.ReceiptAck) *Handler {
+	return &Handler{
+		opType: consts.AckReceipts,
+		uc:     uc,
+	}
+}
+
+func (h *Handler) Handle(ctx context.Context, body json.RawMessage) chathub.OperationResponse {
+	var req dto.AckReceiptsRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("invalid body format: %w", err))
+	}
+	req.UserID = transport.Actor(ctx, req.UserID)
+
+	resp, err := h.uc.Execute(ctx, req)
+	if err != nil {
+		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("ack receipts error: %w", err))
+	}
+
+	return chathub.SuccessResponse(h.opType.String(), resp)
+}
+
+func (h *Handler) Register(handlerStore transport.HandlerStore) {
+	handlerStore[h.opType] = h
+}
@@ -0,0 +1,45 @@
+package deleteMessage
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	"awesome-chat/internal/domain/core/message/ports/usecases"
+	"awesome-chat/internal/infrastructure/ws/chathub"
+	"awesome-chat/internal/infrastructure/ws/chathub/consts"
+	"awesome-chat/internal/infrastructure/ws/chathub/transport"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Handler struct {
+	opType consts.OperationType
+	uc     usecases.MessageDelete
+}
+
+func New(uc usecases.MessageDelete) *Handler {
+	return &Handler{
+		opType: consts.DeleteMessage,
+		uc:     uc,
+	}
+}
+
+func (h *Handler) Handle(ctx context.Context, body json.RawMessage) chathub.OperationResponse {
+	var req dto.DeleteMessageRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("invalid body format: %w", err))
+	}
+	req.UserID = transport.Actor(ctx, req.UserID)
+
+	if err := h.uc.Execute(ctx, req); err != nil {
+		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("delete message error: %w", err))
+	}
+
+	return chathub.SuccessResponse(h.opType.String(), dto.MessageDeletedEvent{
+		MessageID: req.MessageID,
+		UserID:    req.UserID,
+	})
+}
+
+func (h *Handler) Register(handlerStore transport.HandlerStore) {
+	handlerStore[h.opType] = h
+}
@@ -64,3 +64,11 @@ func (o *OperationHandler) Handle(
 
 	return handler.Handle(ctx, opDTO.Body)
 }
+
+// Actor is the user an operation runs as, the connection it came from wins over a user_id in the body.
+func Actor(ctx context.Context, bodyUserID string) string {
+	if clientID, ok := chathub.ClientIDFromContext(ctx); ok {
+		return clientID
+	}
+	return bodyUserID
+}
@@ -0,0 +1,43 @@
+package saveDraft
+
+import (
+	"awesome-chat/internal/application/draft/dto"
+	"awesome-chat/internal/domain/core/draft/ports/usecases"
+	"awesome-chat/internal/infrastructure/ws/chathub"
+	"awesome-chat/internal/infrastructure/ws/chathub/consts"
+	"awesome-chat/internal/infrastructure/ws/chathub/transport"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type Handler struct {
+	opType consts.OperationType
+	uc     usecases.DraftSave
+}
+
+func New(uc usecases.DraftSave) *Handler {
+	return &Handler{
+		opType: consts.SaveDraft,
+		uc:     uc,
+	}
+}
+
+func (h *Handler) Handle(ctx context.Context, body json.RawMessage) chathub.OperationResponse {
+	var req dto.SaveDraftRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("invalid body format: %w", err))
+	}
+	req.UserID = transport.Actor(ctx, req.UserID)
+
+	resp, err := h.uc.Execute(ctx, req)
+	if err != nil {
+		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("save draft error: %w", err))
+	}
+
+	return chathub.SuccessResponse(h.opType.String(), resp)
+}
+
+func (h *Handler) Register(handlerStore transport.HandlerStore) {
+	handlerStore[h.opType] = h
+}
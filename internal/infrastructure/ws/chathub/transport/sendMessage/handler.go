@@ -28,14 +28,14 @@ func (h *Handler) Handle(ctx context.Context, body json.RawMessage) chathub.Oper
 	if err := json.Unmarshal(body, &req); err != nil {
 		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("invalid body format: %w", err))
 	}
+	req.UserID = transport.Actor(ctx, req.UserID)
 
-	if err := h.uc.Execute(ctx, req); err != nil {
+	resp, err := h.uc.Execute(ctx, req)
+	if err != nil {
 		return chathub.ErrorResponse(h.opType.String(), fmt.Errorf("send message error: %w", err))
 	}
 
-	return chathub.SuccessResponse(h.opType.String(), map[string]string{
-		"message": "success",
-	})
+	return chathub.SuccessResponse(h.opType.String(), resp)
 }
 
 func (h *Handler) Register(handlerStore transport.HandlerStore) {
@@ -0,0 +1,145 @@
+package attachment
+
+import (
+	"awesome-chat/internal/application/attachment/dto"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	requestUploadUseCase interface {
+		Execute(ctx context.Context, req dto.RequestUploadRequest) (dto.RequestUploadResponse, error)
+	}
+	completeUploadUseCase interface {
+		Execute(ctx context.Context, req dto.CompleteUploadRequest) (dto.CompleteUploadResponse, error)
+	}
+	getDownloadURLUseCase interface {
+		Execute(ctx context.Context, req dto.GetDownloadURLRequest) (dto.GetDownloadURLResponse, error)
+	}
+)
+
+type Handler struct {
+	requestUploadUC  requestUploadUseCase
+	completeUploadUC completeUploadUseCase
+	getDownloadURLUC getDownloadURLUseCase
+}
+
+func NewAttachmentHandler(
+	requestUploadUC requestUploadUseCase,
+	completeUploadUC completeUploadUseCase,
+	getDownloadURLUC getDownloadURLUseCase,
+) *Handler {
+	return &Handler{
+		requestUploadUC:  requestUploadUC,
+		completeUploadUC: completeUploadUC,
+		getDownloadURLUC: getDownloadURLUC,
+	}
+}
+
+func (h *Handler) requestUpload(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.RequestUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+
+	resp, err := h.requestUploadUC.Execute(reqCtx, req)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *Handler) completeUpload(ctx *fiber.Ctx) error {
+	// checksum verification streams the whole object
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 60*time.Second)
+	defer cancel()
+
+	var req dto.CompleteUploadRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+	req.AttachmentID = ctx.Params("id")
+
+	resp, err := h.completeUploadUC.Execute(reqCtx, req)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) getDownloadURL(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	resp, err := h.getDownloadURLUC.Execute(reqCtx, dto.GetDownloadURLRequest{
+		UserID:       userID,
+		AttachmentID: ctx.Params("id"),
+		Size:         ctx.Query("size"),
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func errorResponse(ctx *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, attachmentErrors.ErrAttachmentNotFound),
+		errors.Is(err, attachmentErrors.ErrPreviewNotFound):
+		status = fiber.StatusNotFound
+	case errors.Is(err, attachmentErrors.ErrInvalidPreviewSize):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, chatErrors.ErrNotChatMember),
+		errors.Is(err, chatErrors.ErrPermissionDenied):
+		status = fiber.StatusForbidden
+	case errors.Is(err, attachmentErrors.ErrAlreadyCompleted):
+		status = fiber.StatusConflict
+	case errors.Is(err, attachmentErrors.ErrFileTooLarge):
+		status = fiber.StatusRequestEntityTooLarge
+	case errors.Is(err, attachmentErrors.ErrUnsupportedContentType):
+		status = fiber.StatusUnsupportedMediaType
+	case errors.Is(err, attachmentErrors.ErrEmptyFile),
+		errors.Is(err, attachmentErrors.ErrInvalidChecksum),
+		errors.Is(err, attachmentErrors.ErrAttachmentNotUploaded),
+		errors.Is(err, attachmentErrors.ErrSizeMismatch),
+		errors.Is(err, attachmentErrors.ErrContentTypeMismatch),
+		errors.Is(err, attachmentErrors.ErrChecksumMismatch):
+		status = fiber.StatusUnprocessableEntity
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":   "Attachment request failed",
+		"details": err.Error(),
+	})
+}
+
+func (h *Handler) RegisterRoutes(router fiber.Router) {
+	router.Post("/attachment/upload-url", h.requestUpload)
+	router.Post("/attachment/:id/complete", h.completeUpload)
+	router.Get("/attachment/:id/download-url", h.getDownloadURL)
+}
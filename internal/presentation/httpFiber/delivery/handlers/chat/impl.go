@@ -2,7 +2,12 @@ package chat
 
 import (
 	"awesome-chat/internal/application/chat/dto"
+	attachmentErrors "awesome-chat/internal/domain/core/attachment/errors"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	userErrors "awesome-chat/internal/domain/core/user/errors"
 	"context"
+	"errors"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -16,10 +21,46 @@ type (
 		Execute(ctx context.Context, req dto.AddUserRequest) error
 	}
 	getUserChatPreviewUseCase interface {
-		Execute(ctx context.Context, userID dto.UserID) (dto.GetUserChatPreviewResponse, error)
+		Execute(ctx context.Context, req dto.GetUserChatPreviewRequest) (dto.GetUserChatPreviewResponse, error)
 	}
-	getChatAllMessagesUseCase interface {
-		Execute(ctx context.Context, chatID dto.ChatID) (dto.AllMessages, error)
+	pinMessageUseCase interface {
+		Execute(ctx context.Context, req dto.PinMessageRequest) (dto.Pin, error)
+	}
+	unpinMessageUseCase interface {
+		Execute(ctx context.Context, req dto.PinMessageRequest) error
+	}
+	getPinsUseCase interface {
+		Execute(ctx context.Context, req dto.GetPinsRequest) (dto.GetPinsResponse, error)
+	}
+	setMessageTTLUseCase interface {
+		Execute(ctx context.Context, req dto.SetMessageTTLRequest) error
+	}
+	setMentionPolicyUseCase interface {
+		Execute(ctx context.Context, req dto.SetMentionPolicyRequest) error
+	}
+	setMemberRoleUseCase interface {
+		Execute(ctx context.Context, req dto.SetMemberRoleRequest) error
+	}
+	openDirectUseCase interface {
+		Execute(ctx context.Context, req dto.OpenDirectRequest) (dto.DirectChatResponse, error)
+	}
+	leaveChatUseCase interface {
+		Execute(ctx context.Context, req dto.LeaveChatRequest) error
+	}
+	removeMemberUseCase interface {
+		Execute(ctx context.Context, req dto.RemoveMemberRequest) error
+	}
+	updateChatUseCase interface {
+		Execute(ctx context.Context, req dto.UpdateChatRequest) (dto.ChatMetadata, error)
+	}
+	setAvatarUseCase interface {
+		Execute(ctx context.Context, req dto.SetChatAvatarRequest) (dto.ChatMetadata, error)
+	}
+	removeAvatarUseCase interface {
+		Execute(ctx context.Context, req dto.RemoveChatAvatarRequest) (dto.ChatMetadata, error)
+	}
+	setPreferencesUseCase interface {
+		Execute(ctx context.Context, req dto.SetPreferencesRequest) (dto.Preferences, error)
 	}
 )
 
@@ -27,20 +68,56 @@ type Handler struct {
 	createUC             createChatWithMembersUseCase
 	addUserUC            addUserUseCase
 	getUserChatPreviewUC getUserChatPreviewUseCase
-	getChatAllMessagesUC getChatAllMessagesUseCase
+	pinMessageUC         pinMessageUseCase
+	unpinMessageUC       unpinMessageUseCase
+	getPinsUC            getPinsUseCase
+	setMessageTTLUC      setMessageTTLUseCase
+	setMentionPolicyUC   setMentionPolicyUseCase
+	setMemberRoleUC      setMemberRoleUseCase
+	openDirectUC         openDirectUseCase
+	leaveChatUC          leaveChatUseCase
+	removeMemberUC       removeMemberUseCase
+	updateChatUC         updateChatUseCase
+	setAvatarUC          setAvatarUseCase
+	removeAvatarUC       removeAvatarUseCase
+	setPreferencesUC     setPreferencesUseCase
 }
 
 func NewChatHandler(
 	createUC createChatWithMembersUseCase,
 	addUserUC addUserUseCase,
 	getUserChatPreviewUC getUserChatPreviewUseCase,
-	getChatAllMessagesUC getChatAllMessagesUseCase,
+	pinMessageUC pinMessageUseCase,
+	unpinMessageUC unpinMessageUseCase,
+	getPinsUC getPinsUseCase,
+	setMessageTTLUC setMessageTTLUseCase,
+	setMentionPolicyUC setMentionPolicyUseCase,
+	setMemberRoleUC setMemberRoleUseCase,
+	openDirectUC openDirectUseCase,
+	leaveChatUC leaveChatUseCase,
+	removeMemberUC removeMemberUseCase,
+	updateChatUC updateChatUseCase,
+	setAvatarUC setAvatarUseCase,
+	removeAvatarUC removeAvatarUseCase,
+	setPreferencesUC setPreferencesUseCase,
 ) *Handler {
 	return &Handler{
 		createUC:             createUC,
 		addUserUC:            addUserUC,
 		getUserChatPreviewUC: getUserChatPreviewUC,
-		getChatAllMessagesUC: getChatAllMessagesUC,
+		pinMessageUC:         pinMessageUC,
+		unpinMessageUC:       unpinMessageUC,
+		getPinsUC:            getPinsUC,
+		setMessageTTLUC:      setMessageTTLUC,
+		setMentionPolicyUC:   setMentionPolicyUC,
+		setMemberRoleUC:      setMemberRoleUC,
+		openDirectUC:         openDirectUC,
+		leaveChatUC:          leaveChatUC,
+		removeMemberUC:       removeMemberUC,
+		updateChatUC:         updateChatUC,
+		setAvatarUC:          setAvatarUC,
+		removeAvatarUC:       removeAvatarUC,
+		setPreferencesUC:     setPreferencesUC,
 	}
 }
 
@@ -55,7 +132,14 @@ func (h *Handler) createChatWithMembers(ctx *fiber.Ctx) error {
 
 	chat, err := h.createUC.Execute(ctx.Context(), req)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		status := fiber.StatusInternalServerError
+		if errors.Is(err, chatErrors.ErrChatShortName) ||
+			errors.Is(err, chatErrors.ErrChatCreatorRequired) ||
+			errors.Is(err, chatErrors.ErrChatInvalidMembersLen) ||
+			errors.Is(err, chatErrors.ErrInvalidChatType) {
+			status = fiber.StatusBadRequest
+		}
+		return ctx.Status(status).JSON(fiber.Map{
 			"error":   "internal server error",
 			"details": err.Error(),
 		})
@@ -71,12 +155,56 @@ func (h *Handler) AddUser(ctx *fiber.Ctx) error {
 	}
 
 	if err := h.addUserUC.Execute(ctx.Context(), req); err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, chatErrors.ErrNotChatMember),
+			errors.Is(err, chatErrors.ErrPermissionDenied):
+			status = fiber.StatusForbidden
+		case errors.Is(err, chatErrors.ErrChatNotFound):
+			status = fiber.StatusNotFound
+		case errors.Is(err, chatErrors.ErrAlreadyMember),
+			errors.Is(err, chatErrors.ErrDirectChatMembers):
+			status = fiber.StatusConflict
+		}
+		return fiber.NewError(status, err.Error())
 	}
 
 	return ctx.SendStatus(fiber.StatusOK)
 }
 
+func (h *Handler) openDirect(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.OpenDirectRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+
+	chat, err := h.openDirectUC.Execute(reqCtx, req)
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, chatErrors.ErrDirectWithSelf):
+			status = fiber.StatusBadRequest
+		case errors.Is(err, userErrors.ErrUserDoesNotExist):
+			status = fiber.StatusNotFound
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to open direct chat",
+			"details": err.Error(),
+		})
+	}
+
+	if chat.Created {
+		return ctx.Status(fiber.StatusCreated).JSON(chat)
+	}
+	return ctx.Status(fiber.StatusOK).JSON(chat)
+}
+
 func (h *Handler) getUserChatPreview(ctx *fiber.Ctx) error {
 	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
 	defer cancel()
@@ -88,8 +216,19 @@ func (h *Handler) getUserChatPreview(ctx *fiber.Ctx) error {
 		})
 	}
 
-	resp, err := h.getUserChatPreviewUC.Execute(reqCtx, dto.UserID(id))
+	resp, err := h.getUserChatPreviewUC.Execute(reqCtx, dto.GetUserChatPreviewRequest{
+		UserID:          id,
+		IncludeArchived: ctx.QueryBool("archived"),
+		Limit:           ctx.QueryInt("limit", 0),
+		Cursor:          ctx.Query("cursor"),
+	})
 	if err != nil {
+		if errors.Is(err, chatErrors.ErrInvalidPreviewCursor) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid cursor",
+				"details": err.Error(),
+			})
+		}
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "internal server error",
 			"details": err.Error(),
@@ -99,36 +238,423 @@ func (h *Handler) getUserChatPreview(ctx *fiber.Ctx) error {
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
 		"chat_previews": resp.ChatPreviews,
 		"meta": fiber.Map{
-			"count": len(resp.ChatPreviews),
+			"count":       len(resp.ChatPreviews),
+			"total":       resp.Total,
+			"next_cursor": resp.NextCursor,
 		},
 	})
 }
 
-func (h *Handler) getChatAllMessages(ctx *fiber.Ctx) error {
+func (h *Handler) getPins(ctx *fiber.Ctx) error {
 	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
 	defer cancel()
 
-	id := ctx.Params("chat_id")
-	if id == "" {
+	userID := ctx.Query("user_id")
+	if userID == "" {
 		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "chat id required",
+			"error": "user_id is required",
 		})
 	}
 
-	messages, err := h.getChatAllMessagesUC.Execute(reqCtx, dto.ChatID(id))
+	resp, err := h.getPinsUC.Execute(reqCtx, dto.GetPinsRequest{
+		UserID: userID,
+		ChatID: ctx.Params("id"),
+	})
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error":   "internal server error",
+		return pinErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) pinMessage(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.PinMessageRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
 			"details": err.Error(),
 		})
 	}
+	req.ChatID = ctx.Params("id")
 
-	return ctx.Status(fiber.StatusOK).JSON(messages)
+	pin, err := h.pinMessageUC.Execute(reqCtx, req)
+	if err != nil {
+		return pinErrorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(pin)
+}
+
+func (h *Handler) unpinMessage(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+	messageID, err := ctx.ParamsInt("message_id")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid message id",
+			"details": err.Error(),
+		})
+	}
+
+	if err = h.unpinMessageUC.Execute(reqCtx, dto.PinMessageRequest{
+		UserID:    userID,
+		ChatID:    ctx.Params("id"),
+		MessageID: messageID,
+	}); err != nil {
+		return pinErrorResponse(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func pinErrorResponse(ctx *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, chatErrors.ErrNotChatMember),
+		errors.Is(err, chatErrors.ErrPermissionDenied):
+		status = fiber.StatusForbidden
+	case errors.Is(err, chatErrors.ErrMessageNotInChat),
+		errors.Is(err, chatErrors.ErrNotPinned):
+		status = fiber.StatusNotFound
+	case errors.Is(err, chatErrors.ErrAlreadyPinned),
+		errors.Is(err, chatErrors.ErrTooManyPins):
+		status = fiber.StatusConflict
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":   "Pin request failed",
+		"details": err.Error(),
+	})
+}
+
+func (h *Handler) setMessageTTL(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.SetMessageTTLRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+
+	if err := h.setMessageTTLUC.Execute(reqCtx, req); err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, msgErrors.ErrInvalidTTL):
+			status = fiber.StatusBadRequest
+		case errors.Is(err, chatErrors.ErrNotChatMember),
+			errors.Is(err, chatErrors.ErrPermissionDenied):
+			status = fiber.StatusForbidden
+		case errors.Is(err, chatErrors.ErrChatNotFound):
+			status = fiber.StatusNotFound
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to set message ttl",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) setMentionPolicy(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.SetMentionPolicyRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+
+	if err := h.setMentionPolicyUC.Execute(reqCtx, req); err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, chatErrors.ErrInvalidMentionPolicy):
+			status = fiber.StatusBadRequest
+		case errors.Is(err, chatErrors.ErrNotChatMember),
+			errors.Is(err, chatErrors.ErrPermissionDenied):
+			status = fiber.StatusForbidden
+		case errors.Is(err, chatErrors.ErrChatNotFound):
+			status = fiber.StatusNotFound
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to set mention policy",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) setMemberRole(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.SetMemberRoleRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+	req.UserID = ctx.Params("user_id")
+
+	if err := h.setMemberRoleUC.Execute(reqCtx, req); err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, chatErrors.ErrInvalidRole):
+			status = fiber.StatusBadRequest
+		case errors.Is(err, chatErrors.ErrNotChatMember),
+			errors.Is(err, chatErrors.ErrPermissionDenied),
+			errors.Is(err, chatErrors.ErrRoleNotAssignable):
+			status = fiber.StatusForbidden
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to set member role",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) leaveChat(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.LeaveChatRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+
+	if err := h.leaveChatUC.Execute(reqCtx, req); err != nil {
+		return memberErrorResponse(ctx, "Failed to leave chat", err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func (h *Handler) removeMember(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	actorID := ctx.Query("actor_id")
+	if actorID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "actor_id is required",
+		})
+	}
+
+	if err := h.removeMemberUC.Execute(reqCtx, dto.RemoveMemberRequest{
+		ActorID: actorID,
+		ChatID:  ctx.Params("id"),
+		UserID:  ctx.Params("user_id"),
+	}); err != nil {
+		return memberErrorResponse(ctx, "Failed to remove member", err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func memberErrorResponse(ctx *fiber.Ctx, message string, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, chatErrors.ErrRemoveSelf):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, chatErrors.ErrPermissionDenied):
+		status = fiber.StatusForbidden
+	case errors.Is(err, chatErrors.ErrNotChatMember),
+		errors.Is(err, chatErrors.ErrChatNotFound),
+		errors.Is(err, userErrors.ErrUserDoesNotExist):
+		status = fiber.StatusNotFound
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":   message,
+		"details": err.Error(),
+	})
+}
+
+func (h *Handler) updateChat(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.UpdateChatRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+
+	resp, err := h.updateChatUC.Execute(reqCtx, req)
+	if err != nil {
+		return metadataErrorResponse(ctx, "Failed to update chat", err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// setAvatar takes a multipart form with the image in "avatar" and the actor in "actor_id".
+func (h *Handler) setAvatar(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 10*time.Second)
+	defer cancel()
+
+	actorID := ctx.FormValue("actor_id")
+	if actorID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "actor_id is required",
+		})
+	}
+
+	header, err := ctx.FormFile("avatar")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "avatar file is required",
+			"details": err.Error(),
+		})
+	}
+	file, err := header.Open()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Failed to read avatar",
+			"details": err.Error(),
+		})
+	}
+	defer func() { _ = file.Close() }()
+
+	resp, err := h.setAvatarUC.Execute(reqCtx, dto.SetChatAvatarRequest{
+		ActorID: actorID,
+		ChatID:  ctx.Params("id"),
+		Image:   file,
+	})
+	if err != nil {
+		return metadataErrorResponse(ctx, "Failed to set chat avatar", err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) removeAvatar(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	actorID := ctx.Query("actor_id")
+	if actorID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "actor_id is required",
+		})
+	}
+
+	resp, err := h.removeAvatarUC.Execute(reqCtx, dto.RemoveChatAvatarRequest{
+		ActorID: actorID,
+		ChatID:  ctx.Params("id"),
+	})
+	if err != nil {
+		return metadataErrorResponse(ctx, "Failed to remove chat avatar", err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) setPreferences(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.SetPreferencesRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+
+	resp, err := h.setPreferencesUC.Execute(reqCtx, req)
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, chatErrors.ErrInvalidMute),
+			errors.Is(err, chatErrors.ErrInvalidSortOrder),
+			errors.Is(err, chatErrors.ErrNothingToUpdate):
+			status = fiber.StatusBadRequest
+		case errors.Is(err, chatErrors.ErrNotChatMember):
+			status = fiber.StatusForbidden
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to set chat preferences",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func metadataErrorResponse(ctx *fiber.Ctx, message string, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, chatErrors.ErrChatShortName),
+		errors.Is(err, chatErrors.ErrChatLongName),
+		errors.Is(err, chatErrors.ErrDescriptionTooLong),
+		errors.Is(err, chatErrors.ErrNothingToUpdate),
+		errors.Is(err, attachmentErrors.ErrUnsupportedImage):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, chatErrors.ErrAvatarTooLarge),
+		errors.Is(err, attachmentErrors.ErrImageTooLarge):
+		status = fiber.StatusRequestEntityTooLarge
+	case errors.Is(err, chatErrors.ErrNotChatMember),
+		errors.Is(err, chatErrors.ErrPermissionDenied):
+		status = fiber.StatusForbidden
+	case errors.Is(err, chatErrors.ErrChatNotFound):
+		status = fiber.StatusNotFound
+	case errors.Is(err, chatErrors.ErrDirectChatSettings):
+		status = fiber.StatusConflict
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":   message,
+		"details": err.Error(),
+	})
 }
 
 func (h *Handler) RegisterRoutes(router fiber.Router) {
 	router.Post("/chat", h.createChatWithMembers)
 	router.Post("/chat/add-user", h.AddUser)
+	router.Post("/chat/direct", h.openDirect)
 	router.Get("/chat/:id", h.getUserChatPreview)
-	router.Get("/chat/messages/:chat_id", h.getChatAllMessages)
+	router.Patch("/chat/:id", h.updateChat)
+	router.Put("/chat/:id/avatar", h.setAvatar)
+	router.Delete("/chat/:id/avatar", h.removeAvatar)
+	router.Put("/chat/:id/preferences", h.setPreferences)
+	router.Get("/chat/:id/pins", h.getPins)
+	router.Post("/chat/:id/pins", h.pinMessage)
+	router.Delete("/chat/:id/pins/:message_id", h.unpinMessage)
+	router.Put("/chat/:id/ttl", h.setMessageTTL)
+	router.Put("/chat/:id/mention-policy", h.setMentionPolicy)
+	router.Put("/chat/:id/members/:user_id/role", h.setMemberRole)
+	router.Delete("/chat/:id/members/:user_id", h.removeMember)
+	router.Post("/chat/:id/leave", h.leaveChat)
 }
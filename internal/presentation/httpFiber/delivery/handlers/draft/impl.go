@@ -0,0 +1,61 @@
+package draft
+
+import (
+	"awesome-chat/internal/application/draft/dto"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	draftErrors "awesome-chat/internal/domain/core/draft/errors"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type getDraftUseCase interface {
+	Execute(ctx context.Context, req dto.GetDraftRequest) (dto.Draft, error)
+}
+
+type Handler struct {
+	getDraftUC getDraftUseCase
+}
+
+func NewDraftHandler(getDraftUC getDraftUseCase) *Handler {
+	return &Handler{getDraftUC: getDraftUC}
+}
+
+func (h *Handler) get(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	resp, err := h.getDraftUC.Execute(reqCtx, dto.GetDraftRequest{
+		UserID: userID,
+		ChatID: ctx.Params("id"),
+	})
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, draftErrors.ErrDraftNotFound):
+			status = fiber.StatusNotFound
+		case errors.Is(err, chatErrors.ErrNotChatMember):
+			status = fiber.StatusForbidden
+		}
+
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to get draft",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) RegisterRoutes(router fiber.Router) {
+	router.Get("/chat/:id/draft", h.get)
+}
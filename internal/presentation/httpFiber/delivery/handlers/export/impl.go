@@ -0,0 +1,102 @@
+package export
+
+import (
+	"awesome-chat/internal/application/export/dto"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	exportErrors "awesome-chat/internal/domain/core/export/errors"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	requestUseCase interface {
+		Execute(ctx context.Context, req dto.RequestExportRequest) (dto.Export, error)
+	}
+	getUseCase interface {
+		Execute(ctx context.Context, req dto.GetExportRequest) (dto.Export, error)
+	}
+)
+
+type Handler struct {
+	requestUC requestUseCase
+	getUC     getUseCase
+}
+
+func NewExportHandler(
+	requestUC requestUseCase,
+	getUC getUseCase,
+) *Handler {
+	return &Handler{
+		requestUC: requestUC,
+		getUC:     getUC,
+	}
+}
+
+func (h *Handler) request(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.RequestExportRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+
+	resp, err := h.requestUC.Execute(reqCtx, req)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	// the export runs in the background, the requester gets export_finished or polls the export
+	return ctx.Status(fiber.StatusAccepted).JSON(resp)
+}
+
+func (h *Handler) get(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	resp, err := h.getUC.Execute(reqCtx, dto.GetExportRequest{
+		UserID:   userID,
+		ExportID: ctx.Params("id"),
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func errorResponse(ctx *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, exportErrors.ErrExportNotFound):
+		status = fiber.StatusNotFound
+	case errors.Is(err, chatErrors.ErrNotChatMember):
+		status = fiber.StatusForbidden
+	case errors.Is(err, exportErrors.ErrExportInProgress):
+		status = fiber.StatusConflict
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":   "Export request failed",
+		"details": err.Error(),
+	})
+}
+
+func (h *Handler) RegisterRoutes(router fiber.Router) {
+	router.Post("/chat/:id/export", h.request)
+	router.Get("/export/:id", h.get)
+}
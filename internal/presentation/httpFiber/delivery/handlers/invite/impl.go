@@ -0,0 +1,243 @@
+package invite
+
+import (
+	"awesome-chat/internal/application/chat/dto"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	userErrors "awesome-chat/internal/domain/core/user/errors"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	createInviteUseCase interface {
+		Execute(ctx context.Context, req dto.CreateInviteRequest) (dto.Invite, error)
+	}
+	listInvitesUseCase interface {
+		Execute(ctx context.Context, req dto.ListInvitesRequest) ([]dto.Invite, error)
+	}
+	revokeInviteUseCase interface {
+		Execute(ctx context.Context, req dto.RevokeInviteRequest) error
+	}
+	joinByInviteUseCase interface {
+		Execute(ctx context.Context, req dto.JoinByInviteRequest) (dto.JoinResponse, error)
+	}
+	listJoinRequestsUseCase interface {
+		Execute(ctx context.Context, req dto.ListJoinRequestsRequest) ([]dto.JoinRequest, error)
+	}
+	decideJoinRequestUseCase interface {
+		Execute(ctx context.Context, req dto.DecideJoinRequestRequest) error
+	}
+)
+
+type Handler struct {
+	createInviteUC      createInviteUseCase
+	listInvitesUC       listInvitesUseCase
+	revokeInviteUC      revokeInviteUseCase
+	joinByInviteUC      joinByInviteUseCase
+	listJoinRequestsUC  listJoinRequestsUseCase
+	decideJoinRequestUC decideJoinRequestUseCase
+}
+
+func NewInviteHandler(
+	createInviteUC createInviteUseCase,
+	listInvitesUC listInvitesUseCase,
+	revokeInviteUC revokeInviteUseCase,
+	joinByInviteUC joinByInviteUseCase,
+	listJoinRequestsUC listJoinRequestsUseCase,
+	decideJoinRequestUC decideJoinRequestUseCase,
+) *Handler {
+	return &Handler{
+		createInviteUC:      createInviteUC,
+		listInvitesUC:       listInvitesUC,
+		revokeInviteUC:      revokeInviteUC,
+		joinByInviteUC:      joinByInviteUC,
+		listJoinRequestsUC:  listJoinRequestsUC,
+		decideJoinRequestUC: decideJoinRequestUC,
+	}
+}
+
+func (h *Handler) create(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.CreateInviteRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+	req.ChatID = ctx.Params("id")
+
+	resp, err := h.createInviteUC.Execute(reqCtx, req)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *Handler) list(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	resp, err := h.listInvitesUC.Execute(reqCtx, dto.ListInvitesRequest{
+		UserID: userID,
+		ChatID: ctx.Params("id"),
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{"invites": resp})
+}
+
+func (h *Handler) revoke(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	actorID := ctx.Query("actor_id")
+	if actorID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "actor_id is required",
+		})
+	}
+
+	if err := h.revokeInviteUC.Execute(reqCtx, dto.RevokeInviteRequest{
+		ActorID:  actorID,
+		ChatID:   ctx.Params("id"),
+		InviteID: ctx.Params("invite_id"),
+	}); err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// join answers 200 when the user is in, 202 when the request waits for an admin.
+func (h *Handler) join(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.JoinByInviteRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+	req.Token = ctx.Params("token")
+
+	resp, err := h.joinByInviteUC.Execute(reqCtx, req)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	status := fiber.StatusOK
+	if resp.Status == dto.JoinStatusPending {
+		status = fiber.StatusAccepted
+	}
+
+	return ctx.Status(status).JSON(resp)
+}
+
+func (h *Handler) listJoinRequests(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	resp, err := h.listJoinRequestsUC.Execute(reqCtx, dto.ListJoinRequestsRequest{
+		UserID: userID,
+		ChatID: ctx.Params("id"),
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{"requests": resp})
+}
+
+func (h *Handler) approve(ctx *fiber.Ctx) error {
+	return h.decide(ctx, true)
+}
+
+func (h *Handler) reject(ctx *fiber.Ctx) error {
+	return h.decide(ctx, false)
+}
+
+func (h *Handler) decide(ctx *fiber.Ctx, approve bool) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	actorID := ctx.Query("actor_id")
+	if actorID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "actor_id is required",
+		})
+	}
+
+	if err := h.decideJoinRequestUC.Execute(reqCtx, dto.DecideJoinRequestRequest{
+		ActorID: actorID,
+		ChatID:  ctx.Params("id"),
+		UserID:  ctx.Params("user_id"),
+		Approve: approve,
+	}); err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func errorResponse(ctx *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, chatErrors.ErrInvalidInvite):
+		status = fiber.StatusBadRequest
+	case errors.Is(err, chatErrors.ErrNotChatMember),
+		errors.Is(err, chatErrors.ErrPermissionDenied):
+		status = fiber.StatusForbidden
+	case errors.Is(err, chatErrors.ErrInviteNotFound),
+		errors.Is(err, chatErrors.ErrJoinRequestNotFound),
+		errors.Is(err, chatErrors.ErrChatNotFound),
+		errors.Is(err, userErrors.ErrUserDoesNotExist):
+		status = fiber.StatusNotFound
+	case errors.Is(err, chatErrors.ErrAlreadyMember),
+		errors.Is(err, chatErrors.ErrJoinRequestPending),
+		errors.Is(err, chatErrors.ErrDirectChatMembers):
+		status = fiber.StatusConflict
+	case errors.Is(err, chatErrors.ErrInviteExpired),
+		errors.Is(err, chatErrors.ErrInviteExhausted):
+		status = fiber.StatusGone
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":   "Invite request failed",
+		"details": err.Error(),
+	})
+}
+
+func (h *Handler) RegisterRoutes(router fiber.Router) {
+	router.Post("/chat/:id/invites", h.create)
+	router.Get("/chat/:id/invites", h.list)
+	router.Delete("/chat/:id/invites/:invite_id", h.revoke)
+	router.Get("/chat/:id/join-requests", h.listJoinRequests)
+	router.Post("/chat/:id/join-requests/:user_id/approve", h.approve)
+	router.Post("/chat/:id/join-requests/:user_id/reject", h.reject)
+	router.Post("/invites/:token/join", h.join)
+}
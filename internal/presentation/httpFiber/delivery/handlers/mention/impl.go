@@ -0,0 +1,95 @@
+package mention
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	mentionsFeedUseCase interface {
+		Execute(ctx context.Context, req dto.MentionsFeedRequest) (dto.MentionsFeedResponse, error)
+	}
+	readMentionsUseCase interface {
+		Execute(ctx context.Context, req dto.ReadMentionsRequest) (dto.ReadMentionsResponse, error)
+	}
+)
+
+type Handler struct {
+	feedUC mentionsFeedUseCase
+	readUC readMentionsUseCase
+}
+
+func NewMentionHandler(
+	feedUC mentionsFeedUseCase,
+	readUC readMentionsUseCase,
+) *Handler {
+	return &Handler{
+		feedUC: feedUC,
+		readUC: readUC,
+	}
+}
+
+func (h *Handler) feed(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	resp, err := h.feedUC.Execute(reqCtx, dto.MentionsFeedRequest{
+		UserID:     userID,
+		ChatID:     ctx.Query("chat_id"),
+		UnreadOnly: ctx.QueryBool("unread_only"),
+		Limit:      ctx.QueryInt("limit", 20),
+		Cursor:     ctx.Query("cursor"),
+	})
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		if errors.Is(err, msgErrors.ErrInvalidCursor) {
+			status = fiber.StatusBadRequest
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to get mentions",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) read(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.ReadMentionsRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+	}
+
+	resp, err := h.readUC.Execute(reqCtx, req)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Failed to mark mentions as read",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) RegisterRoutes(router fiber.Router) {
+	router.Get("/mentions", h.feed)
+	router.Post("/mentions/read", h.read)
+}
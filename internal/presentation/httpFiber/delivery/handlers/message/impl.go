@@ -2,8 +2,12 @@ package messages
 
 import (
 	"awesome-chat/internal/application/message/dto"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
 	"awesome-chat/internal/domain/core/message/ports/usecases"
 	"context"
+	"errors"
+	"io"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -13,44 +17,71 @@ type (
 	saveUseCase interface {
 		Execute(ctx context.Context, msg dto.Message) error
 	}
-	getMessagesUseCase interface {
-		Execute(ctx context.Context, req dto.GetRequest) (dto.Messages, error)
-	}
 	sendUseCase interface {
 		Execute(ctx context.Context, req dto.SendRequest) error
 	}
 	sendSyncUseCase interface {
 		Execute(ctx context.Context, req dto.SendSyncRequest, raw []byte) error
 	}
-	getForChatWithFilterUseCase interface {
-		Execute(ctx context.Context, req dto.GetForChatWithFilterRequest) (dto.GetForChatWithFilterResponse, error)
+	getPageUseCase interface {
+		Execute(ctx context.Context, req dto.PageRequest) (dto.PageResponse, error)
+	}
+	searchUseCase interface {
+		Execute(ctx context.Context, req dto.SearchRequest) (dto.SearchResponse, error)
+	}
+	createPollUseCase interface {
+		Execute(ctx context.Context, req dto.CreatePollRequest) (dto.Poll, error)
+	}
+	getPollUseCase interface {
+		Execute(ctx context.Context, req dto.GetPollRequest) (dto.Poll, error)
+	}
+	getReceiptsUseCase interface {
+		Execute(ctx context.Context, req dto.GetReceiptsRequest) (dto.MessageReceipts, error)
 	}
 )
 
 type Handler struct {
-	getMessagesUC          getMessagesUseCase
-	saveUC                 saveUseCase
-	sendUC                 sendUseCase
-	sendSyncUC             sendSyncUseCase
-	getForChatWithFilterUC getForChatWithFilterUseCase
-	sendVoiceUC            usecases.SendVoice
+	saveUC       saveUseCase
+	sendUC       sendUseCase
+	sendSyncUC   sendSyncUseCase
+	getPageUC    getPageUseCase
+	sendVoiceUC  usecases.SendVoice
+	searchUC     searchUseCase
+	forwardUC    usecases.MessageForward
+	createPollUC createPollUseCase
+	votePollUC   usecases.PollVote
+	getPollUC    getPollUseCase
+	receiptsUC   getReceiptsUseCase
+	deleteUC     usecases.MessageDelete
 }
 
 func NewMessageHandler(
-	getMessagesUC getMessagesUseCase,
 	saveUC saveUseCase,
 	sendUC sendUseCase,
 	sendSyncUC sendSyncUseCase,
-	getForChatWithFilterUC getForChatWithFilterUseCase,
+	getPageUC getPageUseCase,
 	sendVoiceUC usecases.SendVoice,
+	searchUC searchUseCase,
+	forwardUC usecases.MessageForward,
+	createPollUC createPollUseCase,
+	votePollUC usecases.PollVote,
+	getPollUC getPollUseCase,
+	receiptsUC getReceiptsUseCase,
+	deleteUC usecases.MessageDelete,
 ) *Handler {
 	return &Handler{
-		sendSyncUC:             sendSyncUC,
-		saveUC:                 saveUC,
-		sendUC:                 sendUC,
-		getMessagesUC:          getMessagesUC,
-		getForChatWithFilterUC: getForChatWithFilterUC,
-		sendVoiceUC:            sendVoiceUC,
+		sendSyncUC:   sendSyncUC,
+		saveUC:       saveUC,
+		sendUC:       sendUC,
+		getPageUC:    getPageUC,
+		sendVoiceUC:  sendVoiceUC,
+		searchUC:     searchUC,
+		forwardUC:    forwardUC,
+		createPollUC: createPollUC,
+		votePollUC:   votePollUC,
+		getPollUC:    getPollUC,
+		receiptsUC:   receiptsUC,
+		deleteUC:     deleteUC,
 	}
 }
 
@@ -81,9 +112,27 @@ func (h *Handler) SendVoice(ctx *fiber.Ctx) error {
 		})
 	}
 
+	audio, err := blob.Open()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "error reading file",
+			"details": err.Error(),
+		})
+	}
+	defer func() { _ = audio.Close() }()
+
+	raw, err := io.ReadAll(audio)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "error reading file",
+			"details": err.Error(),
+		})
+	}
+	data.Blob = string(raw)
+
 	resp, err := h.sendVoiceUC.Execute(ctx.Context(), data)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return ctx.Status(sendErrorStatus(err)).JSON(fiber.Map{
 			"error":   "failed to send voice message",
 			"details": err.Error(),
 		})
@@ -105,7 +154,7 @@ func (h *Handler) Save(ctx *fiber.Ctx) error {
 	}
 
 	if err := h.saveUC.Execute(timeoutCtx, msg); err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return fiber.NewError(sendErrorStatus(err), err.Error())
 	}
 
 	return ctx.SendStatus(fiber.StatusOK)
@@ -119,7 +168,7 @@ func (h *Handler) Send(ctx *fiber.Ctx) error {
 	}
 
 	if err = h.sendUC.Execute(ctx.Context(), data); err != nil {
-		return err
+		return fiber.NewError(sendErrorStatus(err), err.Error())
 	}
 
 	return ctx.SendStatus(fiber.StatusAccepted)
@@ -133,73 +182,313 @@ func (h *Handler) SendSync(ctx *fiber.Ctx) error {
 	}
 
 	if err = h.sendSyncUC.Execute(ctx.Context(), data, ctx.Body()); err != nil {
-		return err
+		return fiber.NewError(sendErrorStatus(err), err.Error())
 	}
 
 	return ctx.SendStatus(fiber.StatusAccepted)
 }
 
-func (h *Handler) GetMessages(ctx *fiber.Ctx) error {
+func (h *Handler) getPage(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
 	chatID := ctx.Query("chat_id")
 	if chatID == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "chat_id is required")
 	}
 
-	limit := ctx.QueryInt("limit", 100)
+	resp, err := h.getPageUC.Execute(reqCtx, dto.PageRequest{
+		ChatID:   chatID,
+		UserID:   ctx.Query("user_id"),
+		Before:   ctx.Query("before"),
+		After:    ctx.Query("after"),
+		AroundID: ctx.QueryInt("around", 0),
+		Limit:    ctx.QueryInt("limit", 0),
+	})
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, msgErrors.ErrMessageNotFound):
+			status = fiber.StatusNotFound
+		case errors.Is(err, msgErrors.ErrInvalidCursor),
+			errors.Is(err, msgErrors.ErrConflictingPageCursor):
+			status = fiber.StatusBadRequest
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to get messages",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
 
-	offset := ctx.QueryInt("offset", 0)
+func (h *Handler) search(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
 
-	cursor := ctx.Query("cursor", "")
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "user_id is required")
+	}
 
-	messages, err := h.getMessagesUC.Execute(ctx.Context(), dto.GetRequest{
-		ChatID: chatID,
-		Limit:  limit,
-		Offset: offset,
-		Cursor: cursor,
+	resp, err := h.searchUC.Execute(reqCtx, dto.SearchRequest{
+		UserID:   userID,
+		Query:    ctx.Query("q"),
+		ChatID:   ctx.Query("chat_id"),
+		SenderID: ctx.Query("sender_id"),
+		From:     ctx.Query("from"),
+		To:       ctx.Query("to"),
+		Limit:    ctx.QueryInt("limit", 20),
+		Cursor:   ctx.Query("cursor"),
 	})
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		switch {
+		case errors.Is(err, msgErrors.ErrEmptySearchQuery),
+			errors.Is(err, msgErrors.ErrInvalidCursor),
+			errors.Is(err, msgErrors.ErrInvalidDateRange):
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "Invalid search request",
+				"details": err.Error(),
+			})
+		default:
+			return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "Failed to search messages",
+				"details": err.Error(),
+			})
+		}
 	}
 
-	return ctx.JSON(messages)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
 }
 
-func (h *Handler) getForChatWithFilter(ctx *fiber.Ctx) error {
-	chatID := ctx.Query("chat_id")
-	if chatID == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "chat_id is required")
+func (h *Handler) forward(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.ForwardRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+
+	resp, err := h.forwardUC.Execute(reqCtx, req)
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, chatErrors.ErrNotChatMember),
+			errors.Is(err, chatErrors.ErrPermissionDenied):
+			status = fiber.StatusForbidden
+		case errors.Is(err, msgErrors.ErrForwardSourceMissing):
+			status = fiber.StatusNotFound
+		case errors.Is(err, msgErrors.ErrNothingToForward),
+			errors.Is(err, msgErrors.ErrTooManyForwards),
+			errors.Is(err, msgErrors.ErrTooManyForwardTarget):
+			status = fiber.StatusBadRequest
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to forward messages",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) createPoll(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.CreatePollRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+
+	resp, err := h.createPollUC.Execute(reqCtx, req)
+	if err != nil {
+		return ctx.Status(pollErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Failed to create poll",
+			"details": err.Error(),
+		})
 	}
 
-	limit := ctx.QueryInt("limit", 100)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *Handler) votePoll(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	messageID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid poll id")
+	}
+
+	var req dto.VotePollRequest
+	if err = ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+	req.MessageID = messageID
+
+	resp, err := h.votePollUC.Execute(reqCtx, req)
+	if err != nil {
+		return ctx.Status(pollErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Failed to vote",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) getPoll(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
 
-	offset := ctx.QueryInt("offset", 0)
+	messageID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid poll id")
+	}
 
-	cursor := ctx.QueryInt("cursor", 0)
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "user_id is required")
+	}
 
-	resp, err := h.getForChatWithFilterUC.Execute(ctx.Context(), dto.GetForChatWithFilterRequest{
-		ChatID: chatID,
-		Limit:  limit,
-		Offset: offset,
-		Cursor: cursor,
+	resp, err := h.getPollUC.Execute(reqCtx, dto.GetPollRequest{
+		UserID:    userID,
+		MessageID: messageID,
 	})
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error":   "Failed to get messages",
+		return ctx.Status(pollErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Failed to get poll",
 			"details": err.Error(),
 		})
 	}
 
-	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
-		"messages":    resp.AllMessages,
-		"count":       resp.Count,
-		"last_cursor": resp.LastCursor,
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// sendErrorStatus answers 403 when the sender may not post into the chat, e.g. a channel subscriber.
+func sendErrorStatus(err error) int {
+	if errors.Is(err, chatErrors.ErrNotChatMember) || errors.Is(err, chatErrors.ErrPermissionDenied) {
+		return fiber.StatusForbidden
+	}
+	return fiber.StatusInternalServerError
+}
+
+func pollErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, chatErrors.ErrNotChatMember),
+		errors.Is(err, chatErrors.ErrPermissionDenied):
+		return fiber.StatusForbidden
+	case errors.Is(err, msgErrors.ErrPollNotFound):
+		return fiber.StatusNotFound
+	case errors.Is(err, msgErrors.ErrPollClosed):
+		return fiber.StatusConflict
+	case errors.Is(err, msgErrors.ErrPollQuestionEmpty),
+		errors.Is(err, msgErrors.ErrPollQuestionLong),
+		errors.Is(err, msgErrors.ErrPollOptionsCount),
+		errors.Is(err, msgErrors.ErrPollOptionInvalid),
+		errors.Is(err, msgErrors.ErrPollCloseTime),
+		errors.Is(err, msgErrors.ErrPollInvalidChoice),
+		errors.Is(err, msgErrors.ErrPollSingleChoice):
+		return fiber.StatusBadRequest
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+func (h *Handler) getReceipts(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	messageID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid message id")
+	}
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "user_id is required")
+	}
+
+	resp, err := h.receiptsUC.Execute(reqCtx, dto.GetReceiptsRequest{
+		UserID:    userID,
+		MessageID: messageID,
+	})
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, msgErrors.ErrMessageNotFound):
+			status = fiber.StatusNotFound
+		case errors.Is(err, msgErrors.ErrNotMessageSender):
+			status = fiber.StatusForbidden
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to get message receipts",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) deleteMessage(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	messageID, err := ctx.ParamsInt("id")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid message id")
+	}
+
+	userID, chatID := ctx.Query("user_id"), ctx.Query("chat_id")
+	if userID == "" || chatID == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "user_id and chat_id are required")
+	}
+
+	err = h.deleteUC.Execute(reqCtx, dto.DeleteMessageRequest{
+		UserID:    userID,
+		ChatID:    chatID,
+		MessageID: messageID,
 	})
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		switch {
+		case errors.Is(err, msgErrors.ErrMessageNotFound):
+			status = fiber.StatusNotFound
+		case errors.Is(err, chatErrors.ErrNotChatMember),
+			errors.Is(err, chatErrors.ErrPermissionDenied):
+			status = fiber.StatusForbidden
+		}
+		return ctx.Status(status).JSON(fiber.Map{
+			"error":   "Failed to delete message",
+			"details": err.Error(),
+		})
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
 }
 
 func (h *Handler) RegisterRoutes(router fiber.Router) {
 	router.Post("/message/save", h.Save)
 	router.Post("/message/send", h.Send)
 	router.Post("/message/send-sync", h.SendSync)
-	router.Get("/message", h.GetMessages)
-	router.Get("/message/filter", h.getForChatWithFilter)
+	router.Get("/message", h.getPage)
+	router.Get("/message/search", h.search)
+	router.Post("/message/forward", h.forward)
+	router.Post("/message/poll", h.createPoll)
+	router.Post("/message/poll/:id/vote", h.votePoll)
+	router.Get("/message/poll/:id", h.getPoll)
+	router.Get("/message/:id/receipts", h.getReceipts)
+	router.Delete("/message/:id", h.deleteMessage)
 }
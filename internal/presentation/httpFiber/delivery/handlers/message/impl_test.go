@@ -0,0 +1,196 @@
+package messages
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	chatDto "awesome-chat/internal/application/chat/dto"
+	"awesome-chat/internal/application/message/useCases/send"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	"awesome-chat/internal/domain/core/chat/services/permission"
+	"awesome-chat/internal/domain/core/chat/vo"
+	msgEntity "awesome-chat/internal/domain/core/message/entity"
+	msgCreate "awesome-chat/internal/domain/core/message/services/entity"
+	outboxEntity "awesome-chat/internal/domain/core/shared/outbox/entity"
+	outboxCreate "awesome-chat/internal/domain/core/shared/outbox/services/entity"
+	sharedPorts "awesome-chat/internal/domain/core/shared/ports"
+)
+
+type roleStore map[uuid.UUID]vo.Role
+
+func (s roleStore) Role(_ context.Context, _ uuid.UUID, userID uuid.UUID) (vo.Role, error) {
+	role, ok := s[userID]
+	if !ok {
+		return "", chatErrors.ErrNotChatMember
+	}
+	return role, nil
+}
+
+func (s roleStore) SetRole(_ context.Context, _ uuid.UUID, userID uuid.UUID, role vo.Role) error {
+	s[userID] = role
+	return nil
+}
+
+type txManager struct {
+	sharedPorts.TransactionManager
+}
+
+func (txManager) BeginAndInjectTx(ctx context.Context) (context.Context, error) { return ctx, nil }
+func (txManager) RollbackTx(context.Context) error                              { return nil }
+func (txManager) CommitTx(context.Context) error                                { return nil }
+
+type messageRepo struct {
+	mu    sync.Mutex
+	saved []msgEntity.OldMessage
+}
+
+func (r *messageRepo) Save(_ context.Context, m msgEntity.OldMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.saved = append(r.saved, m)
+	return nil
+}
+
+func (r *messageRepo) SaveBatchFast(context.Context, []msgEntity.Message) error { return nil }
+
+type outboxRepo struct{}
+
+func (outboxRepo) Save(context.Context, outboxEntity.Outbox) error { return nil }
+
+type previews struct {
+	mu      sync.Mutex
+	chatIDs []string
+}
+
+func (p *previews) Execute(_ context.Context, req chatDto.InvalidatePreviewsRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.chatIDs = append(p.chatIDs, req.ChatIDs...)
+	return nil
+}
+
+func newSendApp(t *testing.T, roles roleStore) (*fiber.App, *messageRepo, *previews) {
+	t.Helper()
+
+	repo, dropped := &messageRepo{}, &previews{}
+	sendUC := send.NewUseCase(
+		slog.Default(),
+		new(msgCreate.Create),
+		new(outboxCreate.Create),
+		txManager{},
+		repo,
+		outboxRepo{},
+		permission.NewChecker(roles),
+		dropped,
+	)
+	t.Cleanup(func() { _ = sendUC.Shutdown(context.Background()) })
+
+	app := fiber.New()
+	NewMessageHandler(nil, sendUC, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).RegisterRoutes(app)
+
+	return app, repo, dropped
+}
+
+func postSend(t *testing.T, app *fiber.App, chatID, userID uuid.UUID) int {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]string{
+		"user_id": userID.String(),
+		"chat_id": chatID.String(),
+		"content": "hello",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/message/send", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	return resp.StatusCode
+}
+
+func TestSend_RequiresSendPermission(t *testing.T) {
+	chatID := uuid.New()
+	member, readOnly, outsider := uuid.New(), uuid.New(), uuid.New()
+
+	app, repo, _ := newSendApp(t, roleStore{
+		member:   vo.RoleMember,
+		readOnly: vo.RoleReadOnly,
+	})
+
+	tests := []struct {
+		name     string
+		userID   uuid.UUID
+		expected int
+	}{
+		{"Member posts", member, fiber.StatusAccepted},
+		{"Read only member is rejected", readOnly, fiber.StatusForbidden},
+		{"Outsider is rejected", outsider, fiber.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := postSend(t, app, chatID, tt.userID); status != tt.expected {
+				t.Errorf("Expected status %d, got %d", tt.expected, status)
+			}
+		})
+	}
+
+	if len(repo.saved) != 1 || repo.saved[0].UserID != member.String() {
+		t.Errorf("Expected only the member's message to be saved, got %+v", repo.saved)
+	}
+}
+
+func TestSend_ChannelSubscriberIsRejected(t *testing.T) {
+	chatID := uuid.New()
+	admin, subscriber := uuid.New(), uuid.New()
+
+	app, repo, _ := newSendApp(t, roleStore{
+		admin:      vo.RoleAdmin,
+		subscriber: vo.ChatTypeChannel.JoinRole(),
+	})
+
+	if status := postSend(t, app, chatID, subscriber); status != fiber.StatusForbidden {
+		t.Errorf("Expected subscriber to get status %d, got %d", fiber.StatusForbidden, status)
+	}
+	if status := postSend(t, app, chatID, admin); status != fiber.StatusAccepted {
+		t.Errorf("Expected admin to get status %d, got %d", fiber.StatusAccepted, status)
+	}
+
+	if len(repo.saved) != 1 || repo.saved[0].UserID != admin.String() {
+		t.Errorf("Expected only the admin's post to be saved, got %+v", repo.saved)
+	}
+}
+
+func TestSend_DropsPreviewsOfTheChat(t *testing.T) {
+	chatID := uuid.New()
+	member, readOnly := uuid.New(), uuid.New()
+
+	app, _, dropped := newSendApp(t, roleStore{
+		member:   vo.RoleMember,
+		readOnly: vo.RoleReadOnly,
+	})
+
+	if status := postSend(t, app, chatID, readOnly); status != fiber.StatusForbidden {
+		t.Fatalf("Expected status %d, got %d", fiber.StatusForbidden, status)
+	}
+	if len(dropped.chatIDs) != 0 {
+		t.Fatalf("Expected a rejected message to keep the previews, got %v", dropped.chatIDs)
+	}
+
+	if status := postSend(t, app, chatID, member); status != fiber.StatusAccepted {
+		t.Fatalf("Expected status %d, got %d", fiber.StatusAccepted, status)
+	}
+	if len(dropped.chatIDs) != 1 || dropped.chatIDs[0] != chatID.String() {
+		t.Errorf("Expected previews of chat %s to be dropped, got %v", chatID, dropped.chatIDs)
+	}
+}
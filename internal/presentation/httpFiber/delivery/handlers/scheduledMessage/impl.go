@@ -0,0 +1,163 @@
+package scheduledMessage
+
+import (
+	"awesome-chat/internal/application/message/dto"
+	chatErrors "awesome-chat/internal/domain/core/chat/errors"
+	msgErrors "awesome-chat/internal/domain/core/message/errors"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type (
+	scheduleUseCase interface {
+		Execute(ctx context.Context, req dto.ScheduleRequest) (dto.ScheduledMessage, error)
+	}
+	listScheduledUseCase interface {
+		Execute(ctx context.Context, req dto.ListScheduledRequest) (dto.ScheduledMessages, error)
+	}
+	editScheduledUseCase interface {
+		Execute(ctx context.Context, req dto.EditScheduledRequest) (dto.ScheduledMessage, error)
+	}
+	cancelScheduledUseCase interface {
+		Execute(ctx context.Context, req dto.CancelScheduledRequest) error
+	}
+)
+
+type Handler struct {
+	scheduleUC        scheduleUseCase
+	listScheduledUC   listScheduledUseCase
+	editScheduledUC   editScheduledUseCase
+	cancelScheduledUC cancelScheduledUseCase
+}
+
+func NewScheduledMessageHandler(
+	scheduleUC scheduleUseCase,
+	listScheduledUC listScheduledUseCase,
+	editScheduledUC editScheduledUseCase,
+	cancelScheduledUC cancelScheduledUseCase,
+) *Handler {
+	return &Handler{
+		scheduleUC:        scheduleUC,
+		listScheduledUC:   listScheduledUC,
+		editScheduledUC:   editScheduledUC,
+		cancelScheduledUC: cancelScheduledUC,
+	}
+}
+
+func (h *Handler) schedule(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.ScheduleRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+
+	resp, err := h.scheduleUC.Execute(reqCtx, req)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *Handler) list(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	resp, err := h.listScheduledUC.Execute(reqCtx, dto.ListScheduledRequest{
+		UserID: userID,
+		ChatID: ctx.Query("chat_id"),
+	})
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) edit(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	var req dto.EditScheduledRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request format",
+			"details": err.Error(),
+		})
+	}
+	req.ID = ctx.Params("id")
+
+	resp, err := h.editScheduledUC.Execute(reqCtx, req)
+	if err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *Handler) cancel(ctx *fiber.Ctx) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := ctx.Query("user_id")
+	if userID == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "user_id is required",
+		})
+	}
+
+	if err := h.cancelScheduledUC.Execute(reqCtx, dto.CancelScheduledRequest{
+		ID:     ctx.Params("id"),
+		UserID: userID,
+	}); err != nil {
+		return errorResponse(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func errorResponse(ctx *fiber.Ctx, err error) error {
+	status := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, msgErrors.ErrScheduledNotFound):
+		status = fiber.StatusNotFound
+	case errors.Is(err, msgErrors.ErrScheduledAlreadyProcessed):
+		status = fiber.StatusConflict
+	case errors.Is(err, chatErrors.ErrNotChatMember),
+		errors.Is(err, chatErrors.ErrPermissionDenied):
+		status = fiber.StatusForbidden
+	case errors.Is(err, msgErrors.ErrEmptyContent),
+		errors.Is(err, msgErrors.ErrInvalidScheduleTime),
+		errors.Is(err, msgErrors.ErrScheduleInPast),
+		errors.Is(err, msgErrors.ErrScheduleTooFar),
+		errors.Is(err, msgErrors.ErrNothingToUpdate):
+		status = fiber.StatusBadRequest
+	}
+
+	return ctx.Status(status).JSON(fiber.Map{
+		"error":   "Scheduled message request failed",
+		"details": err.Error(),
+	})
+}
+
+func (h *Handler) RegisterRoutes(router fiber.Router) {
+	router.Post("/message/scheduled", h.schedule)
+	router.Get("/message/scheduled", h.list)
+	router.Patch("/message/scheduled/:id", h.edit)
+	router.Delete("/message/scheduled/:id", h.cancel)
+}
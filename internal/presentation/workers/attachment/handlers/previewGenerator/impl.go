@@ -0,0 +1,77 @@
+package previewGenerator
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+	"time"
+)
+
+const (
+	pollInterval = 2 * time.Second
+	jobTimeout   = time.Minute
+)
+
+type useCase interface {
+	Execute(ctx context.Context) (bool, error)
+}
+
+// Handler polls for uploaded images without previews and processes them one by one.
+type Handler struct {
+	log          appPorts.Logger
+	uc           useCase
+	pollInterval time.Duration
+}
+
+func NewHandler(
+	log appPorts.Logger,
+	uc useCase,
+) *Handler {
+	return &Handler{
+		log:          log,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+func (h *Handler) Start(ctx context.Context) error {
+	const op = "attachment.previewGenerator.Handler.Start"
+	withFields := func(args ...any) []any {
+		return append([]any{"operation", op}, args...)
+	}
+
+	h.log.Info("Starting previewGenerator...", withFields()...)
+	defer h.log.Info("PreviewGenerator stopped", withFields()...)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.drain(ctx, withFields)
+		}
+	}
+}
+
+// drain keeps processing while there is work so a burst of uploads does not wait a tick per image.
+func (h *Handler) drain(ctx context.Context, withFields func(args ...any) []any) {
+	for ctx.Err() == nil {
+		jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+		processed, err := h.uc.Execute(jobCtx)
+		cancel()
+
+		if err != nil {
+			h.log.Error("Failed to process previews", withFields("error", err.Error())...)
+			return
+		}
+		if !processed {
+			return
+		}
+	}
+}
+
+func (h *Handler) Stop(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,76 @@
+package flusher
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+	"time"
+)
+
+const (
+	pollInterval = time.Second
+	runTimeout   = 30 * time.Second
+)
+
+type useCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Handler writes drafts from the redis cache to postgres once users pause typing.
+type Handler struct {
+	log          appPorts.Logger
+	uc           useCase
+	pollInterval time.Duration
+}
+
+func NewHandler(
+	log appPorts.Logger,
+	uc useCase,
+) *Handler {
+	return &Handler{
+		log:          log,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+func (h *Handler) Start(ctx context.Context) error {
+	const op = "draft.flusher.Handler.Start"
+	withFields := func(args ...any) []any {
+		return append([]any{"operation", op}, args...)
+	}
+
+	h.log.Info("Starting draft flusher...", withFields()...)
+	defer h.log.Info("Draft flusher stopped", withFields()...)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.drain(ctx, withFields)
+		}
+	}
+}
+
+func (h *Handler) drain(ctx context.Context, withFields func(args ...any) []any) {
+	for ctx.Err() == nil {
+		runCtx, cancel := context.WithTimeout(ctx, runTimeout)
+		flushed, err := h.uc.Execute(runCtx)
+		cancel()
+
+		if err != nil {
+			h.log.Error("Failed to flush drafts", withFields("error", err.Error())...)
+			return
+		}
+		if flushed == 0 {
+			return
+		}
+	}
+}
+
+func (h *Handler) Stop(_ context.Context) error {
+	return nil
+}
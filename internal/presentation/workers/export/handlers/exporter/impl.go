@@ -0,0 +1,78 @@
+package exporter
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+	"time"
+)
+
+const (
+	pollInterval = 5 * time.Second
+	// large chats take a while, the claim timeout of the store outlasts it
+	jobTimeout = 30 * time.Minute
+)
+
+type useCase interface {
+	Execute(ctx context.Context) (bool, error)
+}
+
+// Handler polls for queued chat exports and runs them one by one.
+type Handler struct {
+	log          appPorts.Logger
+	uc           useCase
+	pollInterval time.Duration
+}
+
+func NewHandler(
+	log appPorts.Logger,
+	uc useCase,
+) *Handler {
+	return &Handler{
+		log:          log,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+func (h *Handler) Start(ctx context.Context) error {
+	const op = "export.exporter.Handler.Start"
+	withFields := func(args ...any) []any {
+		return append([]any{"operation", op}, args...)
+	}
+
+	h.log.Info("Starting exporter...", withFields()...)
+	defer h.log.Info("Exporter stopped", withFields()...)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.drain(ctx, withFields)
+		}
+	}
+}
+
+// drain keeps running exports while there are queued ones.
+func (h *Handler) drain(ctx context.Context, withFields func(args ...any) []any) {
+	for ctx.Err() == nil {
+		jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+		processed, err := h.uc.Execute(jobCtx)
+		cancel()
+
+		if err != nil {
+			h.log.Error("Failed to run chat export", withFields("error", err.Error())...)
+			return
+		}
+		if !processed {
+			return
+		}
+	}
+}
+
+func (h *Handler) Stop(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,76 @@
+package unfurler
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+	"time"
+)
+
+const (
+	pollInterval = time.Second
+	batchTimeout = 30 * time.Second
+)
+
+type useCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Handler polls for saved messages with links and unfurls them in batches.
+type Handler struct {
+	log          appPorts.Logger
+	uc           useCase
+	pollInterval time.Duration
+}
+
+func NewHandler(
+	log appPorts.Logger,
+	uc useCase,
+) *Handler {
+	return &Handler{
+		log:          log,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+func (h *Handler) Start(ctx context.Context) error {
+	const op = "linkPreview.unfurler.Handler.Start"
+	withFields := func(args ...any) []any {
+		return append([]any{"operation", op}, args...)
+	}
+
+	h.log.Info("Starting unfurler...", withFields()...)
+	defer h.log.Info("Unfurler stopped", withFields()...)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.drain(ctx, withFields)
+		}
+	}
+}
+
+func (h *Handler) drain(ctx context.Context, withFields func(args ...any) []any) {
+	for ctx.Err() == nil {
+		batchCtx, cancel := context.WithTimeout(ctx, batchTimeout)
+		claimed, err := h.uc.Execute(batchCtx)
+		cancel()
+
+		if err != nil {
+			h.log.Error("Failed to unfurl links", withFields("error", err.Error())...)
+			return
+		}
+		if claimed == 0 {
+			return
+		}
+	}
+}
+
+func (h *Handler) Stop(_ context.Context) error {
+	return nil
+}
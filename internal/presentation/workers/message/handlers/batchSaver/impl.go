@@ -1,6 +1,7 @@
 package batchSaver
 
 import (
+	chatDto "awesome-chat/internal/application/chat/dto"
 	appPorts "awesome-chat/internal/domain/app/ports"
 	"awesome-chat/internal/domain/core/message/ports/store"
 	"awesome-chat/internal/domain/core/message/ports/worker"
@@ -15,12 +16,17 @@ const (
 	flushInterval = time.Second * 3
 )
 
+type previewInvalidator interface {
+	Execute(ctx context.Context, req chatDto.InvalidatePreviewsRequest) error
+}
+
 type Handler struct {
 	log           appPorts.Logger
 	ackWritePipe  worker.MessagePipe[string]
 	saverReadPipe worker.MessagePipe[vo.StreamMessage]
 	saverStore    store.SaveFromStreamStore
 	ackPipeTx     worker.AckPipeTx
+	previews      previewInvalidator
 	batchSize     int
 	flushInterval time.Duration
 }
@@ -31,6 +37,7 @@ func NewHandler(
 	saverPipe worker.MessagePipe[vo.StreamMessage],
 	saverStore store.SaveFromStreamStore,
 	ackPipeTx worker.AckPipeTx,
+	previews previewInvalidator,
 ) *Handler {
 	return &Handler{
 		log:           log,
@@ -38,6 +45,7 @@ func NewHandler(
 		saverReadPipe: saverPipe,
 		saverStore:    saverStore,
 		ackPipeTx:     ackPipeTx,
+		previews:      previews,
 		batchSize:     batchSize,
 		flushInterval: flushInterval,
 	}
@@ -62,9 +70,19 @@ func (h *Handler) Start(ctx context.Context) error {
 			return nil
 		}
 
-		if err := h.saverStore.SaveBatch(ctx, batch); err != nil {
+		collided, err := h.saverStore.SaveBatch(ctx, batch)
+		if err != nil {
 			return fmt.Errorf("%s: batch save failed: %w", op, err)
 		}
+		// retrying would not free the ids, the messages are reported and acked with the rest
+		if len(collided) > 0 {
+			h.log.Error("Messages dropped on id collision", withFields("ids", collided)...)
+		}
+
+		// one drop covers every chat of the batch, a failed one does not hold back the acks
+		if err := h.previews.Execute(ctx, chatDto.InvalidatePreviewsRequest{ChatIDs: vo.StreamChatIDs(batch)}); err != nil {
+			h.log.Error("Failed to invalidate previews", withFields("error", err.Error())...)
+		}
 
 		for _, msg := range batch {
 			select {
@@ -0,0 +1,76 @@
+package mentionNotifier
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+	"time"
+)
+
+const (
+	pollInterval = time.Second
+	runTimeout   = 30 * time.Second
+)
+
+type useCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Handler sends mention notifications for mentions the batch saver has stored.
+type Handler struct {
+	log          appPorts.Logger
+	uc           useCase
+	pollInterval time.Duration
+}
+
+func NewHandler(
+	log appPorts.Logger,
+	uc useCase,
+) *Handler {
+	return &Handler{
+		log:          log,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+func (h *Handler) Start(ctx context.Context) error {
+	const op = "message.mentionNotifier.Handler.Start"
+	withFields := func(args ...any) []any {
+		return append([]any{"operation", op}, args...)
+	}
+
+	h.log.Info("Starting mention notifier...", withFields()...)
+	defer h.log.Info("Mention notifier stopped", withFields()...)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.drain(ctx, withFields)
+		}
+	}
+}
+
+func (h *Handler) drain(ctx context.Context, withFields func(args ...any) []any) {
+	for ctx.Err() == nil {
+		runCtx, cancel := context.WithTimeout(ctx, runTimeout)
+		notified, err := h.uc.Execute(runCtx)
+		cancel()
+
+		if err != nil {
+			h.log.Error("Failed to notify mentions", withFields("error", err.Error())...)
+			return
+		}
+		if notified == 0 {
+			return
+		}
+	}
+}
+
+func (h *Handler) Stop(_ context.Context) error {
+	return nil
+}
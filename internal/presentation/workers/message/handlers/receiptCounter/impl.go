@@ -0,0 +1,76 @@
+package receiptCounter
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+	"time"
+)
+
+const (
+	pollInterval = time.Second
+	runTimeout   = 30 * time.Second
+)
+
+type useCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Handler redoes the receipt counts of large chats whose members moved their cursors.
+type Handler struct {
+	log          appPorts.Logger
+	uc           useCase
+	pollInterval time.Duration
+}
+
+func NewHandler(
+	log appPorts.Logger,
+	uc useCase,
+) *Handler {
+	return &Handler{
+		log:          log,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+func (h *Handler) Start(ctx context.Context) error {
+	const op = "message.receiptCounter.Handler.Start"
+	withFields := func(args ...any) []any {
+		return append([]any{"operation", op}, args...)
+	}
+
+	h.log.Info("Starting receipt counter...", withFields()...)
+	defer h.log.Info("Receipt counter stopped", withFields()...)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.drain(ctx, withFields)
+		}
+	}
+}
+
+func (h *Handler) drain(ctx context.Context, withFields func(args ...any) []any) {
+	for ctx.Err() == nil {
+		runCtx, cancel := context.WithTimeout(ctx, runTimeout)
+		counted, err := h.uc.Execute(runCtx)
+		cancel()
+
+		if err != nil {
+			h.log.Error("Failed to count receipts", withFields("error", err.Error())...)
+			return
+		}
+		if counted == 0 {
+			return
+		}
+	}
+}
+
+func (h *Handler) Stop(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"context"
+	"time"
+)
+
+const (
+	pollInterval = time.Second
+	runTimeout   = 30 * time.Second
+)
+
+type useCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// Handler polls for due scheduled messages and sends them in batches.
+type Handler struct {
+	log          appPorts.Logger
+	uc           useCase
+	pollInterval time.Duration
+}
+
+func NewHandler(
+	log appPorts.Logger,
+	uc useCase,
+) *Handler {
+	return &Handler{
+		log:          log,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+func (h *Handler) Start(ctx context.Context) error {
+	const op = "message.scheduler.Handler.Start"
+	withFields := func(args ...any) []any {
+		return append([]any{"operation", op}, args...)
+	}
+
+	h.log.Info("Starting scheduler...", withFields()...)
+	defer h.log.Info("Scheduler stopped", withFields()...)
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			h.drain(ctx, withFields)
+		}
+	}
+}
+
+// drain keeps sending while whole batches come back, a popular minute should not wait a tick per batch.
+func (h *Handler) drain(ctx context.Context, withFields func(args ...any) []any) {
+	for ctx.Err() == nil {
+		runCtx, cancel := context.WithTimeout(ctx, runTimeout)
+		sent, err := h.uc.Execute(runCtx)
+		cancel()
+
+		if err != nil {
+			h.log.Error("Failed to dispatch scheduled messages", withFields("error", err.Error())...)
+			return
+		}
+		if sent == 0 {
+			return
+		}
+	}
+}
+
+func (h *Handler) Stop(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,61 @@
+package event
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+)
+
+type useCase interface {
+	Execute(ctx context.Context, payload []byte) error
+}
+
+// ChatEventBroadcastHandler delivers chat events published by other services to the clients of this node.
+type ChatEventBroadcastHandler struct {
+	log appPorts.Logger
+	sub ports.Subscriber
+	uc  useCase
+}
+
+func NewChatEventBroadcastHandler(
+	log appPorts.Logger,
+	sub ports.Subscriber,
+	uc useCase,
+) *ChatEventBroadcastHandler {
+	return &ChatEventBroadcastHandler{
+		log: log,
+		sub: sub,
+		uc:  uc,
+	}
+}
+
+func (h *ChatEventBroadcastHandler) Start(ctx context.Context) error {
+	const op = "event.ChatEventBroadcastHandler.Start"
+
+	ch, err := h.sub.GetSubChannel(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	h.log.Info("Starting chat event broadcaster...", "operation", op)
+	defer h.log.Info("Chat event broadcaster stopped", "operation", op)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case payload, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err = h.uc.Execute(ctx, payload); err != nil {
+				h.log.Error("chat event broadcast error", "operation", op, "error", err.Error())
+			}
+		}
+	}
+}
+
+func (h *ChatEventBroadcastHandler) Stop(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,58 @@
+package event
+
+import (
+	appPorts "awesome-chat/internal/domain/app/ports"
+	"awesome-chat/internal/domain/core/shared/ports"
+	"context"
+	"fmt"
+)
+
+// PreviewInvalidateHandler drops the cached preview lists chat events make stale.
+// One instance is enough for the whole deployment, it runs in the worker.
+type PreviewInvalidateHandler struct {
+	log appPorts.Logger
+	sub ports.Subscriber
+	uc  useCase
+}
+
+func NewPreviewInvalidateHandler(
+	log appPorts.Logger,
+	sub ports.Subscriber,
+	uc useCase,
+) *PreviewInvalidateHandler {
+	return &PreviewInvalidateHandler{
+		log: log,
+		sub: sub,
+		uc:  uc,
+	}
+}
+
+func (h *PreviewInvalidateHandler) Start(ctx context.Context) error {
+	const op = "event.PreviewInvalidateHandler.Start"
+
+	ch, err := h.sub.GetSubChannel(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	h.log.Info("Starting preview invalidator...", "operation", op)
+	defer h.log.Info("Preview invalidator stopped", "operation", op)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case payload, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err = h.uc.Execute(ctx, payload); err != nil {
+				h.log.Error("preview invalidation error", "operation", op, "error", err.Error())
+			}
+		}
+	}
+}
+
+func (h *PreviewInvalidateHandler) Stop(_ context.Context) error {
+	return nil
+}
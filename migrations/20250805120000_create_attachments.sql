@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY,
+    message_id BIGINT REFERENCES messages(id) ON DELETE CASCADE, -- NULL until upload is completed
+    uploader_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    chat_id UUID NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL, -- 'image', 'video', 'audio', 'file'
+    object_key VARCHAR(512) NOT NULL UNIQUE,
+    file_name VARCHAR(255) NOT NULL,
+    mime_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum CHAR(64) NOT NULL, -- sha256 hex
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'uploaded'
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    uploaded_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_message_id ON attachments(message_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_chat_id ON attachments(chat_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TABLE IF EXISTS attachments;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+ALTER TABLE attachments
+    ADD COLUMN IF NOT EXISTS preview_status VARCHAR(20), -- NULL for non-images, 'pending', 'ready', 'failed'
+    ADD COLUMN IF NOT EXISTS preview_attempts INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS width INT,
+    ADD COLUMN IF NOT EXISTS height INT,
+    ADD COLUMN IF NOT EXISTS blurhash VARCHAR(128),
+    ADD COLUMN IF NOT EXISTS previews JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_attachments_preview_pending
+    ON attachments(uploaded_at)
+    WHERE preview_status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP INDEX IF EXISTS idx_attachments_preview_pending;
+
+ALTER TABLE attachments
+    DROP COLUMN IF EXISTS previews,
+    DROP COLUMN IF EXISTS blurhash,
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width,
+    DROP COLUMN IF EXISTS preview_attempts,
+    DROP COLUMN IF EXISTS preview_status;
+-- +goose StatementEnd
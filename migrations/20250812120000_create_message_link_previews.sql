@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- generated so every save path, COPY included, is picked up by the unfurler
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS has_links BOOLEAN
+        GENERATED ALWAYS AS (coalesce(content, '') ~* 'https?://') STORED;
+
+CREATE INDEX IF NOT EXISTS idx_messages_has_links_created_at
+    ON messages(created_at)
+    WHERE has_links;
+
+CREATE TABLE IF NOT EXISTS message_link_previews (
+    message_id BIGINT PRIMARY KEY REFERENCES messages(id) ON DELETE CASCADE,
+    chat_id UUID NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    url TEXT,
+    title TEXT,
+    description TEXT,
+    image_url TEXT,
+    site_name TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'ready', 'failed'
+    claimed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    fetched_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_link_previews_pending
+    ON message_link_previews(claimed_at)
+    WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TABLE IF EXISTS message_link_previews;
+DROP INDEX IF EXISTS idx_messages_has_links_created_at;
+ALTER TABLE messages DROP COLUMN IF EXISTS has_links;
+-- +goose StatementEnd
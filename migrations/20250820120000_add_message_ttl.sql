@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS message_ttl_seconds INT CHECK (message_ttl_seconds > 0); -- NULL keeps messages forever
+
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_messages_expires_at
+    ON messages(expires_at)
+    WHERE expires_at IS NOT NULL;
+
+-- the chat TTL is an upper bound: a message may ask to go sooner, never later
+CREATE OR REPLACE FUNCTION messages_apply_chat_ttl() RETURNS trigger AS $$
+DECLARE
+    ttl INT;
+BEGIN
+    SELECT message_ttl_seconds INTO ttl FROM chats WHERE id = NEW.chat_id;
+    IF ttl IS NOT NULL THEN
+        NEW.expires_at := LEAST(NEW.expires_at, NOW() + make_interval(secs => ttl));
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_messages_apply_chat_ttl ON messages;
+CREATE TRIGGER trg_messages_apply_chat_ttl
+    BEFORE INSERT ON messages
+    FOR EACH ROW EXECUTE FUNCTION messages_apply_chat_ttl();
+
+-- purging a message must not be blocked by replies to it
+ALTER TABLE messages DROP CONSTRAINT IF EXISTS messages_reply_to_id_fkey;
+ALTER TABLE messages
+    ADD CONSTRAINT messages_reply_to_id_fkey
+    FOREIGN KEY (reply_to_id) REFERENCES messages(id) ON DELETE SET NULL;
+
+-- audio_url holds a presigned URL, the sweeper needs the object itself
+ALTER TABLE voice_messages
+    ADD COLUMN IF NOT EXISTS object_key VARCHAR(512);
+
+UPDATE voice_messages
+SET object_key = substring(audio_url FROM '/([0-9a-f-]{36})(\?|$)')
+WHERE object_key IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+ALTER TABLE voice_messages DROP COLUMN IF EXISTS object_key;
+DROP TRIGGER IF EXISTS trg_messages_apply_chat_ttl ON messages;
+DROP FUNCTION IF EXISTS messages_apply_chat_ttl();
+DROP INDEX IF EXISTS idx_messages_expires_at;
+ALTER TABLE messages DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE chats DROP COLUMN IF EXISTS message_ttl_seconds;
+-- +goose StatementEnd
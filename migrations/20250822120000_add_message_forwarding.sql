@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- forwarded_from_message_id is the message the copy was made from,
+-- user and chat always name the original author, also for forwards of forwards
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS forwarded_from_message_id BIGINT REFERENCES messages(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS forwarded_from_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS forwarded_from_chat_id UUID REFERENCES chats(id) ON DELETE SET NULL;
+
+-- forwarded attachments point at the same objects
+ALTER TABLE attachments DROP CONSTRAINT IF EXISTS attachments_object_key_key;
+CREATE INDEX IF NOT EXISTS idx_attachments_object_key ON attachments(object_key);
+CREATE INDEX IF NOT EXISTS idx_voice_messages_object_key ON voice_messages(object_key);
+
+-- messages come in through COPY as well, so the copy of the media rows lives in the database
+CREATE OR REPLACE FUNCTION messages_forward_type() RETURNS trigger AS $$
+BEGIN
+    SELECT message_type INTO NEW.message_type FROM messages WHERE id = NEW.forwarded_from_message_id;
+    NEW.message_type := COALESCE(NEW.message_type, 'text');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION messages_forward_media() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO attachments (
+        id, message_id, uploader_id, chat_id, kind, object_key, file_name, mime_type,
+        size_bytes, checksum, status, uploaded_at,
+        preview_status, preview_attempts, width, height, blurhash, previews
+    )
+    SELECT
+        gen_random_uuid(), NEW.id, NEW.user_id, NEW.chat_id, kind, object_key, file_name, mime_type,
+        size_bytes, checksum, status, uploaded_at,
+        preview_status, preview_attempts, width, height, blurhash, previews
+    FROM attachments
+    WHERE message_id = NEW.forwarded_from_message_id;
+
+    INSERT INTO voice_messages (message_id, audio_url, object_key, duration, waveform)
+    SELECT NEW.id, audio_url, object_key, duration, waveform
+    FROM voice_messages
+    WHERE message_id = NEW.forwarded_from_message_id;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_messages_forward_type ON messages;
+CREATE TRIGGER trg_messages_forward_type
+    BEFORE INSERT ON messages
+    FOR EACH ROW
+    WHEN (NEW.forwarded_from_message_id IS NOT NULL)
+    EXECUTE FUNCTION messages_forward_type();
+
+DROP TRIGGER IF EXISTS trg_messages_forward_media ON messages;
+CREATE TRIGGER trg_messages_forward_media
+    AFTER INSERT ON messages
+    FOR EACH ROW
+    WHEN (NEW.forwarded_from_message_id IS NOT NULL)
+    EXECUTE FUNCTION messages_forward_media();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TRIGGER IF EXISTS trg_messages_forward_media ON messages;
+DROP TRIGGER IF EXISTS trg_messages_forward_type ON messages;
+DROP FUNCTION IF EXISTS messages_forward_media();
+DROP FUNCTION IF EXISTS messages_forward_type();
+DROP INDEX IF EXISTS idx_voice_messages_object_key;
+DROP INDEX IF EXISTS idx_attachments_object_key;
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS forwarded_from_chat_id,
+    DROP COLUMN IF EXISTS forwarded_from_user_id,
+    DROP COLUMN IF EXISTS forwarded_from_message_id;
+-- +goose StatementEnd
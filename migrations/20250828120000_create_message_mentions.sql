@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- 'ignore' drops mentions of non-members, 'reject' refuses the message
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS mention_policy VARCHAR(10) NOT NULL DEFAULT 'ignore';
+
+-- the saver parses mentions out of the content, the database resolves them to members
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS mentioned_usernames TEXT[],
+    ADD COLUMN IF NOT EXISTS mentions_all BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS message_mentions (
+    message_id BIGINT NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    chat_id UUID NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    is_all BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    notified_at TIMESTAMPTZ,
+    read_at TIMESTAMPTZ,
+    PRIMARY KEY (message_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_message_mentions_user_id
+    ON message_mentions(user_id, message_id DESC);
+CREATE INDEX IF NOT EXISTS idx_message_mentions_unread
+    ON message_mentions(user_id, chat_id)
+    WHERE read_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_message_mentions_unnotified
+    ON message_mentions(created_at)
+    WHERE notified_at IS NULL;
+
+CREATE OR REPLACE FUNCTION messages_store_mentions() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO message_mentions (message_id, chat_id, user_id, is_all)
+    SELECT
+        NEW.id,
+        NEW.chat_id,
+        u.id,
+        NEW.mentions_all AND NOT (lower(u.username) = ANY(COALESCE(NEW.mentioned_usernames, '{}')))
+    FROM user_chats uc
+    JOIN users u ON u.id = uc.user_id
+    WHERE uc.chat_id = NEW.chat_id
+        AND uc.user_id <> NEW.user_id
+        AND (NEW.mentions_all OR lower(u.username) = ANY(NEW.mentioned_usernames))
+    ON CONFLICT DO NOTHING;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_messages_store_mentions ON messages;
+CREATE TRIGGER trg_messages_store_mentions
+    AFTER INSERT ON messages
+    FOR EACH ROW
+    WHEN (NEW.mentions_all OR cardinality(NEW.mentioned_usernames) > 0)
+    EXECUTE FUNCTION messages_store_mentions();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TRIGGER IF EXISTS trg_messages_store_mentions ON messages;
+DROP FUNCTION IF EXISTS messages_store_mentions();
+DROP TABLE IF EXISTS message_mentions;
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS mentions_all,
+    DROP COLUMN IF EXISTS mentioned_usernames;
+ALTER TABLE chats DROP COLUMN IF EXISTS mention_policy;
+-- +goose StatementEnd
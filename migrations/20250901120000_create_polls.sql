@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- a poll is a message of type 'poll', the content holds the question
+CREATE TABLE IF NOT EXISTS polls (
+    message_id BIGINT PRIMARY KEY REFERENCES messages(id) ON DELETE CASCADE,
+    multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+    anonymous BOOLEAN NOT NULL DEFAULT FALSE,
+    closes_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS poll_options (
+    poll_id BIGINT NOT NULL REFERENCES polls(message_id) ON DELETE CASCADE,
+    position SMALLINT NOT NULL,
+    text TEXT NOT NULL,
+    PRIMARY KEY (poll_id, position)
+);
+
+CREATE TABLE IF NOT EXISTS poll_votes (
+    poll_id BIGINT NOT NULL,
+    position SMALLINT NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    voted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (poll_id, user_id, position),
+    FOREIGN KEY (poll_id, position) REFERENCES poll_options(poll_id, position) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_poll_votes_option ON poll_votes(poll_id, position);
+
+-- the poll stays in its chat, a forward carries the question as text
+CREATE OR REPLACE FUNCTION messages_forward_type() RETURNS trigger AS $$
+BEGIN
+    SELECT message_type INTO NEW.message_type FROM messages WHERE id = NEW.forwarded_from_message_id;
+    IF NEW.message_type IS NULL OR NEW.message_type = 'poll' THEN
+        NEW.message_type := 'text';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+CREATE OR REPLACE FUNCTION messages_forward_type() RETURNS trigger AS $$
+BEGIN
+    SELECT message_type INTO NEW.message_type FROM messages WHERE id = NEW.forwarded_from_message_id;
+    NEW.message_type := COALESCE(NEW.message_type, 'text');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS poll_votes;
+DROP TABLE IF EXISTS poll_options;
+DROP TABLE IF EXISTS polls;
+-- +goose StatementEnd
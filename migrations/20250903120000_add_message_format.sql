@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- content holds the plain text, entities point into it by code point offsets
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS format VARCHAR(10) NOT NULL DEFAULT 'plain'
+        CHECK (format IN ('plain', 'markdown')),
+    ADD COLUMN IF NOT EXISTS entities JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS entities,
+    DROP COLUMN IF EXISTS format;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- every message of the chat sent up to a cursor is delivered to or read by the member
+ALTER TABLE user_chats
+    ADD COLUMN IF NOT EXISTS delivered_until TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS read_until TIMESTAMP;
+
+-- chats above the receipt threshold keep counts per message instead of sharing member cursors
+CREATE TABLE IF NOT EXISTS message_receipt_counts (
+    message_id BIGINT PRIMARY KEY REFERENCES messages(id) ON DELETE CASCADE,
+    chat_id UUID NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    delivered_count INT NOT NULL DEFAULT 0,
+    read_count INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- counts of messages sent after since are behind the cursors
+CREATE TABLE IF NOT EXISTS receipt_count_queue (
+    chat_id UUID PRIMARY KEY REFERENCES chats(id) ON DELETE CASCADE,
+    since TIMESTAMP NOT NULL,
+    queued_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TABLE IF EXISTS receipt_count_queue;
+DROP TABLE IF EXISTS message_receipt_counts;
+
+ALTER TABLE user_chats
+    DROP COLUMN IF EXISTS read_until,
+    DROP COLUMN IF EXISTS delivered_until;
+-- +goose StatementEnd
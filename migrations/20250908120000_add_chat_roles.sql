@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+ALTER TABLE user_chats
+    ADD COLUMN IF NOT EXISTS role VARCHAR(16) NOT NULL DEFAULT 'member'
+        CHECK (role IN ('owner', 'admin', 'member', 'read_only'));
+
+-- existing members start as plain members, the one most likely to have created the chat owns it.
+-- Chats record neither creator nor join times yet, so that is the author of the oldest message,
+-- chats without messages fall back to the lowest user id
+UPDATE user_chats uc
+SET role = 'owner'
+FROM (
+    SELECT DISTINCT ON (uc.chat_id) uc.chat_id, uc.user_id
+    FROM user_chats uc
+    LEFT JOIN LATERAL (
+        SELECT MIN(m.created_at) AS first_at
+        FROM messages m
+        WHERE m.chat_id = uc.chat_id AND m.user_id = uc.user_id
+    ) f ON TRUE
+    ORDER BY uc.chat_id, f.first_at ASC NULLS LAST, uc.user_id
+) o
+WHERE uc.chat_id = o.chat_id AND uc.user_id = o.user_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_chats_owner ON user_chats(chat_id) WHERE role = 'owner';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP INDEX IF EXISTS idx_user_chats_owner;
+
+ALTER TABLE user_chats
+    DROP COLUMN IF EXISTS role;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS chat_type VARCHAR(16) NOT NULL DEFAULT 'group'
+        CHECK (chat_type IN ('group', 'direct'));
+
+-- one direct chat per pair of users, the pair is stored ordered so both sides hit the same row
+CREATE TABLE IF NOT EXISTS direct_chats (
+    chat_id UUID PRIMARY KEY REFERENCES chats(id) ON DELETE CASCADE,
+    user_low UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_high UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    CHECK (user_low < user_high),
+    UNIQUE (user_low, user_high)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TABLE IF EXISTS direct_chats;
+
+ALTER TABLE chats DROP COLUMN IF EXISTS chat_type;
+-- +goose StatementEnd
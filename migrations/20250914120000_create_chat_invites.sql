@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- max_uses 0 means the link has no limit, uses counts every redemption including pending requests
+CREATE TABLE IF NOT EXISTS chat_invites (
+    id                UUID PRIMARY KEY,
+    chat_id           UUID        NOT NULL REFERENCES chats (id) ON DELETE CASCADE,
+    token             TEXT        NOT NULL UNIQUE,
+    created_by        UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    expires_at        TIMESTAMPTZ,
+    max_uses          INT         NOT NULL DEFAULT 0 CHECK (max_uses >= 0),
+    uses              INT         NOT NULL DEFAULT 0,
+    requires_approval BOOLEAN     NOT NULL DEFAULT FALSE,
+    revoked_at        TIMESTAMPTZ,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_invites_chat ON chat_invites (chat_id) WHERE revoked_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS chat_join_requests (
+    chat_id    UUID        NOT NULL REFERENCES chats (id) ON DELETE CASCADE,
+    user_id    UUID        NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    invite_id  UUID        REFERENCES chat_invites (id) ON DELETE SET NULL,
+    status     VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_by UUID        REFERENCES users (id) ON DELETE SET NULL,
+    decided_at TIMESTAMPTZ,
+    PRIMARY KEY (chat_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_join_requests_pending ON chat_join_requests (chat_id, created_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TABLE IF EXISTS chat_join_requests;
+DROP TABLE IF EXISTS chat_invites;
+-- +goose StatementEnd
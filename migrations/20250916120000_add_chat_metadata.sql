@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- avatar keys point into the avatars bucket, both are set or both are NULL
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS description      TEXT        NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS avatar_key       TEXT,
+    ADD COLUMN IF NOT EXISTS avatar_thumb_key TEXT,
+    ADD COLUMN IF NOT EXISTS updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+ALTER TABLE chats
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS avatar_thumb_key,
+    DROP COLUMN IF EXISTS avatar_key,
+    DROP COLUMN IF EXISTS description;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- preferences of one member for one chat, they never affect the other members
+ALTER TABLE user_chats
+    ADD COLUMN IF NOT EXISTS muted_until TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS archived    BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS pinned      BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS sort_order  INT CHECK (sort_order > 0);
+
+CREATE INDEX IF NOT EXISTS idx_user_chats_user_archived ON user_chats (user_id, archived);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP INDEX IF EXISTS idx_user_chats_user_archived;
+
+ALTER TABLE user_chats
+    DROP COLUMN IF EXISTS sort_order,
+    DROP COLUMN IF EXISTS pinned,
+    DROP COLUMN IF EXISTS archived,
+    DROP COLUMN IF EXISTS muted_until;
+-- +goose StatementEnd
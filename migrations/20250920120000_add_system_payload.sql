@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- what a system message reports (kind, actor, target), clients render it, content is the plain text fallback
+ALTER TABLE messages
+    ADD COLUMN IF NOT EXISTS system_payload JSONB;
+
+-- a forwarded system message is plain text in the target chat, the payload describes the source chat only
+CREATE OR REPLACE FUNCTION messages_forward_type() RETURNS trigger AS $$
+BEGIN
+    SELECT message_type INTO NEW.message_type FROM messages WHERE id = NEW.forwarded_from_message_id;
+    IF NEW.message_type IS NULL OR NEW.message_type IN ('poll', 'system') THEN
+        NEW.message_type := 'text';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+CREATE OR REPLACE FUNCTION messages_forward_type() RETURNS trigger AS $$
+BEGIN
+    SELECT message_type INTO NEW.message_type FROM messages WHERE id = NEW.forwarded_from_message_id;
+    IF NEW.message_type IS NULL OR NEW.message_type = 'poll' THEN
+        NEW.message_type := 'text';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE messages
+    DROP COLUMN IF EXISTS system_payload;
+-- +goose StatementEnd
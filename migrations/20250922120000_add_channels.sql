@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+ALTER TABLE chats DROP CONSTRAINT IF EXISTS chats_chat_type_check;
+ALTER TABLE chats
+    ADD CONSTRAINT chats_chat_type_check CHECK (chat_type IN ('group', 'direct', 'channel'));
+
+-- kept by trigger so previews of channels with many subscribers do not count user_chats
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS member_count INT NOT NULL DEFAULT 0;
+
+UPDATE chats c
+SET member_count = (SELECT count(*) FROM user_chats uc WHERE uc.chat_id = c.id);
+
+CREATE OR REPLACE FUNCTION user_chats_member_count() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE chats SET member_count = member_count + 1 WHERE id = NEW.chat_id;
+    ELSE
+        UPDATE chats SET member_count = member_count - 1 WHERE id = OLD.chat_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS user_chats_member_count ON user_chats;
+CREATE TRIGGER user_chats_member_count
+    AFTER INSERT OR DELETE ON user_chats
+    FOR EACH ROW EXECUTE FUNCTION user_chats_member_count();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TRIGGER IF EXISTS user_chats_member_count ON user_chats;
+DROP FUNCTION IF EXISTS user_chats_member_count();
+
+ALTER TABLE chats DROP COLUMN IF EXISTS member_count;
+
+DELETE FROM chats WHERE chat_type = 'channel';
+ALTER TABLE chats DROP CONSTRAINT IF EXISTS chats_chat_type_check;
+ALTER TABLE chats
+    ADD CONSTRAINT chats_chat_type_check CHECK (chat_type IN ('group', 'direct'));
+-- +goose StatementEnd
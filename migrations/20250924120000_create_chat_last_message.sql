@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- latest message of every chat, previews join it instead of looking up messages per chat
+CREATE TABLE IF NOT EXISTS chat_last_message (
+    chat_id    UUID PRIMARY KEY REFERENCES chats(id) ON DELETE CASCADE,
+    -- a purged message drops its row, the purge refreshes the chat right after
+    message_id BIGINT NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    user_id    UUID NOT NULL,
+    content    TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMPTZ
+);
+
+-- writers call it for the chats they inserted into, inside their transaction.
+-- A row only moves forward, so a batch committing late does not hide a newer message.
+CREATE OR REPLACE FUNCTION refresh_chat_last_message(chat_ids UUID[]) RETURNS void AS $$
+BEGIN
+    INSERT INTO chat_last_message AS l (chat_id, message_id, user_id, content, created_at, expires_at)
+    SELECT c.id, m.id, m.user_id, COALESCE(m.content, ''), m.created_at, m.expires_at
+    FROM unnest(chat_ids) AS c(id)
+    CROSS JOIN LATERAL (
+        SELECT id, user_id, content, created_at, expires_at
+        FROM messages
+        WHERE chat_id = c.id
+            AND (expires_at IS NULL OR expires_at > NOW())
+        ORDER BY created_at DESC, id DESC
+        LIMIT 1
+    ) m
+    ON CONFLICT (chat_id) DO UPDATE SET
+        message_id = EXCLUDED.message_id,
+        user_id    = EXCLUDED.user_id,
+        content    = EXCLUDED.content,
+        created_at = EXCLUDED.created_at,
+        expires_at = EXCLUDED.expires_at
+    WHERE (l.created_at, l.message_id) < (EXCLUDED.created_at, EXCLUDED.message_id);
+END;
+$$ LANGUAGE plpgsql;
+
+SELECT refresh_chat_last_message(ARRAY(SELECT id FROM chats));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP FUNCTION IF EXISTS refresh_chat_last_message(UUID[]);
+DROP TABLE IF EXISTS chat_last_message;
+-- +goose StatementEnd
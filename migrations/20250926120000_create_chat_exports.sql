@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+CREATE TABLE IF NOT EXISTS chat_exports (
+    id            UUID PRIMARY KEY,
+    chat_id       UUID NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    user_id       UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status        VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'ready', 'failed')),
+    -- messages sent after the request are left out, same clock as messages.created_at
+    requested_at  TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    claimed_at    TIMESTAMPTZ,
+    attempts      INT NOT NULL DEFAULT 0,
+    object_key    VARCHAR(512), -- set once ready, points into the exports bucket
+    size_bytes    BIGINT,
+    message_count INT,
+    error         TEXT,
+    finished_at   TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_exports_queue
+    ON chat_exports(requested_at)
+    WHERE status IN ('pending', 'running');
+
+-- a user has at most one unfinished export per chat
+CREATE UNIQUE INDEX IF NOT EXISTS uq_chat_exports_active
+    ON chat_exports(chat_id, user_id)
+    WHERE status IN ('pending', 'running');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP TABLE IF EXISTS chat_exports;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- a claimed image is leased until then, a failed one waits out its backoff
+ALTER TABLE attachments
+    ADD COLUMN IF NOT EXISTS preview_next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+DROP INDEX IF EXISTS idx_attachments_preview_pending;
+CREATE INDEX IF NOT EXISTS idx_attachments_preview_pending
+    ON attachments(preview_next_attempt_at)
+    WHERE preview_status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+DROP INDEX IF EXISTS idx_attachments_preview_pending;
+CREATE INDEX IF NOT EXISTS idx_attachments_preview_pending
+    ON attachments(uploaded_at)
+    WHERE preview_status = 'pending';
+
+ALTER TABLE attachments
+    DROP COLUMN IF EXISTS preview_next_attempt_at;
+-- +goose StatementEnd
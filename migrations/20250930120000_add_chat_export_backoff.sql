@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- a failed export waits out its backoff before it is claimed again
+ALTER TABLE chat_exports
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+ALTER TABLE chat_exports
+    DROP COLUMN IF EXISTS next_attempt_at;
+-- +goose StatementEnd
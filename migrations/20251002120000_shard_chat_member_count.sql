@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- joins spread over the shards of the chat instead of all updating its chats row,
+-- a busy channel no longer serializes its joins and leaves with every other write to the chat
+CREATE TABLE IF NOT EXISTS chat_member_counts (
+    chat_id UUID     NOT NULL REFERENCES chats (id) ON DELETE CASCADE,
+    shard   SMALLINT NOT NULL,
+    count   INT      NOT NULL DEFAULT 0,
+    PRIMARY KEY (chat_id, shard)
+);
+
+INSERT INTO chat_member_counts (chat_id, shard, count)
+SELECT chat_id, 0, count(*)
+FROM user_chats
+GROUP BY chat_id
+ON CONFLICT (chat_id, shard) DO NOTHING;
+
+CREATE OR REPLACE FUNCTION user_chats_member_count() RETURNS trigger AS $$
+DECLARE
+    slot SMALLINT := floor(random() * 16);
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO chat_member_counts (chat_id, shard, count)
+        VALUES (NEW.chat_id, slot, 1)
+        ON CONFLICT (chat_id, shard) DO UPDATE SET count = chat_member_counts.count + 1;
+    ELSE
+        -- members deleted along with their chat leave nothing to count
+        INSERT INTO chat_member_counts (chat_id, shard, count)
+        SELECT OLD.chat_id, slot, -1
+        WHERE EXISTS (SELECT 1 FROM chats WHERE id = OLD.chat_id)
+        ON CONFLICT (chat_id, shard) DO UPDATE SET count = chat_member_counts.count - 1;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE chats DROP COLUMN IF EXISTS member_count;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+ALTER TABLE chats
+    ADD COLUMN IF NOT EXISTS member_count INT NOT NULL DEFAULT 0;
+
+UPDATE chats c
+SET member_count = (SELECT count(*) FROM user_chats uc WHERE uc.chat_id = c.id);
+
+CREATE OR REPLACE FUNCTION user_chats_member_count() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        UPDATE chats SET member_count = member_count + 1 WHERE id = NEW.chat_id;
+    ELSE
+        UPDATE chats SET member_count = member_count - 1 WHERE id = OLD.chat_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS chat_member_counts;
+-- +goose StatementEnd
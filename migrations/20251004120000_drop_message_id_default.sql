@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+-- every message id comes from the snowflake generator, an insert without one fails
+-- instead of taking a small sequence value that sorts before older messages
+ALTER TABLE messages
+    ALTER COLUMN id DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+
+ALTER TABLE messages
+    ALTER COLUMN id SET DEFAULT nextval('messages_id_seq');
+-- +goose StatementEnd